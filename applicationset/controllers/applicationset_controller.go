@@ -17,8 +17,10 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -161,7 +163,24 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		)
 	}
 
-	if r.Policy.Update() {
+	policy, err := r.getPolicy(&applicationSetInfo)
+	if err != nil {
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argoprojiov1alpha1.ApplicationSetCondition{
+				Type:    argoprojiov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argoprojiov1alpha1.ApplicationSetReasonSyncPolicyError,
+				Status:  argoprojiov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{}, nil
+	}
+
+	// skippedByPolicy holds the changes which were not made to the Applications because of the policy, by name
+	skippedByPolicy := map[string]string{}
+
+	if policy.Update() {
 		err = r.createOrUpdateInCluster(ctx, applicationSetInfo, validApps)
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
@@ -176,7 +195,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			return ctrl.Result{}, err
 		}
 	} else {
-		err = r.createInCluster(ctx, applicationSetInfo, validApps)
+		skippedUpdates, err := r.createInCluster(ctx, applicationSetInfo, validApps)
+		for _, name := range skippedUpdates {
+			skippedByPolicy[name] = "update"
+		}
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
 				&applicationSetInfo,
@@ -191,7 +213,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	if r.Policy.Delete() {
+	if policy.Delete() {
 		err = r.deleteInCluster(ctx, applicationSetInfo, desiredApplications)
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
@@ -205,6 +227,35 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			)
 			return ctrl.Result{}, err
 		}
+	} else {
+		skippedDeletes, err := r.getApplicationsToDelete(ctx, applicationSetInfo, desiredApplications)
+		if err != nil {
+			log.Warnf("error occurred while listing the Applications to delete: %v", err)
+		}
+		for _, app := range skippedDeletes {
+			skippedByPolicy[app.Name] = "delete"
+		}
+	}
+
+	var rollingSyncRequeueAfter time.Duration
+	if isRollingSync(&applicationSetInfo) {
+		rollingSyncRequeueAfter, err = r.performRollingSync(ctx, &applicationSetInfo)
+		if err != nil {
+			_ = r.setApplicationSetStatusCondition(ctx,
+				&applicationSetInfo,
+				argoprojiov1alpha1.ApplicationSetCondition{
+					Type:    argoprojiov1alpha1.ApplicationSetConditionErrorOccurred,
+					Message: err.Error(),
+					Reason:  argoprojiov1alpha1.ApplicationSetReasonSyncApplicationError,
+					Status:  argoprojiov1alpha1.ApplicationSetConditionStatusTrue,
+				}, parametersGenerated,
+			)
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := r.updateResourcesStatus(ctx, &applicationSetInfo, skippedByPolicy); err != nil {
+		log.Warnf("error occurred while updating the status of the ApplicationSet resources: %v", err)
 	}
 
 	if applicationSetInfo.RefreshRequired() {
@@ -226,6 +277,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	if rollingSyncRequeueAfter > 0 && (requeueAfter == 0 || rollingSyncRequeueAfter < requeueAfter) {
+		requeueAfter = rollingSyncRequeueAfter
+	}
 	log.WithField("requeueAfter", requeueAfter).Info("end reconcile")
 
 	if len(validateErrors) == 0 {
@@ -349,6 +403,112 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 	return nil
 }
 
+// getPolicy returns the policy applied to the Applications of the ApplicationSet: the policy of the controller, further
+// restricted by the applications sync policy of the ApplicationSet, if any.
+func (r *ApplicationSetReconciler) getPolicy(applicationSet *argoprojiov1alpha1.ApplicationSet) (utils.Policy, error) {
+	return utils.ApplicationSetPolicy(r.Policy, applicationSet)
+}
+
+// updateResourcesStatus records the sync and health status of the Applications owned by the ApplicationSet, along with
+// the aggregated counts and the changes skipped because of the applications sync policy, in the ApplicationSet status.
+func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, applicationSet *argoprojiov1alpha1.ApplicationSet, skippedByPolicy map[string]string) error {
+	applications, err := r.getCurrentApplications(ctx, *applicationSet)
+	if err != nil {
+		return fmt.Errorf("error listing Applications of ApplicationSet %s: %w", applicationSet.Name, err)
+	}
+
+	statuses := buildResourceStatuses(applicationSet.Status.Resources, applications, skippedByPolicy)
+	summary := buildResourcesSummary(statuses)
+	if equalResourceStatuses(applicationSet.Status.Resources, statuses) && applicationSet.Status.ResourcesSummary != nil && *applicationSet.Status.ResourcesSummary == summary {
+		return nil
+	}
+
+	// fetch updated Application Set object before updating it
+	namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+	if err := r.Get(ctx, namespacedName, applicationSet); err != nil {
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error fetching updated application set: %v", err)
+	}
+
+	applicationSet.Status.Resources = statuses
+	applicationSet.Status.ResourcesSummary = &summary
+
+	if err := r.Client.Status().Update(ctx, applicationSet); err != nil && !apierr.IsNotFound(err) {
+		return fmt.Errorf("unable to set application set resources status: %v", err)
+	}
+	return nil
+}
+
+// buildResourceStatuses returns the status of the given Applications, sorted by name. The last transition time of an
+// Application is only updated when its sync or health status differs from the previous one.
+func buildResourceStatuses(previous []argoprojiov1alpha1.ApplicationSetResourceStatus, applications []argov1alpha1.Application, skippedByPolicy map[string]string) []argoprojiov1alpha1.ApplicationSetResourceStatus {
+	previousStatuses := map[string]argoprojiov1alpha1.ApplicationSetResourceStatus{}
+	for _, status := range previous {
+		previousStatuses[status.Name] = status
+	}
+
+	now := metav1.Now()
+	statuses := make([]argoprojiov1alpha1.ApplicationSetResourceStatus, 0, len(applications))
+	for _, app := range applications {
+		status := argoprojiov1alpha1.ApplicationSetResourceStatus{
+			Name:            app.Name,
+			Namespace:       app.Namespace,
+			SyncStatus:      app.Status.Sync.Status,
+			Health:          app.Status.Health.Status,
+			HealthMessage:   app.Status.Health.Message,
+			SkippedByPolicy: skippedByPolicy[app.Name],
+		}
+		if prev, ok := previousStatuses[app.Name]; ok && prev.SyncStatus == status.SyncStatus && prev.Health == status.Health {
+			status.LastTransitionTime = prev.LastTransitionTime
+		} else {
+			status.LastTransitionTime = &now
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// buildResourcesSummary counts the given Application statuses by sync and health status.
+func buildResourcesSummary(statuses []argoprojiov1alpha1.ApplicationSetResourceStatus) argoprojiov1alpha1.ApplicationSetResourcesSummary {
+	summary := argoprojiov1alpha1.ApplicationSetResourcesSummary{Total: int64(len(statuses))}
+	for _, status := range statuses {
+		switch status.SyncStatus {
+		case argov1alpha1.SyncStatusCodeSynced:
+			summary.Synced++
+		case argov1alpha1.SyncStatusCodeOutOfSync:
+			summary.OutOfSync++
+		}
+		switch status.Health {
+		case health.HealthStatusHealthy:
+			summary.Healthy++
+		case health.HealthStatusProgressing:
+			summary.Progressing++
+		case health.HealthStatusDegraded:
+			summary.Degraded++
+		}
+	}
+	return summary
+}
+
+func equalResourceStatuses(a, b []argoprojiov1alpha1.ApplicationSetResourceStatus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Namespace != b[i].Namespace || a[i].SyncStatus != b[i].SyncStatus ||
+			a[i].Health != b[i].Health || a[i].HealthMessage != b[i].HealthMessage || a[i].SkippedByPolicy != b[i].SkippedByPolicy {
+			return false
+		}
+	}
+	return true
+}
+
 // validateGeneratedApplications uses the Argo CD validation functions to verify the correctness of the
 // generated applications.
 func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Context, desiredApplications []argov1alpha1.Application, applicationSetInfo argoprojiov1alpha1.ApplicationSet, namespace string) (map[int]error, error) {
@@ -424,13 +584,19 @@ func getTempApplication(applicationSetTemplate argoprojiov1alpha1.ApplicationSet
 }
 
 func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argoprojiov1alpha1.ApplicationSet) ([]argov1alpha1.Application, argoprojiov1alpha1.ApplicationSetReasonType, error) {
+	return GenerateApplications(applicationSetInfo, r.Generators, r.Renderer)
+}
+
+// GenerateApplications renders the Applications of an ApplicationSet from the parameters of its generators. It does not
+// write anything to the cluster, so it is also used to preview the Applications of an ApplicationSet.
+func GenerateApplications(applicationSetInfo argoprojiov1alpha1.ApplicationSet, supportedGenerators map[string]generators.Generator, renderer utils.Renderer) ([]argov1alpha1.Application, argoprojiov1alpha1.ApplicationSetReasonType, error) {
 	var res []argov1alpha1.Application
 
 	var firstError error
 	var applicationSetReason argoprojiov1alpha1.ApplicationSetReasonType
 
 	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
-		t, err := generators.Transform(requestedGenerator, r.Generators, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]string{})
+		t, err := generators.Transform(requestedGenerator, supportedGenerators, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]interface{}{})
 		if err != nil {
 			log.WithError(err).WithField("generator", requestedGenerator).
 				Error("error generating application from params")
@@ -445,7 +611,7 @@ func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argop
 			tmplApplication := getTempApplication(a.Template)
 
 			for _, p := range a.Params {
-				app, err := r.Renderer.RenderTemplateParams(tmplApplication, applicationSetInfo.Spec.SyncPolicy, p)
+				app, err := renderer.RenderTemplateParams(tmplApplication, applicationSetInfo.Spec.SyncPolicy, p, applicationSetInfo.Spec.GoTemplate)
 				if err != nil {
 					log.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
 						Error("error generating application from params")
@@ -464,6 +630,12 @@ func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argop
 		log.WithField("generator", requestedGenerator).Debugf("apps from generator: %+v", res)
 	}
 
+	// When a rollout strategy is configured, the Applications are synced step by step by the ApplicationSet
+	// controller rather than by their automated sync policy
+	if isRollingSync(&applicationSetInfo) {
+		res = removeAutomatedSyncPolicy(res)
+	}
+
 	return res, applicationSetReason, firstError
 }
 
@@ -504,7 +676,19 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // - For existing application, it will call update
 // The function also adds owner reference to all applications, and uses it to delete them.
 func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context, applicationSet argoprojiov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
+	_, err := r.createOrUpdateApplications(ctx, applicationSet, desiredApplications, true)
+	return err
+}
+
+// createOrUpdateApplications creates or updates the desiredApplications in the cluster. If allowUpdate is false, the
+// existing Applications are left unchanged, and the names of those which differ from their desired state are returned.
+func (r *ApplicationSetReconciler) createOrUpdateApplications(ctx context.Context, applicationSet argoprojiov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application, allowUpdate bool) ([]string, error) {
+	c := r.Client
+	if !allowUpdate {
+		c = &noUpdateClient{Client: r.Client}
+	}
 
+	var skippedUpdates []string
 	var firstError error
 	// Creates or updates the application in appList
 	for _, generatedApp := range desiredApplications {
@@ -523,7 +707,7 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			},
 		}
 
-		action, err := utils.CreateOrUpdate(ctx, r.Client, found, func() error {
+		action, err := utils.CreateOrUpdate(ctx, c, applicationSet.Spec.IgnoreApplicationDifferences, found, func() error {
 			// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
 			found.Spec = generatedApp.Spec
 
@@ -549,38 +733,32 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			continue
 		}
 
+		if !allowUpdate && action == controllerutil.OperationResultUpdated {
+			skippedUpdates = append(skippedUpdates, generatedApp.Name)
+			appLog.Info("skipped update of Application because of the applications sync policy")
+			continue
+		}
+
 		r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, fmt.Sprint(action), "%s Application %q", action, generatedApp.Name)
 		appLog.Logf(log.InfoLevel, "%s Application", action)
 	}
-	return firstError
+	return skippedUpdates, firstError
 }
 
-// createInCluster will filter from the desiredApplications only the application that needs to be created
-// Then it will call createOrUpdateInCluster to do the actual create
-func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, applicationSet argoprojiov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
-
-	var createApps []argov1alpha1.Application
-	current, err := r.getCurrentApplications(ctx, applicationSet)
-	if err != nil {
-		return err
-	}
-
-	m := make(map[string]bool) // Will holds the app names that are current in the cluster
-
-	for _, app := range current {
-		m[app.Name] = true
-	}
-
-	// filter applications that are not in m[string]bool (new to the cluster)
-	for _, app := range desiredApplications {
-		_, exists := m[app.Name]
+// noUpdateClient is a client which does not update objects. It lets CreateOrUpdate report the Applications which
+// would be updated, without updating them.
+type noUpdateClient struct {
+	client.Client
+}
 
-		if !exists {
-			createApps = append(createApps, app)
-		}
-	}
+func (c *noUpdateClient) Update(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+	return nil
+}
 
-	return r.createOrUpdateInCluster(ctx, applicationSet, createApps)
+// createInCluster will create the desiredApplications which are not in the cluster yet, without updating the existing
+// ones. It returns the names of the existing Applications which differ from their desired state.
+func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, applicationSet argoprojiov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) ([]string, error) {
+	return r.createOrUpdateApplications(ctx, applicationSet, desiredApplications, false)
 }
 
 func (r *ApplicationSetReconciler) getCurrentApplications(_ context.Context, applicationSet argoprojiov1alpha1.ApplicationSet) ([]argov1alpha1.Application, error) {
@@ -606,49 +784,59 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, applicat
 		return err
 	}
 
-	// Save current applications to be able to delete the ones that are not in appList
-	current, err := r.getCurrentApplications(ctx, applicationSet)
+	appsToDelete, err := r.getApplicationsToDelete(ctx, applicationSet, desiredApplications)
 	if err != nil {
 		return err
 	}
 
+	var firstError error
+	for _, app := range appsToDelete {
+		appLog := log.WithFields(log.Fields{"app": app.Name, "appSet": applicationSet.Name})
+		// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
+		err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, appLog)
+		if err != nil {
+			appLog.WithError(err).Error("failed to update Application")
+			if firstError == nil {
+				firstError = err
+			}
+			continue
+		}
+
+		err = r.Client.Delete(ctx, &app)
+		if err != nil {
+			appLog.WithError(err).Error("failed to delete Application")
+			if firstError == nil {
+				firstError = err
+			}
+			continue
+		}
+		r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", app.Name)
+		appLog.Log(log.InfoLevel, "Deleted application")
+	}
+	return firstError
+}
+
+// getApplicationsToDelete returns the Applications of the ApplicationSet which are currently on the cluster, but not in
+// desiredApplications.
+func (r *ApplicationSetReconciler) getApplicationsToDelete(ctx context.Context, applicationSet argoprojiov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) ([]argov1alpha1.Application, error) {
+	current, err := r.getCurrentApplications(ctx, applicationSet)
+	if err != nil {
+		return nil, err
+	}
+
 	m := make(map[string]bool) // Will holds the app names in appList for the deletion process
 
 	for _, app := range desiredApplications {
 		m[app.Name] = true
 	}
 
-	// Delete apps that are not in m[string]bool
-	var firstError error
+	var appsToDelete []argov1alpha1.Application
 	for _, app := range current {
-		appLog := log.WithFields(log.Fields{"app": app.Name, "appSet": applicationSet.Name})
-		_, exists := m[app.Name]
-
-		if !exists {
-
-			// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
-			err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, appLog)
-			if err != nil {
-				appLog.WithError(err).Error("failed to update Application")
-				if firstError != nil {
-					firstError = err
-				}
-				continue
-			}
-
-			err = r.Client.Delete(ctx, &app)
-			if err != nil {
-				appLog.WithError(err).Error("failed to delete Application")
-				if firstError != nil {
-					firstError = err
-				}
-				continue
-			}
-			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", app.Name)
-			appLog.Log(log.InfoLevel, "Deleted application")
+		if _, exists := m[app.Name]; !exists {
+			appsToDelete = append(appsToDelete, app)
 		}
 	}
-	return firstError
+	return appsToDelete, nil
 }
 
 // removeFinalizerOnInvalidDestination removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
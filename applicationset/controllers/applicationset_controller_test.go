@@ -9,12 +9,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -45,10 +48,10 @@ func (g *generatorMock) GetTemplate(appSetGenerator *argoprojiov1alpha1.Applicat
 	return args.Get(0).(*argoprojiov1alpha1.ApplicationSetTemplate)
 }
 
-func (g *generatorMock) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, _ *argoprojiov1alpha1.ApplicationSet) ([]map[string]string, error) {
+func (g *generatorMock) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, _ *argoprojiov1alpha1.ApplicationSet) ([]map[string]interface{}, error) {
 	args := g.Called(appSetGenerator)
 
-	return args.Get(0).([]map[string]string), args.Error(1)
+	return args.Get(0).([]map[string]interface{}), args.Error(1)
 }
 
 type rendererMock struct {
@@ -61,7 +64,7 @@ func (g *generatorMock) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Appl
 	return args.Get(0).(time.Duration)
 }
 
-func (r *rendererMock) RenderTemplateParams(tmpl *argov1alpha1.Application, syncPolicy *argoprojiov1alpha1.ApplicationSetSyncPolicy, params map[string]string) (*argov1alpha1.Application, error) {
+func (r *rendererMock) RenderTemplateParams(tmpl *argov1alpha1.Application, syncPolicy *argoprojiov1alpha1.ApplicationSetSyncPolicy, params map[string]interface{}, useGoTemplate bool) (*argov1alpha1.Application, error) {
 	args := r.Called(tmpl, params)
 
 	if args.Error(1) != nil {
@@ -82,7 +85,7 @@ func TestExtractApplications(t *testing.T) {
 
 	for _, c := range []struct {
 		name                string
-		params              []map[string]string
+		params              []map[string]interface{}
 		template            argoprojiov1alpha1.ApplicationSetTemplate
 		generateParamsError error
 		rendererError       error
@@ -91,7 +94,7 @@ func TestExtractApplications(t *testing.T) {
 	}{
 		{
 			name:   "Generate two applications",
-			params: []map[string]string{{"name": "app1"}, {"name": "app2"}},
+			params: []map[string]interface{}{{"name": "app1"}, {"name": "app2"}},
 			template: argoprojiov1alpha1.ApplicationSetTemplate{
 				ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
 					Name:      "name",
@@ -110,7 +113,7 @@ func TestExtractApplications(t *testing.T) {
 		},
 		{
 			name:   "Handles error from the render",
-			params: []map[string]string{{"name": "app1"}, {"name": "app2"}},
+			params: []map[string]interface{}{{"name": "app1"}, {"name": "app2"}},
 			template: argoprojiov1alpha1.ApplicationSetTemplate{
 				ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
 					Name:      "name",
@@ -220,7 +223,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 
 	for _, c := range []struct {
 		name             string
-		params           []map[string]string
+		params           []map[string]interface{}
 		template         argoprojiov1alpha1.ApplicationSetTemplate
 		overrideTemplate argoprojiov1alpha1.ApplicationSetTemplate
 		expectedMerged   argoprojiov1alpha1.ApplicationSetTemplate
@@ -228,7 +231,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 	}{
 		{
 			name:   "Generate app",
-			params: []map[string]string{{"name": "app1"}},
+			params: []map[string]interface{}{{"name": "app1"}},
 			template: argoprojiov1alpha1.ApplicationSetTemplate{
 				ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
 					Name:      "name",
@@ -1315,7 +1318,7 @@ func TestCreateApplications(t *testing.T) {
 			Recorder: record.NewFakeRecorder(len(initObjs) + len(c.expected)),
 		}
 
-		err = r.createInCluster(context.TODO(), c.appSet, c.apps)
+		_, err = r.createInCluster(context.TODO(), c.appSet, c.apps)
 		assert.Nil(t, err)
 
 		for _, obj := range c.expected {
@@ -1489,6 +1492,55 @@ func TestDeleteInCluster(t *testing.T) {
 	}
 }
 
+// failingDeleteClient is a client whose Delete calls always fail
+type failingDeleteClient struct {
+	crtclient.Client
+}
+
+func (c *failingDeleteClient) Delete(ctx context.Context, obj crtclient.Object, opts ...crtclient.DeleteOption) error {
+	return fmt.Errorf("delete of %q failed", obj.GetName())
+}
+
+func TestDeleteInClusterReturnsFirstError(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argoprojiov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+	err = argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	appSet := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+	}
+	initObjs := []crtclient.Object{&appSet}
+	for _, name := range []string{"a", "b"} {
+		app := &argov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "namespace",
+			},
+			Spec: argov1alpha1.ApplicationSpec{
+				Project: "project",
+			},
+		}
+		err = controllerutil.SetControllerReference(&appSet, app, scheme)
+		assert.Nil(t, err)
+		initObjs = append(initObjs, app)
+	}
+
+	r := ApplicationSetReconciler{
+		Client:        &failingDeleteClient{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).Build()},
+		Scheme:        scheme,
+		Recorder:      record.NewFakeRecorder(len(initObjs)),
+		KubeClientset: kubefake.NewSimpleClientset(),
+	}
+
+	err = r.deleteInCluster(context.TODO(), appSet, nil)
+	assert.EqualError(t, err, `delete of "a" failed`)
+}
+
 func TestGetMinRequeueAfter(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := argoprojiov1alpha1.AddToScheme(scheme)
@@ -1925,3 +1977,324 @@ func TestSetApplicationSetStatusCondition(t *testing.T) {
 
 	assert.Len(t, appSet.Status.Conditions, 3)
 }
+
+func TestBuildResourceStatuses(t *testing.T) {
+	previousTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	previous := []argoprojiov1alpha1.ApplicationSetResourceStatus{
+		{Name: "unchanged", SyncStatus: argov1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy, LastTransitionTime: &previousTime},
+		{Name: "changed", SyncStatus: argov1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy, LastTransitionTime: &previousTime},
+		{Name: "deleted", SyncStatus: argov1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy, LastTransitionTime: &previousTime},
+	}
+	newApp := func(name string, syncStatus argov1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) argov1alpha1.Application {
+		return argov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Status: argov1alpha1.ApplicationStatus{
+				Sync:   argov1alpha1.SyncStatus{Status: syncStatus},
+				Health: argov1alpha1.HealthStatus{Status: healthStatus, Message: "message"},
+			},
+		}
+	}
+	apps := []argov1alpha1.Application{
+		newApp("unchanged", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		newApp("new", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing),
+		newApp("changed", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusDegraded),
+	}
+
+	statuses := buildResourceStatuses(previous, apps, nil)
+	if assert.Len(t, statuses, 3) {
+		assert.Equal(t, "changed", statuses[0].Name)
+		assert.Equal(t, health.HealthStatusDegraded, statuses[0].Health)
+		assert.NotEqual(t, previousTime, *statuses[0].LastTransitionTime)
+		assert.Equal(t, "new", statuses[1].Name)
+		assert.Equal(t, "argocd", statuses[1].Namespace)
+		assert.Equal(t, argov1alpha1.SyncStatusCodeOutOfSync, statuses[1].SyncStatus)
+		assert.NotNil(t, statuses[1].LastTransitionTime)
+		assert.Equal(t, "unchanged", statuses[2].Name)
+		assert.Equal(t, "message", statuses[2].HealthMessage)
+		assert.Equal(t, previousTime, *statuses[2].LastTransitionTime)
+	}
+}
+
+func TestBuildResourcesSummary(t *testing.T) {
+	summary := buildResourcesSummary([]argoprojiov1alpha1.ApplicationSetResourceStatus{
+		{Name: "a", SyncStatus: argov1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy},
+		{Name: "b", SyncStatus: argov1alpha1.SyncStatusCodeOutOfSync, Health: health.HealthStatusProgressing},
+		{Name: "c", SyncStatus: argov1alpha1.SyncStatusCodeOutOfSync, Health: health.HealthStatusDegraded},
+		{Name: "d", SyncStatus: argov1alpha1.SyncStatusCodeUnknown, Health: health.HealthStatusMissing},
+	})
+	assert.Equal(t, argoprojiov1alpha1.ApplicationSetResourcesSummary{
+		Total:       4,
+		Synced:      1,
+		OutOfSync:   2,
+		Healthy:     1,
+		Progressing: 1,
+		Degraded:    1,
+	}, summary)
+}
+
+func TestUpdateResourcesStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argoprojiov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+	err = argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+	}
+	controller := true
+	app := &argov1alpha1.Application{
+		TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "argoproj.io/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "argocd",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: argoprojiov1alpha1.GroupVersion.String(),
+				Kind:       "ApplicationSet",
+				Name:       appSet.Name,
+				Controller: &controller,
+			}},
+		},
+		Status: argov1alpha1.ApplicationStatus{
+			Sync:   argov1alpha1.SyncStatus{Status: argov1alpha1.SyncStatusCodeSynced},
+			Health: argov1alpha1.HealthStatus{Status: health.HealthStatusHealthy},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet, app).Build()
+	r := ApplicationSetReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+
+	err = r.updateResourcesStatus(context.TODO(), appSet, nil)
+	assert.NoError(t, err)
+
+	updatedAppSet := &argoprojiov1alpha1.ApplicationSet{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "argocd", Name: appSet.Name}, updatedAppSet)
+	assert.NoError(t, err)
+	if assert.Len(t, updatedAppSet.Status.Resources, 1) {
+		assert.Equal(t, "app", updatedAppSet.Status.Resources[0].Name)
+		assert.Equal(t, argov1alpha1.SyncStatusCodeSynced, updatedAppSet.Status.Resources[0].SyncStatus)
+		assert.Equal(t, health.HealthStatusHealthy, updatedAppSet.Status.Resources[0].Health)
+	}
+	assert.Equal(t, &argoprojiov1alpha1.ApplicationSetResourcesSummary{Total: 1, Synced: 1, Healthy: 1}, updatedAppSet.Status.ResourcesSummary)
+}
+
+// failingStatusClient is a client whose Get calls of ApplicationSets and status updates fail with the given errors
+type failingStatusClient struct {
+	crtclient.Client
+	getErr    error
+	updateErr error
+}
+
+func (c *failingStatusClient) Get(ctx context.Context, key crtclient.ObjectKey, obj crtclient.Object) error {
+	if _, ok := obj.(*argoprojiov1alpha1.ApplicationSet); ok && c.getErr != nil {
+		return c.getErr
+	}
+	return c.Client.Get(ctx, key, obj)
+}
+
+func (c *failingStatusClient) Status() crtclient.StatusWriter {
+	return &failingStatusWriter{StatusWriter: c.Client.Status(), err: c.updateErr}
+}
+
+type failingStatusWriter struct {
+	crtclient.StatusWriter
+	err error
+}
+
+func (w *failingStatusWriter) Update(ctx context.Context, obj crtclient.Object, opts ...crtclient.UpdateOption) error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+func TestUpdateResourcesStatus_Errors(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argoprojiov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+	err = argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+	}
+	notFound := apierr.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applicationsets"}, appSet.Name)
+	cases := []struct {
+		name          string
+		getErr        error
+		updateErr     error
+		expectedError string
+	}{
+		{name: "status update fails", updateErr: fmt.Errorf("conflict"), expectedError: "unable to set application set resources status: conflict"},
+		{name: "status update of a deleted ApplicationSet", updateErr: notFound},
+		{name: "fetching the ApplicationSet fails", getErr: fmt.Errorf("timeout"), expectedError: "error fetching updated application set: timeout"},
+		{name: "ApplicationSet deleted", getErr: notFound},
+	}
+
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			client := &failingStatusClient{
+				Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet.DeepCopy()).Build(),
+				getErr:    cc.getErr,
+				updateErr: cc.updateErr,
+			}
+			r := ApplicationSetReconciler{Client: client, Scheme: scheme}
+
+			err := r.updateResourcesStatus(context.TODO(), appSet.DeepCopy(), nil)
+			if cc.expectedError != "" {
+				assert.EqualError(t, err, cc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetPolicy(t *testing.T) {
+	policy := func(p argoprojiov1alpha1.ApplicationsSyncPolicy) *argoprojiov1alpha1.ApplicationSetSyncPolicy {
+		return &argoprojiov1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &p}
+	}
+	cases := []struct {
+		name             string
+		controllerPolicy utils.Policy
+		syncPolicy       *argoprojiov1alpha1.ApplicationSetSyncPolicy
+		update, delete   bool
+		hasError         bool
+	}{
+		{name: "no sync policy", controllerPolicy: &utils.SyncPolicy{}, update: true, delete: true},
+		{name: "no applications sync policy", controllerPolicy: &utils.CreateUpdatePolicy{}, syncPolicy: &argoprojiov1alpha1.ApplicationSetSyncPolicy{}, update: true},
+		{name: "create-only", controllerPolicy: &utils.SyncPolicy{}, syncPolicy: policy(argoprojiov1alpha1.ApplicationsSyncPolicyCreateOnly)},
+		{name: "create-update", controllerPolicy: &utils.SyncPolicy{}, syncPolicy: policy(argoprojiov1alpha1.ApplicationsSyncPolicyCreateUpdate), update: true},
+		{name: "sync cannot relax the controller policy", controllerPolicy: &utils.CreateOnlyPolicy{}, syncPolicy: policy(argoprojiov1alpha1.ApplicationsSyncPolicySync)},
+		{name: "invalid", controllerPolicy: &utils.SyncPolicy{}, syncPolicy: policy("create-delete"), hasError: true},
+	}
+
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			r := ApplicationSetReconciler{Policy: cc.controllerPolicy}
+			appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{SyncPolicy: cc.syncPolicy}}
+			policy, err := r.getPolicy(appSet)
+			if cc.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, cc.update, policy.Update())
+			assert.Equal(t, cc.delete, policy.Delete())
+		})
+	}
+}
+
+func TestReconcileApplicationsSyncPolicyCreateOnly(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argoprojiov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+	err = argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	defaultProject := argov1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       argov1alpha1.AppProjectSpec{SourceRepos: []string{"*"}, Destinations: []argov1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}}},
+	}
+	createOnly := argoprojiov1alpha1.ApplicationsSyncPolicyCreateOnly
+	appSet := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+			UID:       "appset-uid",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{
+				{
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{
+							Raw: []byte(`{"cluster": "existing","url": "https://existing-cluster"}`),
+						}, {
+							Raw: []byte(`{"cluster": "new","url": "https://new-cluster"}`),
+						}},
+					},
+				},
+			},
+			Template: argoprojiov1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
+					Name:      "{{cluster}}",
+					Namespace: "argocd",
+				},
+				Spec: argov1alpha1.ApplicationSpec{
+					Source:      argov1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+					Project:     "default",
+					Destination: argov1alpha1.ApplicationDestination{Server: "{{url}}"},
+				},
+			},
+			SyncPolicy: &argoprojiov1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &createOnly},
+		},
+	}
+
+	controller := true
+	ownedApp := func(name string, server string) *argov1alpha1.Application {
+		return &argov1alpha1.Application{
+			TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "argoproj.io/v1alpha1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "argocd",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: argoprojiov1alpha1.GroupVersion.String(),
+					Kind:       "ApplicationSet",
+					Name:       appSet.Name,
+					UID:        appSet.UID,
+					Controller: &controller,
+				}},
+			},
+			Spec: argov1alpha1.ApplicationSpec{
+				Source:      argov1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "manually-edited"},
+				Project:     "default",
+				Destination: argov1alpha1.ApplicationDestination{Server: server},
+			},
+		}
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, ownedApp("existing", "https://existing-cluster"), ownedApp("stale", "https://stale-cluster")).Build()
+	argoDBMock := dbmocks.ArgoDB{}
+	argoDBMock.On("GetCluster", mock.Anything, mock.Anything).Return(&argov1alpha1.Cluster{}, nil)
+
+	r := ApplicationSetReconciler{
+		Log:      ctrl.Log.WithName("controllers").WithName("ApplicationSet"),
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		ArgoDB:           &argoDBMock,
+		ArgoAppClientset: appclientset.NewSimpleClientset(&defaultProject),
+		KubeClientset:    kubefake.NewSimpleClientset(),
+		Policy:           &utils.SyncPolicy{},
+	}
+
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+	assert.NoError(t, err)
+
+	var app argov1alpha1.Application
+	err = client.Get(context.TODO(), crtclient.ObjectKey{Namespace: "argocd", Name: "new"}, &app)
+	assert.NoError(t, err)
+	err = client.Get(context.TODO(), crtclient.ObjectKey{Namespace: "argocd", Name: "existing"}, &app)
+	assert.NoError(t, err)
+	assert.Equal(t, "manually-edited", app.Spec.Source.Path)
+	err = client.Get(context.TODO(), crtclient.ObjectKey{Namespace: "argocd", Name: "stale"}, &app)
+	assert.NoError(t, err)
+
+	var updatedAppSet argoprojiov1alpha1.ApplicationSet
+	err = client.Get(context.TODO(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &updatedAppSet)
+	assert.NoError(t, err)
+	skipped := map[string]string{}
+	for _, resource := range updatedAppSet.Status.Resources {
+		skipped[resource.Name] = resource.SkippedByPolicy
+	}
+	assert.Equal(t, map[string]string{"existing": "update", "new": "", "stale": "delete"}, skipped)
+}
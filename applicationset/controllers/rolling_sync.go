@@ -0,0 +1,369 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
+const (
+	// RollingSyncInitiator is the username recorded on the sync operations triggered by the rollout strategy
+	RollingSyncInitiator = "applicationset-controller"
+	// rollingSyncRetryDelay is the time the rollout strategy waits after a failed sync of an Application before
+	// syncing it again
+	rollingSyncRetryDelay = 3 * time.Minute
+)
+
+// isRollingSync returns true if the Applications of the ApplicationSet are synced by the RollingSync strategy.
+func isRollingSync(applicationSet *argoprojiov1alpha1.ApplicationSet) bool {
+	return applicationSet.Spec.Strategy != nil &&
+		applicationSet.Spec.Strategy.Type == argoprojiov1alpha1.ApplicationSetStrategyTypeRollingSync &&
+		applicationSet.Spec.Strategy.RollingSync != nil &&
+		len(applicationSet.Spec.Strategy.RollingSync.Steps) > 0
+}
+
+// removeAutomatedSyncPolicy disables automated sync on the generated Applications, since the rollout strategy is
+// responsible for triggering their syncs.
+func removeAutomatedSyncPolicy(applications []argov1alpha1.Application) []argov1alpha1.Application {
+	res := make([]argov1alpha1.Application, len(applications))
+	for i := range applications {
+		app := applications[i].DeepCopy()
+		if app.Spec.SyncPolicy != nil {
+			app.Spec.SyncPolicy.Automated = nil
+			if app.Spec.SyncPolicy.IsZero() {
+				app.Spec.SyncPolicy = nil
+			}
+		}
+		res[i] = *app
+	}
+	return res
+}
+
+// performRollingSync advances the generated Applications through the steps of the rollout strategy: it refreshes the
+// status of each Application, triggers the syncs of the current step and records the progress in the ApplicationSet
+// status. It returns the time after which the ApplicationSet must be reconciled again to retry failed syncs, if any.
+func (r *ApplicationSetReconciler) performRollingSync(ctx context.Context, applicationSet *argoprojiov1alpha1.ApplicationSet) (time.Duration, error) {
+	applications, err := r.getCurrentApplications(ctx, *applicationSet)
+	if err != nil {
+		return 0, fmt.Errorf("error listing Applications of ApplicationSet %s: %w", applicationSet.Name, err)
+	}
+
+	appStepMap, err := buildAppStepMap(applicationSet, applications)
+	if err != nil {
+		return 0, err
+	}
+
+	statuses := updateApplicationStatuses(applicationSet, applications, appStepMap)
+	statuses, appsToSync, requeueAfter := progressApplicationStatuses(applicationSet, applications, appStepMap, statuses)
+
+	prune := false
+	if syncPolicy := applicationSet.Spec.Template.Spec.SyncPolicy; syncPolicy != nil && syncPolicy.Automated != nil {
+		prune = syncPolicy.Automated.Prune
+	}
+
+	var firstError error
+	for i := range appsToSync {
+		if err := r.syncApplication(ctx, applicationSet, &appsToSync[i], prune); err != nil && firstError == nil {
+			firstError = err
+		}
+	}
+
+	if err := r.setApplicationSetApplicationStatus(ctx, applicationSet, statuses); err != nil {
+		return 0, err
+	}
+	return requeueAfter, firstError
+}
+
+// buildAppStepMap assigns each Application to the first rollout step whose match expressions select it. Applications
+// which are not selected by any step are assigned to an implicit final step, which is started after all the others.
+func buildAppStepMap(applicationSet *argoprojiov1alpha1.ApplicationSet, applications []argov1alpha1.Application) (map[string]int, error) {
+	selectors := make([]labels.Selector, len(applicationSet.Spec.Strategy.RollingSync.Steps))
+	for i, step := range applicationSet.Spec.Strategy.RollingSync.Steps {
+		selector := labels.NewSelector()
+		for _, expression := range step.MatchExpressions {
+			requirement, err := labels.NewRequirement(expression.Key, toSelectionOperator(expression.Operator), expression.Values)
+			if err != nil {
+				return nil, fmt.Errorf("invalid match expression in step %d of the rollout strategy: %w", i+1, err)
+			}
+			selector = selector.Add(*requirement)
+		}
+		selectors[i] = selector
+	}
+
+	appStepMap := map[string]int{}
+	for _, app := range applications {
+		appStepMap[app.Name] = len(selectors)
+		for i, selector := range selectors {
+			if selector.Matches(labels.Set(app.Labels)) {
+				appStepMap[app.Name] = i
+				break
+			}
+		}
+	}
+	return appStepMap, nil
+}
+
+func toSelectionOperator(operator string) selection.Operator {
+	switch operator {
+	case "In":
+		return selection.In
+	case "NotIn":
+		return selection.NotIn
+	case "Exists":
+		return selection.Exists
+	case "DoesNotExist":
+		return selection.DoesNotExist
+	}
+	return selection.Operator(operator)
+}
+
+// updateApplicationStatuses computes the new rollout status of each Application from the status of the Application
+// resource and its previous rollout status.
+func updateApplicationStatuses(applicationSet *argoprojiov1alpha1.ApplicationSet, applications []argov1alpha1.Application, appStepMap map[string]int) []argoprojiov1alpha1.ApplicationSetApplicationStatus {
+	previousStatuses := map[string]argoprojiov1alpha1.ApplicationSetApplicationStatus{}
+	for _, status := range applicationSet.Status.ApplicationStatus {
+		previousStatuses[status.Application] = status
+	}
+
+	now := metav1.Now()
+	var statuses []argoprojiov1alpha1.ApplicationSetApplicationStatus
+	for _, app := range applications {
+		step := appStepMap[app.Name]
+		synced := app.Status.Sync.Status == argov1alpha1.SyncStatusCodeSynced
+		healthy := app.Status.Health.Status == health.HealthStatusHealthy
+		operationRunning := app.Operation != nil || (app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed())
+
+		status, exists := previousStatuses[app.Name]
+		newStatus := status.Status
+		message := status.Message
+		switch {
+		case !exists:
+			if synced && healthy {
+				newStatus, message = argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy, "Application resource is already Healthy, updating status from Waiting to Healthy."
+			} else {
+				newStatus, message = argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, "No Application status found, defaulting status to Waiting."
+			}
+		case status.Status == argoprojiov1alpha1.ApplicationSetApplicationStatusPending:
+			operationStarted := app.Status.OperationState != nil && status.LastTransitionTime != nil &&
+				!app.Status.OperationState.StartedAt.Before(status.LastTransitionTime)
+			if operationRunning || operationStarted {
+				newStatus, message = argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing, "Application resource became Progressing, updating status from Pending to Progressing."
+			}
+		case status.Status == argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing:
+			if !operationRunning && synced && healthy {
+				newStatus, message = argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy, "Application resource became Healthy, updating status from Progressing to Healthy."
+			} else if !operationRunning && syncFailed(&app) {
+				// the sync is retried once the retry delay has passed, see progressApplicationStatuses
+				newStatus, message = argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, fmt.Sprintf("Application sync failed, updating status from Progressing to Waiting: %s", app.Status.OperationState.Message)
+			}
+		case status.Status == argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy:
+			if !synced {
+				newStatus, message = argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, "Application has pending changes, setting status to Waiting."
+			}
+		case status.Status == argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting:
+			if synced && healthy {
+				newStatus, message = argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy, "Application resource is already Healthy, updating status from Waiting to Healthy."
+			}
+		}
+
+		stepName := strconv.Itoa(step + 1)
+		if !exists || newStatus != status.Status || stepName != status.Step || message != status.Message {
+			status = argoprojiov1alpha1.ApplicationSetApplicationStatus{
+				Application:        app.Name,
+				LastTransitionTime: status.LastTransitionTime,
+				Message:            message,
+				Status:             newStatus,
+				Step:               stepName,
+			}
+			if !exists || newStatus != previousStatuses[app.Name].Status {
+				status.LastTransitionTime = &now
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Application < statuses[j].Application
+	})
+	return statuses
+}
+
+// syncFailed returns true if the last sync operation of the Application failed.
+func syncFailed(app *argov1alpha1.Application) bool {
+	return app.Status.OperationState != nil &&
+		(app.Status.OperationState.Phase == synccommon.OperationFailed || app.Status.OperationState.Phase == synccommon.OperationError)
+}
+
+// progressApplicationStatuses moves the Waiting Applications of the current rollout step to Pending, up to the
+// maxUpdate of the step, and returns the Applications which need to be synced. A step is started once all the
+// Applications of the previous steps are Healthy. Applications whose last sync failed are only synced again after
+// the retry delay, and the time until the next retry is returned.
+func progressApplicationStatuses(applicationSet *argoprojiov1alpha1.ApplicationSet, applications []argov1alpha1.Application, appStepMap map[string]int, statuses []argoprojiov1alpha1.ApplicationSetApplicationStatus) ([]argoprojiov1alpha1.ApplicationSetApplicationStatus, []argov1alpha1.Application, time.Duration) {
+	appMap := map[string]argov1alpha1.Application{}
+	for _, app := range applications {
+		appMap[app.Name] = app
+	}
+
+	steps := applicationSet.Spec.Strategy.RollingSync.Steps
+	// the last step holds the Applications which are not selected by any step
+	stepStatuses := make([][]int, len(steps)+1)
+	for i, status := range statuses {
+		step := appStepMap[status.Application]
+		stepStatuses[step] = append(stepStatuses[step], i)
+	}
+
+	now := metav1.Now()
+	var appsToSync []argov1alpha1.Application
+	var requeueAfter time.Duration
+	for step, indexes := range stepStatuses {
+		if len(indexes) == 0 {
+			continue
+		}
+
+		maxUpdate := len(indexes)
+		if step < len(steps) && steps[step].MaxUpdate != nil {
+			scaled, err := intstr.GetScaledValueFromIntOrPercent(steps[step].MaxUpdate, len(indexes), false)
+			if err != nil {
+				log.WithError(err).WithField("appSet", applicationSet.Name).Warnf("invalid maxUpdate in step %d of the rollout strategy, syncing all Applications of the step", step+1)
+			} else {
+				maxUpdate = scaled
+			}
+			// Always allow at least one Application to be updated, otherwise the rollout would never progress
+			if maxUpdate < 1 {
+				maxUpdate = 1
+			}
+		}
+
+		updating := 0
+		for _, i := range indexes {
+			if statuses[i].Status == argoprojiov1alpha1.ApplicationSetApplicationStatusPending || statuses[i].Status == argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing {
+				updating++
+			}
+		}
+
+		stepHealthy := true
+		for _, i := range indexes {
+			if statuses[i].Status == argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting && updating < maxUpdate {
+				if retryAfter := syncRetryAfter(appMap[statuses[i].Application], now.Time); retryAfter > 0 {
+					if requeueAfter == 0 || retryAfter < requeueAfter {
+						requeueAfter = retryAfter
+					}
+					stepHealthy = false
+					continue
+				}
+				statuses[i].Status = argoprojiov1alpha1.ApplicationSetApplicationStatusPending
+				statuses[i].Message = "Application moved to Pending status, watching for the Application resource to start Progressing."
+				statuses[i].LastTransitionTime = &now
+				appsToSync = append(appsToSync, appMap[statuses[i].Application])
+				updating++
+			}
+			if statuses[i].Status != argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy {
+				stepHealthy = false
+			}
+		}
+
+		// The next steps only start once every Application of this step is Healthy
+		if !stepHealthy {
+			break
+		}
+	}
+	return statuses, appsToSync, requeueAfter
+}
+
+// syncRetryAfter returns the time left until an Application whose last sync failed may be synced again, or zero if it
+// can be synced right away.
+func syncRetryAfter(app argov1alpha1.Application, now time.Time) time.Duration {
+	if !syncFailed(&app) || app.Status.OperationState.FinishedAt == nil {
+		return 0
+	}
+	if retryAfter := app.Status.OperationState.FinishedAt.Add(rollingSyncRetryDelay).Sub(now); retryAfter > 0 {
+		return retryAfter
+	}
+	return 0
+}
+
+// syncApplication requests a sync operation on the Application, in the same way as the automated sync of the
+// application controller does.
+func (r *ApplicationSetReconciler) syncApplication(ctx context.Context, applicationSet *argoprojiov1alpha1.ApplicationSet, app *argov1alpha1.Application, prune bool) error {
+	appLog := log.WithFields(log.Fields{"app": app.Name, "appSet": applicationSet.Name})
+
+	var syncOptions argov1alpha1.SyncOptions
+	if app.Spec.SyncPolicy != nil {
+		syncOptions = app.Spec.SyncPolicy.SyncOptions
+	}
+	app.Operation = &argov1alpha1.Operation{
+		InitiatedBy: argov1alpha1.OperationInitiator{
+			Username:  RollingSyncInitiator,
+			Automated: true,
+		},
+		Info: []*argov1alpha1.Info{
+			{
+				Name:  "Reason",
+				Value: "ApplicationSet RollingSync triggered a sync of this Application resource.",
+			},
+		},
+		Sync: &argov1alpha1.SyncOperation{
+			Prune:       prune,
+			SyncOptions: syncOptions,
+		},
+	}
+
+	if err := r.Client.Update(ctx, app); err != nil {
+		appLog.WithError(err).Error("failed to trigger the sync of the Application")
+		return err
+	}
+	r.Recorder.Eventf(applicationSet, corev1.EventTypeNormal, "Synced", "Triggered sync of Application %q", app.Name)
+	appLog.Info("triggered sync of Application by the rollout strategy")
+	return nil
+}
+
+// setApplicationSetApplicationStatus updates the rollout status of the Applications in the ApplicationSet status.
+func (r *ApplicationSetReconciler) setApplicationSetApplicationStatus(ctx context.Context, applicationSet *argoprojiov1alpha1.ApplicationSet, statuses []argoprojiov1alpha1.ApplicationSetApplicationStatus) error {
+	if equalApplicationStatuses(applicationSet.Status.ApplicationStatus, statuses) {
+		return nil
+	}
+
+	// fetch updated Application Set object before updating it
+	namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+	if err := r.Get(ctx, namespacedName, applicationSet); err != nil {
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error fetching updated application set: %v", err)
+	}
+
+	applicationSet.Status.ApplicationStatus = statuses
+
+	if err := r.Client.Status().Update(ctx, applicationSet); err != nil {
+		return fmt.Errorf("unable to set application set application status: %v", err)
+	}
+	return nil
+}
+
+func equalApplicationStatuses(a, b []argoprojiov1alpha1.ApplicationSetApplicationStatus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Application != b[i].Application || a[i].Status != b[i].Status || a[i].Step != b[i].Step || a[i].Message != b[i].Message {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,565 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
+func rollingSyncAppSet(steps ...argoprojiov1alpha1.ApplicationSetRolloutStep) *argoprojiov1alpha1.ApplicationSet {
+	return &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Strategy: &argoprojiov1alpha1.ApplicationSetStrategy{
+				Type: argoprojiov1alpha1.ApplicationSetStrategyTypeRollingSync,
+				RollingSync: &argoprojiov1alpha1.ApplicationSetRolloutStrategy{
+					Steps: steps,
+				},
+			},
+		},
+	}
+}
+
+func envStep(maxUpdate *intstr.IntOrString, envs ...string) argoprojiov1alpha1.ApplicationSetRolloutStep {
+	return argoprojiov1alpha1.ApplicationSetRolloutStep{
+		MatchExpressions: []argoprojiov1alpha1.ApplicationMatchExpression{
+			{Key: "env", Operator: "In", Values: envs},
+		},
+		MaxUpdate: maxUpdate,
+	}
+}
+
+func rollingSyncApp(name string, env string, syncStatus argov1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) argov1alpha1.Application {
+	return argov1alpha1.Application{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Application",
+			APIVersion: "argoproj.io/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "argocd",
+			Labels:    map[string]string{"env": env},
+		},
+		Spec: argov1alpha1.ApplicationSpec{
+			Project: "default",
+		},
+		Status: argov1alpha1.ApplicationStatus{
+			Sync:   argov1alpha1.SyncStatus{Status: syncStatus},
+			Health: argov1alpha1.HealthStatus{Status: healthStatus},
+		},
+	}
+}
+
+func TestIsRollingSync(t *testing.T) {
+	assert.False(t, isRollingSync(&argoprojiov1alpha1.ApplicationSet{}))
+	assert.False(t, isRollingSync(rollingSyncAppSet()))
+	assert.True(t, isRollingSync(rollingSyncAppSet(envStep(nil, "dev"))))
+
+	appSet := rollingSyncAppSet(envStep(nil, "dev"))
+	appSet.Spec.Strategy.Type = "AllAtOnce"
+	assert.False(t, isRollingSync(appSet))
+}
+
+func TestRemoveAutomatedSyncPolicy(t *testing.T) {
+	apps := []argov1alpha1.Application{
+		{Spec: argov1alpha1.ApplicationSpec{SyncPolicy: &argov1alpha1.SyncPolicy{Automated: &argov1alpha1.SyncPolicyAutomated{Prune: true}}}},
+		{Spec: argov1alpha1.ApplicationSpec{SyncPolicy: &argov1alpha1.SyncPolicy{
+			Automated:   &argov1alpha1.SyncPolicyAutomated{},
+			SyncOptions: argov1alpha1.SyncOptions{"CreateNamespace=true"},
+		}}},
+		{},
+	}
+
+	res := removeAutomatedSyncPolicy(apps)
+
+	assert.Nil(t, res[0].Spec.SyncPolicy)
+	assert.Equal(t, &argov1alpha1.SyncPolicy{SyncOptions: argov1alpha1.SyncOptions{"CreateNamespace=true"}}, res[1].Spec.SyncPolicy)
+	assert.Nil(t, res[2].Spec.SyncPolicy)
+	// the input applications must not be modified
+	assert.NotNil(t, apps[0].Spec.SyncPolicy.Automated)
+}
+
+func TestBuildAppStepMap(t *testing.T) {
+	for _, c := range []struct {
+		name          string
+		steps         []argoprojiov1alpha1.ApplicationSetRolloutStep
+		expected      map[string]int
+		expectedError string
+	}{
+		{
+			name:     "applications are assigned to the matching step",
+			steps:    []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(nil, "dev"), envStep(nil, "staging", "prod")},
+			expected: map[string]int{"app-dev": 0, "app-staging": 1, "app-prod": 1},
+		},
+		{
+			name:     "applications which match no step are assigned to a final step",
+			steps:    []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(nil, "prod")},
+			expected: map[string]int{"app-dev": 1, "app-staging": 1, "app-prod": 0},
+		},
+		{
+			name: "applications are assigned to the first matching step",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{
+				{MatchExpressions: []argoprojiov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "NotIn", Values: []string{"prod"}}}},
+				{MatchExpressions: []argoprojiov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "Exists"}}},
+			},
+			expected: map[string]int{"app-dev": 0, "app-staging": 0, "app-prod": 1},
+		},
+		{
+			name: "invalid operator",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{
+				{MatchExpressions: []argoprojiov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "Unknown", Values: []string{"prod"}}}},
+			},
+			expectedError: "invalid match expression in step 1 of the rollout strategy",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			apps := []argov1alpha1.Application{
+				rollingSyncApp("app-dev", "dev", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+				rollingSyncApp("app-staging", "staging", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+				rollingSyncApp("app-prod", "prod", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+			}
+
+			res, err := buildAppStepMap(rollingSyncAppSet(c.steps...), apps)
+
+			if c.expectedError != "" {
+				assert.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, res)
+		})
+	}
+}
+
+func TestUpdateApplicationStatuses(t *testing.T) {
+	before := metav1.NewTime(time.Now().Add(-time.Minute))
+	after := metav1.NewTime(time.Now())
+
+	for _, c := range []struct {
+		name           string
+		app            argov1alpha1.Application
+		previousStatus *argoprojiov1alpha1.ApplicationSetApplicationStatusType
+		expectedStatus argoprojiov1alpha1.ApplicationSetApplicationStatusType
+	}{
+		{
+			name:           "new healthy application",
+			app:            rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+		},
+		{
+			name:           "new out of sync application",
+			app:            rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+		},
+		{
+			name:           "healthy application becomes out of sync",
+			app:            rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+		},
+		{
+			name:           "waiting application is synced manually",
+			app:            rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+		},
+		{
+			name:           "pending application without operation",
+			app:            rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusPending),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+		},
+		{
+			name: "pending application with a running operation",
+			app: func() argov1alpha1.Application {
+				app := rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+				app.Operation = &argov1alpha1.Operation{Sync: &argov1alpha1.SyncOperation{}}
+				return app
+			}(),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusPending),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing,
+		},
+		{
+			name: "pending application with an operation completed since the transition",
+			app: func() argov1alpha1.Application {
+				app := rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+				app.Status.OperationState = &argov1alpha1.OperationState{Phase: synccommon.OperationSucceeded, StartedAt: after}
+				return app
+			}(),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusPending),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing,
+		},
+		{
+			name:           "progressing application becomes healthy",
+			app:            rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+		},
+		{
+			name:           "progressing application is still degraded",
+			app:            rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeSynced, health.HealthStatusDegraded),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing,
+		},
+		{
+			name: "progressing application fails to sync",
+			app: func() argov1alpha1.Application {
+				app := rollingSyncApp("app", "dev", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+				app.Status.OperationState = &argov1alpha1.OperationState{Phase: synccommon.OperationFailed, StartedAt: after, FinishedAt: &after}
+				return app
+			}(),
+			previousStatus: statusPtr(argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing),
+			expectedStatus: argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := rollingSyncAppSet(envStep(nil, "dev"))
+			if c.previousStatus != nil {
+				appSet.Status.ApplicationStatus = []argoprojiov1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app", Status: *c.previousStatus, Step: "1", LastTransitionTime: &before},
+				}
+			}
+
+			res := updateApplicationStatuses(appSet, []argov1alpha1.Application{c.app}, map[string]int{"app": 0})
+
+			assert.Len(t, res, 1)
+			assert.Equal(t, "app", res[0].Application)
+			assert.Equal(t, "1", res[0].Step)
+			assert.Equal(t, c.expectedStatus, res[0].Status)
+			assert.NotNil(t, res[0].LastTransitionTime)
+			if c.previousStatus != nil && *c.previousStatus == c.expectedStatus {
+				assert.Equal(t, &before, res[0].LastTransitionTime)
+			}
+		})
+	}
+}
+
+func statusPtr(status argoprojiov1alpha1.ApplicationSetApplicationStatusType) *argoprojiov1alpha1.ApplicationSetApplicationStatusType {
+	return &status
+}
+
+func TestProgressApplicationStatuses(t *testing.T) {
+	maxUpdateOne := intstr.FromInt(1)
+	maxUpdateHalf := intstr.FromString("50%")
+	maxUpdateZero := intstr.FromInt(0)
+
+	for _, c := range []struct {
+		name           string
+		steps          []argoprojiov1alpha1.ApplicationSetRolloutStep
+		statuses       map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType
+		expected       map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType
+		expectedToSync []string
+	}{
+		{
+			name:  "only the first step is started",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(nil, "dev"), envStep(nil, "prod")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expectedToSync: []string{"dev-1", "dev-2"},
+		},
+		{
+			name:  "the next step is started once the previous step is healthy",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(nil, "dev"), envStep(nil, "prod")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+			},
+			expectedToSync: []string{"prod-1"},
+		},
+		{
+			name:  "the next step waits for the progressing applications",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(nil, "dev"), envStep(nil, "prod")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+		},
+		{
+			name:  "maxUpdate limits the number of updated applications",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(&maxUpdateOne, "dev")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+				"dev-2": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1": argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+				"dev-2": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expectedToSync: []string{"dev-1"},
+		},
+		{
+			name:  "maxUpdate takes the progressing applications into account",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(&maxUpdateHalf, "dev")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1": argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing,
+				"dev-2": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1": argoprojiov1alpha1.ApplicationSetApplicationStatusProgressing,
+				"dev-2": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+		},
+		{
+			name:  "a maxUpdate of zero still updates one application",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(&maxUpdateZero, "dev")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+				"dev-2": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1": argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+				"dev-2": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expectedToSync: []string{"dev-1"},
+		},
+		{
+			name:  "applications which match no step are started after the last step",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(nil, "dev")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"dev-2":  argoprojiov1alpha1.ApplicationSetApplicationStatusHealthy,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+			},
+			expectedToSync: []string{"prod-1"},
+		},
+		{
+			name:  "applications which match no step wait for the other steps",
+			steps: []argoprojiov1alpha1.ApplicationSetRolloutStep{envStep(nil, "dev")},
+			statuses: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expected: map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{
+				"dev-1":  argoprojiov1alpha1.ApplicationSetApplicationStatusPending,
+				"prod-1": argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting,
+			},
+			expectedToSync: []string{"dev-1"},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := rollingSyncAppSet(c.steps...)
+
+			var apps []argov1alpha1.Application
+			var statuses []argoprojiov1alpha1.ApplicationSetApplicationStatus
+			for _, name := range []string{"dev-1", "dev-2", "prod-1"} {
+				status, ok := c.statuses[name]
+				if !ok {
+					continue
+				}
+				apps = append(apps, rollingSyncApp(name, name[:len(name)-2], argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy))
+				statuses = append(statuses, argoprojiov1alpha1.ApplicationSetApplicationStatus{Application: name, Status: status})
+			}
+			appStepMap, err := buildAppStepMap(appSet, apps)
+			assert.NoError(t, err)
+
+			res, appsToSync, requeueAfter := progressApplicationStatuses(appSet, apps, appStepMap, statuses)
+			assert.Zero(t, requeueAfter)
+
+			actual := map[string]argoprojiov1alpha1.ApplicationSetApplicationStatusType{}
+			for _, status := range res {
+				actual[status.Application] = status.Status
+			}
+			assert.Equal(t, c.expected, actual)
+
+			var actualToSync []string
+			for _, app := range appsToSync {
+				actualToSync = append(actualToSync, app.Name)
+			}
+			assert.Equal(t, c.expectedToSync, actualToSync)
+		})
+	}
+}
+
+func TestProgressApplicationStatuses_FailedSync(t *testing.T) {
+	appSet := rollingSyncAppSet(envStep(nil, "dev"), envStep(nil, "prod"))
+	statuses := []argoprojiov1alpha1.ApplicationSetApplicationStatus{
+		{Application: "dev-1", Status: argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting},
+		{Application: "prod-1", Status: argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting},
+	}
+	failedApp := func(finishedAt time.Time) []argov1alpha1.Application {
+		app := rollingSyncApp("dev-1", "dev", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		finished := metav1.NewTime(finishedAt)
+		app.Status.OperationState = &argov1alpha1.OperationState{Phase: synccommon.OperationFailed, FinishedAt: &finished}
+		return []argov1alpha1.Application{app, rollingSyncApp("prod-1", "prod", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)}
+	}
+
+	t.Run("the sync is not retried before the retry delay", func(t *testing.T) {
+		apps := failedApp(time.Now().Add(-time.Minute))
+		appStepMap, err := buildAppStepMap(appSet, apps)
+		assert.NoError(t, err)
+
+		res, appsToSync, requeueAfter := progressApplicationStatuses(appSet, apps, appStepMap, append([]argoprojiov1alpha1.ApplicationSetApplicationStatus{}, statuses...))
+
+		assert.Empty(t, appsToSync)
+		assert.Equal(t, argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, res[0].Status)
+		assert.Equal(t, argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, res[1].Status)
+		assert.Greater(t, requeueAfter, time.Duration(0))
+		assert.LessOrEqual(t, requeueAfter, rollingSyncRetryDelay-time.Minute)
+	})
+
+	t.Run("the sync is retried after the retry delay", func(t *testing.T) {
+		apps := failedApp(time.Now().Add(-rollingSyncRetryDelay - time.Minute))
+		appStepMap, err := buildAppStepMap(appSet, apps)
+		assert.NoError(t, err)
+
+		res, appsToSync, requeueAfter := progressApplicationStatuses(appSet, apps, appStepMap, append([]argoprojiov1alpha1.ApplicationSetApplicationStatus{}, statuses...))
+
+		if assert.Len(t, appsToSync, 1) {
+			assert.Equal(t, "dev-1", appsToSync[0].Name)
+		}
+		assert.Equal(t, argoprojiov1alpha1.ApplicationSetApplicationStatusPending, res[0].Status)
+		assert.Equal(t, argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, res[1].Status)
+		assert.Zero(t, requeueAfter)
+	})
+}
+
+func TestPerformRollingSync(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argoprojiov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+	err = argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	appSet := rollingSyncAppSet(envStep(nil, "dev"), envStep(nil, "prod"))
+	appSet.Spec.Template.Spec.SyncPolicy = &argov1alpha1.SyncPolicy{
+		Automated:   &argov1alpha1.SyncPolicyAutomated{Prune: true},
+		SyncOptions: argov1alpha1.SyncOptions{"CreateNamespace=true"},
+	}
+
+	devApp := rollingSyncApp("app-dev", "dev", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	devApp.Spec.SyncPolicy = &argov1alpha1.SyncPolicy{SyncOptions: argov1alpha1.SyncOptions{"CreateNamespace=true"}}
+	prodApp := rollingSyncApp("app-prod", "prod", argov1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	controller := true
+	for _, app := range []*argov1alpha1.Application{&devApp, &prodApp} {
+		app.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: argoprojiov1alpha1.GroupVersion.String(),
+			Kind:       "ApplicationSet",
+			Name:       appSet.Name,
+			Controller: &controller,
+		}}
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet, &devApp, &prodApp).Build()
+
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(2),
+	}
+
+	requeueAfter, err := r.performRollingSync(context.TODO(), appSet)
+	assert.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+
+	updatedDevApp := &argov1alpha1.Application{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "argocd", Name: "app-dev"}, updatedDevApp)
+	assert.NoError(t, err)
+	if assert.NotNil(t, updatedDevApp.Operation) {
+		assert.Equal(t, RollingSyncInitiator, updatedDevApp.Operation.InitiatedBy.Username)
+		assert.True(t, updatedDevApp.Operation.InitiatedBy.Automated)
+		assert.True(t, updatedDevApp.Operation.Sync.Prune)
+		assert.Equal(t, argov1alpha1.SyncOptions{"CreateNamespace=true"}, updatedDevApp.Operation.Sync.SyncOptions)
+	}
+
+	updatedProdApp := &argov1alpha1.Application{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "argocd", Name: "app-prod"}, updatedProdApp)
+	assert.NoError(t, err)
+	assert.Nil(t, updatedProdApp.Operation)
+
+	updatedAppSet := &argoprojiov1alpha1.ApplicationSet{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "argocd", Name: appSet.Name}, updatedAppSet)
+	assert.NoError(t, err)
+	if assert.Len(t, updatedAppSet.Status.ApplicationStatus, 2) {
+		assert.Equal(t, "app-dev", updatedAppSet.Status.ApplicationStatus[0].Application)
+		assert.Equal(t, argoprojiov1alpha1.ApplicationSetApplicationStatusPending, updatedAppSet.Status.ApplicationStatus[0].Status)
+		assert.Equal(t, "1", updatedAppSet.Status.ApplicationStatus[0].Step)
+		assert.Equal(t, "app-prod", updatedAppSet.Status.ApplicationStatus[1].Application)
+		assert.Equal(t, argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, updatedAppSet.Status.ApplicationStatus[1].Status)
+		assert.Equal(t, "2", updatedAppSet.Status.ApplicationStatus[1].Step)
+	}
+}
+
+func TestSetApplicationSetApplicationStatus_Errors(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argoprojiov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	statuses := []argoprojiov1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app-dev", Status: argoprojiov1alpha1.ApplicationSetApplicationStatusWaiting, Step: "1"},
+	}
+	notFound := apierr.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applicationsets"}, "name")
+	cases := []struct {
+		name          string
+		getErr        error
+		updateErr     error
+		expectedError string
+	}{
+		{name: "status update fails", updateErr: fmt.Errorf("conflict"), expectedError: "unable to set application set application status: conflict"},
+		{name: "fetching the ApplicationSet fails", getErr: fmt.Errorf("timeout"), expectedError: "error fetching updated application set: timeout"},
+		{name: "ApplicationSet deleted", getErr: notFound},
+	}
+
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			appSet := rollingSyncAppSet(envStep(nil, "dev"))
+			client := &failingStatusClient{
+				Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet.DeepCopy()).Build(),
+				getErr:    cc.getErr,
+				updateErr: cc.updateErr,
+			}
+			r := ApplicationSetReconciler{Client: client, Scheme: scheme}
+
+			err := r.setApplicationSetApplicationStatus(context.TODO(), appSet, statuses)
+			if cc.expectedError != "" {
+				assert.EqualError(t, err, cc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -86,7 +86,7 @@ func TestGenerateParams(t *testing.T) {
 		name     string
 		selector metav1.LabelSelector
 		values   map[string]string
-		expected []map[string]string
+		expected []map[string]interface{}
 		// clientError is true if a k8s client error should be simulated
 		clientError   bool
 		expectedError error
@@ -103,7 +103,7 @@ func TestGenerateParams(t *testing.T) {
 				"bat":   "{{ metadata.labels.environment }}",
 				"aaa":   "{{ server }}",
 				"no-op": "{{ this-does-not-exist }}",
-			}, expected: []map[string]string{
+			}, expected: []map[string]interface{}{
 				{"values.lol1": "lol", "values.lol2": "{{values.lol1}}{{values.lol1}}", "values.lol3": "{{values.lol2}}{{values.lol2}}{{values.lol2}}", "values.foo": "bar", "values.bar": "production", "values.no-op": "{{ this-does-not-exist }}", "values.bat": "production", "values.aaa": "https://production-01.example.com", "name": "production_01/west", "nameNormalized": "production-01-west", "server": "https://production-01.example.com", "metadata.labels.environment": "production", "metadata.labels.org": "bar",
 					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.annotations.foo.argoproj.io": "production"},
 
@@ -123,7 +123,7 @@ func TestGenerateParams(t *testing.T) {
 				},
 			},
 			values: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"name": "production_01/west", "nameNormalized": "production-01-west", "server": "https://production-01.example.com", "metadata.labels.environment": "production", "metadata.labels.org": "bar",
 					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.annotations.foo.argoproj.io": "production"},
 
@@ -143,7 +143,7 @@ func TestGenerateParams(t *testing.T) {
 			values: map[string]string{
 				"foo": "bar",
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"values.foo": "bar", "name": "production_01/west", "nameNormalized": "production-01-west", "server": "https://production-01.example.com", "metadata.labels.environment": "production", "metadata.labels.org": "bar",
 					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.annotations.foo.argoproj.io": "production"},
 			},
@@ -167,7 +167,7 @@ func TestGenerateParams(t *testing.T) {
 			values: map[string]string{
 				"foo": "bar",
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"values.foo": "bar", "name": "staging-01", "nameNormalized": "staging-01", "server": "https://staging-01.example.com", "metadata.labels.environment": "staging", "metadata.labels.org": "foo",
 					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.annotations.foo.argoproj.io": "staging"},
 				{"values.foo": "bar", "name": "production_01/west", "nameNormalized": "production-01-west", "server": "https://production-01.example.com", "metadata.labels.environment": "production", "metadata.labels.org": "bar",
@@ -196,7 +196,7 @@ func TestGenerateParams(t *testing.T) {
 			values: map[string]string{
 				"name": "baz",
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"values.name": "baz", "name": "staging-01", "nameNormalized": "staging-01", "server": "https://staging-01.example.com", "metadata.labels.environment": "staging", "metadata.labels.org": "foo",
 					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.annotations.foo.argoproj.io": "staging"},
 			},
@@ -238,7 +238,7 @@ func TestGenerateParams(t *testing.T) {
 					Selector: testCase.selector,
 					Values:   testCase.values,
 				},
-			}, nil)
+			}, &argoappsetv1alpha1.ApplicationSet{})
 
 			if testCase.expectedError != nil {
 				assert.EqualError(t, err, testCase.expectedError.Error())
@@ -251,6 +251,80 @@ func TestGenerateParams(t *testing.T) {
 	}
 }
 
+func TestGenerateParamsGoTemplate(t *testing.T) {
+	clusters := []client.Object{
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "production-01",
+				Namespace: "namespace",
+				Labels: map[string]string{
+					"argocd.argoproj.io/secret-type": "cluster",
+					"environment":                    "production",
+				},
+				Annotations: map[string]string{
+					"foo.argoproj.io": "production",
+				},
+			},
+			Data: map[string][]byte{
+				"config": []byte("{}"),
+				"name":   []byte("production_01/west"),
+				"server": []byte("https://production-01.example.com"),
+			},
+			Type: corev1.SecretType("Opaque"),
+		},
+	}
+
+	appClientset := kubefake.NewSimpleClientset(clusters[0])
+	fakeClient := fake.NewClientBuilder().WithObjects(clusters...).Build()
+	var clusterGenerator = NewClusterGenerator(fakeClient, context.Background(), appClientset, "namespace")
+
+	got, err := clusterGenerator.GenerateParams(&argoappsetv1alpha1.ApplicationSetGenerator{
+		Clusters: &argoappsetv1alpha1.ClusterGenerator{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"environment": "production",
+				},
+			},
+			Values: map[string]string{
+				"env":   "{{ .metadata.labels.environment | upper }}",
+				"owner": "{{ index .metadata.annotations \"foo.argoproj.io\" }}",
+				"url":   "{{ .server }}",
+			},
+		},
+	}, &argoappsetv1alpha1.ApplicationSet{
+		Spec: argoappsetv1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"name":           "production_01/west",
+			"nameNormalized": "production-01-west",
+			"server":         "https://production-01.example.com",
+			"metadata": map[string]interface{}{
+				"labels": map[string]string{
+					"argocd.argoproj.io/secret-type": "cluster",
+					"environment":                    "production",
+				},
+				"annotations": map[string]string{
+					"foo.argoproj.io": "production",
+				},
+			},
+			"values": map[string]interface{}{
+				"env":   "PRODUCTION",
+				"owner": "production",
+				"url":   "https://production-01.example.com",
+			},
+		},
+	}, got)
+}
+
 func TestSanitizeClusterName(t *testing.T) {
 	t.Run("valid DNS-1123 subdomain name", func(t *testing.T) {
 		assert.Equal(t, "cluster-name", sanitizeName("cluster-name"))
@@ -12,12 +12,12 @@ import (
 )
 
 type TransformResult struct {
-	Params   []map[string]string
+	Params   []map[string]interface{}
 	Template argoprojiov1alpha1.ApplicationSetTemplate
 }
 
 //Transform a spec generator to list of paramSets and a template
-func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, allGenerators map[string]Generator, baseTemplate argoprojiov1alpha1.ApplicationSetTemplate, appSet *argoprojiov1alpha1.ApplicationSet, genParams map[string]string) ([]TransformResult, error) {
+func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, allGenerators map[string]Generator, baseTemplate argoprojiov1alpha1.ApplicationSetTemplate, appSet *argoprojiov1alpha1.ApplicationSet, genParams map[string]interface{}) ([]TransformResult, error) {
 	res := []TransformResult{}
 	var firstError error
 	interpolatedGenerator := requestedGenerator.DeepCopy()
@@ -34,9 +34,9 @@ func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, al
 			}
 			continue
 		}
-		var params []map[string]string
+		var params []map[string]interface{}
 		if len(genParams) != 0 {
-			tempInterpolatedGenerator, err := interpolateGenerator(&requestedGenerator, genParams)
+			tempInterpolatedGenerator, err := interpolateGenerator(&requestedGenerator, genParams, appSet.Spec.GoTemplate)
 			interpolatedGenerator = &tempInterpolatedGenerator
 			if err != nil {
 				log.WithError(err).WithField("genParams", genParams).
@@ -97,7 +97,7 @@ func mergeGeneratorTemplate(g Generator, requestedGenerator *argoprojiov1alpha1.
 
 // Currently for Matrix Generator. Allows interpolating the matrix's 2nd child generator with values from the 1st child generator
 // "params" parameter is an array, where each index corresponds to a generator. Each index contains a map w/ that generator's parameters.
-func interpolateGenerator(requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator, params map[string]string) (argoprojiov1alpha1.ApplicationSetGenerator, error) {
+func interpolateGenerator(requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator, params map[string]interface{}, useGoTemplate bool) (argoprojiov1alpha1.ApplicationSetGenerator, error) {
 	interpolatedGenerator := requestedGenerator.DeepCopy()
 	tmplBytes, err := json.Marshal(interpolatedGenerator)
 	if err != nil {
@@ -106,14 +106,24 @@ func interpolateGenerator(requestedGenerator *argoprojiov1alpha1.ApplicationSetG
 	}
 
 	render := utils.Render{}
-	fstTmpl := fasttemplate.New(string(tmplBytes), "{{", "}}")
-	replacedTmplStr, err := render.Replace(fstTmpl, params, true)
-	if err != nil {
-		log.WithError(err).WithField("interpolatedGeneratorString", replacedTmplStr).Error("error interpolating generator with other generator's parameter")
-		return *interpolatedGenerator, err
+	var replacedTmplBytes []byte
+	if useGoTemplate {
+		replacedTmplBytes, err = render.ReplaceGoTemplateJSON(tmplBytes, params)
+		if err != nil {
+			log.WithError(err).WithField("interpolatedGeneratorString", string(tmplBytes)).Error("error interpolating generator with other generator's parameter")
+			return *interpolatedGenerator, err
+		}
+	} else {
+		fstTmpl := fasttemplate.New(string(tmplBytes), "{{", "}}")
+		replacedTmplStr, err := render.Replace(fstTmpl, params, true)
+		if err != nil {
+			log.WithError(err).WithField("interpolatedGeneratorString", replacedTmplStr).Error("error interpolating generator with other generator's parameter")
+			return *interpolatedGenerator, err
+		}
+		replacedTmplBytes = []byte(replacedTmplStr)
 	}
 
-	err = json.Unmarshal([]byte(replacedTmplStr), interpolatedGenerator)
+	err = json.Unmarshal(replacedTmplBytes, interpolatedGenerator)
 	if err != nil {
 		log.WithError(err).WithField("requestedGenerator", interpolatedGenerator).Error("error unmarshalling requested generator for interpolation")
 		return *interpolatedGenerator, err
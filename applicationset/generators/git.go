@@ -45,7 +45,7 @@ func (g *GitGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Appli
 	return DefaultRequeueAfterSeconds
 }
 
-func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, _ *argoprojiov1alpha1.ApplicationSet) ([]map[string]string, error) {
+func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet) ([]map[string]interface{}, error) {
 
 	if appSetGenerator == nil {
 		return nil, EmptyAppSetGeneratorError
@@ -56,11 +56,11 @@ func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Applic
 	}
 
 	var err error
-	var res []map[string]string
+	var res []map[string]interface{}
 	if appSetGenerator.Git.Directories != nil {
-		res, err = g.generateParamsForGitDirectories(appSetGenerator)
+		res, err = g.generateParamsForGitDirectories(appSetGenerator, appSet.Spec.GoTemplate)
 	} else if appSetGenerator.Git.Files != nil {
-		res, err = g.generateParamsForGitFiles(appSetGenerator)
+		res, err = g.generateParamsForGitFiles(appSetGenerator, appSet.Spec.GoTemplate)
 	} else {
 		return nil, EmptyAppSetGeneratorError
 	}
@@ -71,7 +71,7 @@ func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Applic
 	return res, nil
 }
 
-func (g *GitGenerator) generateParamsForGitDirectories(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) ([]map[string]string, error) {
+func (g *GitGenerator) generateParamsForGitDirectories(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, useGoTemplate bool) ([]map[string]interface{}, error) {
 
 	// Directories, not files
 	allPaths, err := g.repos.GetDirectories(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision)
@@ -88,12 +88,12 @@ func (g *GitGenerator) generateParamsForGitDirectories(appSetGenerator *argoproj
 
 	requestedApps := g.filterApps(appSetGenerator.Git.Directories, allPaths)
 
-	res := g.generateParamsFromApps(requestedApps, appSetGenerator)
+	res := g.generateParamsFromApps(requestedApps, appSetGenerator, useGoTemplate)
 
 	return res, nil
 }
 
-func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) ([]map[string]string, error) {
+func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, useGoTemplate bool) ([]map[string]interface{}, error) {
 
 	// Get all files that match the requested path string, removing duplicates
 	allFiles := make(map[string][]byte)
@@ -116,11 +116,11 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	sort.Strings(allPaths)
 
 	// Generate params from each path, and return
-	res := []map[string]string{}
+	res := []map[string]interface{}{}
 	for _, path := range allPaths {
 
 		// A JSON / YAML file path can contain multiple sets of parameters (ie it is an array)
-		paramsArray, err := g.generateParamsFromGitFile(path, allFiles[path])
+		paramsArray, err := g.generateParamsFromGitFile(path, allFiles[path], useGoTemplate)
 		if err != nil {
 			return nil, fmt.Errorf("unable to process file '%s': %v", path, err)
 		}
@@ -132,7 +132,7 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	return res, nil
 }
 
-func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte) ([]map[string]string, error) {
+func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte, useGoTemplate bool) ([]map[string]interface{}, error) {
 	objectsFound := []map[string]interface{}{}
 
 	// First, we attempt to parse as an array
@@ -147,27 +147,45 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 		objectsFound = append(objectsFound, singleObj)
 	}
 
-	res := []map[string]string{}
+	res := []map[string]interface{}{}
 
-	// Flatten all objects found, and return them
 	for _, objectFound := range objectsFound {
 
-		flat, err := flatten.Flatten(objectFound, "", flatten.DotStyle)
-		if err != nil {
-			return nil, err
-		}
-		params := map[string]string{}
-		for k, v := range flat {
-			params[k] = fmt.Sprintf("%v", v)
-		}
-		params["path"] = path.Dir(filePath)
-		params["path.basename"] = path.Base(params["path"])
-		params["path.filename"] = path.Base(filePath)
-		params["path.basenameNormalized"] = sanitizeName(path.Base(params["path"]))
-		params["path.filenameNormalized"] = sanitizeName(path.Base(params["path.filename"]))
-		for k, v := range strings.Split(params["path"], "/") {
-			if len(v) > 0 {
-				params["path["+strconv.Itoa(k)+"]"] = v
+		params := map[string]interface{}{}
+
+		if useGoTemplate {
+			// Go templates can access the nested objects directly, so they are passed through as-is
+			for k, v := range objectFound {
+				params[k] = v
+			}
+
+			paramPath := map[string]interface{}{}
+			paramPath["path"] = path.Dir(filePath)
+			paramPath["basename"] = path.Base(path.Dir(filePath))
+			paramPath["filename"] = path.Base(filePath)
+			paramPath["basenameNormalized"] = sanitizeName(path.Base(path.Dir(filePath)))
+			paramPath["filenameNormalized"] = sanitizeName(path.Base(filePath))
+			paramPath["segments"] = strings.Split(path.Dir(filePath), "/")
+			params["path"] = paramPath
+		} else {
+			// Flatten all objects found, and return them
+			flat, err := flatten.Flatten(objectFound, "", flatten.DotStyle)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range flat {
+				params[k] = fmt.Sprintf("%v", v)
+			}
+			dir := path.Dir(filePath)
+			params["path"] = dir
+			params["path.basename"] = path.Base(dir)
+			params["path.filename"] = path.Base(filePath)
+			params["path.basenameNormalized"] = sanitizeName(path.Base(dir))
+			params["path.filenameNormalized"] = sanitizeName(path.Base(filePath))
+			for k, v := range strings.Split(dir, "/") {
+				if len(v) > 0 {
+					params["path["+strconv.Itoa(k)+"]"] = v
+				}
 			}
 		}
 		res = append(res, params)
@@ -205,19 +223,29 @@ func (g *GitGenerator) filterApps(Directories []argoprojiov1alpha1.GitDirectoryG
 	return res
 }
 
-func (g *GitGenerator) generateParamsFromApps(requestedApps []string, _ *argoprojiov1alpha1.ApplicationSetGenerator) []map[string]string {
+func (g *GitGenerator) generateParamsFromApps(requestedApps []string, _ *argoprojiov1alpha1.ApplicationSetGenerator, useGoTemplate bool) []map[string]interface{} {
 	// TODO: At some point, the appicationSetGenerator param should be used
 
-	res := make([]map[string]string, len(requestedApps))
+	res := make([]map[string]interface{}, len(requestedApps))
 	for i, a := range requestedApps {
 
-		params := make(map[string]string, 2)
-		params["path"] = a
-		params["path.basename"] = path.Base(a)
-		params["path.basenameNormalized"] = sanitizeName(path.Base(a))
-		for k, v := range strings.Split(params["path"], "/") {
-			if len(v) > 0 {
-				params["path["+strconv.Itoa(k)+"]"] = v
+		params := make(map[string]interface{}, 5)
+
+		if useGoTemplate {
+			paramPath := map[string]interface{}{}
+			paramPath["path"] = a
+			paramPath["basename"] = path.Base(a)
+			paramPath["basenameNormalized"] = sanitizeName(path.Base(a))
+			paramPath["segments"] = strings.Split(a, "/")
+			params["path"] = paramPath
+		} else {
+			params["path"] = a
+			params["path.basename"] = path.Base(a)
+			params["path.basenameNormalized"] = sanitizeName(path.Base(a))
+			for k, v := range strings.Split(a, "/") {
+				if len(v) > 0 {
+					params["path["+strconv.Itoa(k)+"]"] = v
+				}
 			}
 		}
 		res[i] = params
@@ -51,11 +51,11 @@ func Test_generateParamsFromGitFile(t *testing.T) {
 	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
 foo:
   bar: baz
-`))
+`), false)
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.Equal(t, []map[string]string{
+	assert.Equal(t, []map[string]interface{}{
 		{
 			"foo.bar":                 "baz",
 			"path":                    "path/dir",
@@ -69,6 +69,34 @@ foo:
 	}, params)
 }
 
+func Test_generateParamsFromGitFileGoTemplate(t *testing.T) {
+	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
+foo:
+  bar: baz
+`), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"foo": map[string]interface{}{
+				"bar": "baz",
+			},
+			"path": map[string]interface{}{
+				"path":               "path/dir",
+				"basename":           "dir",
+				"filename":           "file_name.yaml",
+				"basenameNormalized": "dir",
+				"filenameNormalized": "file-name.yaml",
+				"segments": []string{
+					"path",
+					"dir",
+				},
+			},
+		},
+	}, params)
+}
+
 func TestGitGenerateParamsFromDirectories(t *testing.T) {
 
 	cases := []struct {
@@ -76,7 +104,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 		directories   []argoprojiov1alpha1.GitDirectoryGeneratorItem
 		repoApps      []string
 		repoError     error
-		expected      []map[string]string
+		expected      []map[string]interface{}
 		expectedError error
 	}{
 		{
@@ -89,7 +117,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 				"p1/app4",
 			},
 			repoError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"path": "app1", "path.basename": "app1", "path.basenameNormalized": "app1", "path[0]": "app1"},
 				{"path": "app2", "path.basename": "app2", "path.basenameNormalized": "app2", "path[0]": "app2"},
 				{"path": "app_3", "path.basename": "app_3", "path.basenameNormalized": "app-3", "path[0]": "app_3"},
@@ -106,7 +134,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 				"p1/p2/p3/app4",
 			},
 			repoError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"path": "p1/app2", "path.basename": "app2", "path[0]": "p1", "path[1]": "app2", "path.basenameNormalized": "app2"},
 				{"path": "p1/p2/app3", "path.basename": "app3", "path[0]": "p1", "path[1]": "p2", "path[2]": "app3", "path.basenameNormalized": "app3"},
 			},
@@ -123,7 +151,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 				"p2/app3",
 			},
 			repoError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"path": "app1", "path.basename": "app1", "path[0]": "app1", "path.basenameNormalized": "app1"},
 				{"path": "app2", "path.basename": "app2", "path[0]": "app2", "path.basenameNormalized": "app2"},
 				{"path": "p2/app3", "path.basename": "app3", "path[0]": "p2", "path[1]": "app3", "path.basenameNormalized": "app3"},
@@ -141,7 +169,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 				"p2/app3",
 			},
 			repoError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"path": "app1", "path.basename": "app1", "path[0]": "app1", "path.basenameNormalized": "app1"},
 				{"path": "app2", "path.basename": "app2", "path[0]": "app2", "path.basenameNormalized": "app2"},
 				{"path": "p2/app3", "path.basename": "app3", "path[0]": "p2", "path[1]": "app3", "path.basenameNormalized": "app3"},
@@ -153,7 +181,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 			directories:   []argoprojiov1alpha1.GitDirectoryGeneratorItem{{Path: "*"}},
 			repoApps:      []string{},
 			repoError:     nil,
-			expected:      []map[string]string{},
+			expected:      []map[string]interface{}{},
 			expectedError: nil,
 		},
 		{
@@ -161,7 +189,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 			directories:   []argoprojiov1alpha1.GitDirectoryGeneratorItem{{Path: "*"}},
 			repoApps:      []string{},
 			repoError:     fmt.Errorf("error"),
-			expected:      []map[string]string{},
+			expected:      []map[string]interface{}{},
 			expectedError: fmt.Errorf("error"),
 		},
 	}
@@ -192,7 +220,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 				},
 			}
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], nil)
+			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo)
 
 			if testCaseCopy.expectedError != nil {
 				assert.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -217,7 +245,7 @@ func TestGitGenerateParamsFromFiles(t *testing.T) {
 		repoFileContents map[string][]byte
 		// if repoPathsError is non-nil, the call to GetPaths(...) will return this error value
 		repoPathsError error
-		expected       []map[string]string
+		expected       []map[string]interface{}
 		expectedError  error
 	}{
 		{
@@ -248,7 +276,7 @@ func TestGitGenerateParamsFromFiles(t *testing.T) {
 }`),
 			},
 			repoPathsError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{
 					"cluster.owner":           "john.doe@example.com",
 					"cluster.name":            "production",
@@ -285,7 +313,7 @@ func TestGitGenerateParamsFromFiles(t *testing.T) {
 			files:            []argoprojiov1alpha1.GitFileGeneratorItem{{Path: "**/config.json"}},
 			repoFileContents: map[string][]byte{},
 			repoPathsError:   fmt.Errorf("paths error"),
-			expected:         []map[string]string{},
+			expected:         []map[string]interface{}{},
 			expectedError:    fmt.Errorf("paths error"),
 		},
 		{
@@ -295,7 +323,7 @@ func TestGitGenerateParamsFromFiles(t *testing.T) {
 				"cluster-config/production/config.json": []byte(`invalid json file`),
 			},
 			repoPathsError: nil,
-			expected:       []map[string]string{},
+			expected:       []map[string]interface{}{},
 			expectedError:  fmt.Errorf("unable to process file 'cluster-config/production/config.json': unable to parse file: error unmarshaling JSON: while decoding JSON: json: cannot unmarshal string into Go value of type map[string]interface {}"),
 		},
 		{
@@ -324,7 +352,7 @@ func TestGitGenerateParamsFromFiles(t *testing.T) {
 ]`),
 			},
 			repoPathsError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{
 					"cluster.owner":           "john.doe@example.com",
 					"cluster.name":            "production",
@@ -376,7 +404,7 @@ cluster:
 `),
 			},
 			repoPathsError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{
 					"cluster.owner":           "john.doe@example.com",
 					"cluster.name":            "production",
@@ -424,7 +452,7 @@ cluster:
     address: https://kubernetes.default.svc`),
 			},
 			repoPathsError: nil,
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{
 					"cluster.owner":           "john.doe@example.com",
 					"cluster.name":            "production",
@@ -481,7 +509,7 @@ cluster:
 				},
 			}
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], nil)
+			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo)
 			fmt.Println(got, err)
 
 			if testCaseCopy.expectedError != nil {
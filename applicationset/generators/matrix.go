@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/imdario/mergo"
+
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
 )
@@ -28,7 +30,7 @@ func NewMatrixGenerator(supportedGenerators map[string]Generator) Generator {
 	return m
 }
 
-func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet) ([]map[string]string, error) {
+func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet) ([]map[string]interface{}, error) {
 
 	if appSetGenerator.Matrix == nil {
 		return nil, EmptyAppSetGeneratorError
@@ -42,7 +44,7 @@ func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 		return nil, ErrMoreThanTwoGenerators
 	}
 
-	res := []map[string]string{}
+	res := []map[string]interface{}{}
 
 	g0, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil)
 	if err != nil {
@@ -54,18 +56,31 @@ func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 			return nil, err
 		}
 		for _, b := range g1 {
-			val, err := utils.CombineStringMaps(a, b)
-			if err != nil {
-				return nil, err
+			if appSet.Spec.GoTemplate {
+				// Structured params are deep-merged, so that eg. two generators may each contribute to "values".
+				// mergo merges into the nested maps of its destination, so copies are merged to leave a and b untouched.
+				val := utils.DeepCopyMap(a)
+				if val == nil {
+					val = map[string]interface{}{}
+				}
+				if err := mergo.Merge(&val, utils.DeepCopyMap(b), mergo.WithOverride); err != nil {
+					return nil, fmt.Errorf("failed to combine params from the matrix generators: %w", err)
+				}
+				res = append(res, val)
+			} else {
+				val, err := utils.CombineMaps(a, b)
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, val)
 			}
-			res = append(res, val)
 		}
 	}
 
 	return res, nil
 }
 
-func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]string) ([]map[string]string, error) {
+func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]interface{}) ([]map[string]interface{}, error) {
 	var matrix *argoprojiov1alpha1.MatrixGenerator
 	if appSetBaseGenerator.Matrix != nil {
 		// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
@@ -98,6 +113,7 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 			SCMProvider:             appSetBaseGenerator.SCMProvider,
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
+			Plugin:                  appSetBaseGenerator.Plugin,
 			Matrix:                  matrix,
 			Merge:                   mergeGenerator,
 		},
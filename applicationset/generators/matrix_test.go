@@ -34,7 +34,7 @@ func TestMatrixGenerate(t *testing.T) {
 		name           string
 		baseGenerators []argoprojiov1alpha1.ApplicationSetNestedGenerator
 		expectedErr    error
-		expected       []map[string]string
+		expected       []map[string]interface{}
 	}{
 		{
 			name: "happy flow - generate params",
@@ -46,7 +46,7 @@ func TestMatrixGenerate(t *testing.T) {
 					List: listGenerator,
 				},
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"path": "app1", "path.basename": "app1", "path.basenameNormalized": "app1", "cluster": "Cluster", "url": "Url"},
 				{"path": "app2", "path.basename": "app2", "path.basenameNormalized": "app2", "cluster": "Cluster", "url": "Url"},
 			},
@@ -71,7 +71,7 @@ func TestMatrixGenerate(t *testing.T) {
 					},
 				},
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"a": "1", "b": "1"},
 				{"a": "1", "b": "2"},
 				{"a": "2", "b": "1"},
@@ -143,7 +143,7 @@ func TestMatrixGenerate(t *testing.T) {
 					Git:  g.Git,
 					List: g.List,
 				}
-				genMock.On("GenerateParams", mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet).Return([]map[string]string{
+				genMock.On("GenerateParams", mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet).Return([]map[string]interface{}{
 					{
 						"path":                    "app1",
 						"path.basename":           "app1",
@@ -186,6 +186,113 @@ func TestMatrixGenerate(t *testing.T) {
 	}
 }
 
+func TestMatrixGenerateGoTemplate(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+		},
+	}
+
+	var matrixGenerator = NewMatrixGenerator(
+		map[string]Generator{
+			"List": &ListGenerator{},
+		},
+	)
+
+	got, err := matrixGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Matrix: &argoprojiov1alpha1.MatrixGenerator{
+			Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"cluster": "in-cluster", "values": {"replicas": 1}}`)},
+						},
+					},
+				},
+				{
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"app": "{{ .cluster }}-app", "values": {"debug": true}}`)},
+						},
+					},
+				},
+			},
+		},
+	}, appSet)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"cluster": "in-cluster",
+			"app":     "in-cluster-app",
+			"values": map[string]interface{}{
+				"replicas": float64(1),
+				"debug":    true,
+			},
+		},
+	}, got)
+}
+
+func TestMatrixGenerateGoTemplateNestedParams(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+		},
+	}
+
+	gitGenerator := &argoprojiov1alpha1.GitGenerator{
+		RepoURL:     "RepoURL",
+		Revision:    "Revision",
+		Directories: []argoprojiov1alpha1.GitDirectoryGeneratorItem{{Path: "*"}},
+	}
+	gitParams := []map[string]interface{}{
+		{"path": map[string]interface{}{"path": "app1", "basename": "app1"}},
+		{"path": map[string]interface{}{"path": "app2", "basename": "app2"}},
+	}
+	genMock := &generatorMock{}
+	genMock.On("GenerateParams", mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet).Return(gitParams, nil)
+	genMock.On("GetTemplate", &argoprojiov1alpha1.ApplicationSetGenerator{Git: gitGenerator}).
+		Return(&argoprojiov1alpha1.ApplicationSetTemplate{})
+
+	var matrixGenerator = NewMatrixGenerator(
+		map[string]Generator{
+			"Git":  genMock,
+			"List": &ListGenerator{},
+		},
+	)
+
+	got, err := matrixGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Matrix: &argoprojiov1alpha1.MatrixGenerator{
+			Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				{
+					Git: gitGenerator,
+				},
+				{
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"path": {"env": "dev"}}`)},
+							{Raw: []byte(`{"path": {"basename": "override"}}`)},
+						},
+					},
+				},
+			},
+		},
+	}, appSet)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"path": map[string]interface{}{"path": "app1", "basename": "app1", "env": "dev"}},
+		{"path": map[string]interface{}{"path": "app1", "basename": "override"}},
+		{"path": map[string]interface{}{"path": "app2", "basename": "app2", "env": "dev"}},
+		{"path": map[string]interface{}{"path": "app2", "basename": "override"}},
+	}, got)
+	// the params of the first generator are not modified by the merge
+	assert.Equal(t, []map[string]interface{}{
+		{"path": map[string]interface{}{"path": "app1", "basename": "app1"}},
+		{"path": map[string]interface{}{"path": "app2", "basename": "app2"}},
+	}, gitParams)
+}
+
 func TestMatrixGetRequeueAfter(t *testing.T) {
 
 	gitGenerator := &argoprojiov1alpha1.GitGenerator{
@@ -287,7 +394,7 @@ func TestInterpolatedMatrixGenerate(t *testing.T) {
 		name           string
 		baseGenerators []argoprojiov1alpha1.ApplicationSetNestedGenerator
 		expectedErr    error
-		expected       []map[string]string
+		expected       []map[string]interface{}
 		clientError    bool
 	}{
 		{
@@ -300,7 +407,7 @@ func TestInterpolatedMatrixGenerate(t *testing.T) {
 					Clusters: interpolatedClusterGenerator,
 				},
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{"path": "examples/git-generator-files-discovery/cluster-config/dev/config.json", "path.basename": "dev", "path.basenameNormalized": "dev", "name": "dev-01", "nameNormalized": "dev-01", "server": "https://dev-01.example.com", "metadata.labels.environment": "dev", "metadata.labels.argocd.argoproj.io/secret-type": "cluster"},
 				{"path": "examples/git-generator-files-discovery/cluster-config/prod/config.json", "path.basename": "prod", "path.basenameNormalized": "prod", "name": "prod-01", "nameNormalized": "prod-01", "server": "https://prod-01.example.com", "metadata.labels.environment": "prod", "metadata.labels.argocd.argoproj.io/secret-type": "cluster"},
 			},
@@ -376,7 +483,7 @@ func TestInterpolatedMatrixGenerate(t *testing.T) {
 					Git:      g.Git,
 					Clusters: g.Clusters,
 				}
-				genMock.On("GenerateParams", mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet).Return([]map[string]string{
+				genMock.On("GenerateParams", mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet).Return([]map[string]interface{}{
 					{
 						"path":                    "examples/git-generator-files-discovery/cluster-config/dev/config.json",
 						"path.basename":           "dev",
@@ -427,10 +534,10 @@ func (g *generatorMock) GetTemplate(appSetGenerator *argoprojiov1alpha1.Applicat
 	return args.Get(0).(*argoprojiov1alpha1.ApplicationSetTemplate)
 }
 
-func (g *generatorMock) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet) ([]map[string]string, error) {
+func (g *generatorMock) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet) ([]map[string]interface{}, error) {
 	args := g.Called(appSetGenerator, appSet)
 
-	return args.Get(0).([]map[string]string), args.Error(1)
+	return args.Get(0).([]map[string]interface{}), args.Error(1)
 }
 
 func (g *generatorMock) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
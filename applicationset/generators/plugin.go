@@ -0,0 +1,231 @@
+package generators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jeremywohl/flatten"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/services/plugin"
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/common"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
+var _ Generator = (*PluginGenerator)(nil)
+
+const (
+	DefaultPluginRequeueAfterSeconds = 30 * time.Minute
+
+	// pluginBaseURLKey is the key of the plugin ConfigMap which holds the base URL of the plugin service
+	pluginBaseURLKey = "baseUrl"
+	// pluginTokenKey is the key of the plugin ConfigMap which holds a reference to the token used to authenticate to
+	// the plugin service, either '$<key>' for a key of argocd-secret or '$<secret name>:<key>' for any other Secret
+	pluginTokenKey = "token"
+)
+
+type PluginGenerator struct {
+	client client.Client
+	ctx    context.Context
+	// getParamsFunc calls the plugin service with the given base URL and token
+	getParamsFunc func(ctx context.Context, baseURL, token string, request plugin.ServiceRequest) ([]map[string]interface{}, error)
+
+	cacheLock sync.Mutex
+	cache     map[string]pluginCacheEntry
+}
+
+// pluginCacheEntry is a response of a plugin service which is reused until it expires
+type pluginCacheEntry struct {
+	params  []map[string]interface{}
+	expires time.Time
+}
+
+func NewPluginGenerator(client client.Client, ctx context.Context) Generator {
+	return &PluginGenerator{
+		client:        client,
+		ctx:           ctx,
+		getParamsFunc: getPluginParams,
+		cache:         map[string]pluginCacheEntry{},
+	}
+}
+
+func getPluginParams(ctx context.Context, baseURL, token string, request plugin.ServiceRequest) ([]map[string]interface{}, error) {
+	svc, err := plugin.NewPluginService(baseURL, token)
+	if err != nil {
+		return nil, err
+	}
+	return svc.GetParams(ctx, request)
+}
+
+func (g *PluginGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.Plugin.RequeueAfterSeconds != nil && *appSetGenerator.Plugin.RequeueAfterSeconds > 0 {
+		return time.Duration(*appSetGenerator.Plugin.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultPluginRequeueAfterSeconds
+}
+
+func (g *PluginGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.Plugin.Template
+}
+
+func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) ([]map[string]interface{}, error) {
+	if appSetGenerator == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	if appSetGenerator.Plugin == nil {
+		return nil, EmptyAppSetGeneratorError
+	}
+
+	baseURL, token, err := g.getPluginConfig(appSetGenerator.Plugin.ConfigMapRef, applicationSetInfo.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error getting plugin config: %v", err)
+	}
+
+	request := plugin.ServiceRequest{
+		ApplicationSetName: applicationSetInfo.Name,
+		Input: plugin.ServiceInput{
+			Parameters: map[string]interface{}{},
+		},
+	}
+	for key, value := range appSetGenerator.Plugin.Input.Parameters {
+		var param interface{}
+		if err := json.Unmarshal(value.Raw, &param); err != nil {
+			return nil, fmt.Errorf("error unmarshalling plugin input parameter %q: %v", key, err)
+		}
+		request.Input.Parameters[key] = param
+	}
+
+	// Responses are cached per ApplicationSet and input, so that the plugin service is only called again once the
+	// requeue interval has elapsed rather than on every reconciliation.
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling plugin request: %v", err)
+	}
+	cacheKey := fmt.Sprintf("%s/%s/%s", applicationSetInfo.Namespace, baseURL, body)
+	pluginParams, ok := g.getCachedParams(cacheKey)
+	if !ok {
+		pluginParams, err = g.getParamsFunc(g.ctx, baseURL, token, request)
+		if err != nil {
+			return nil, fmt.Errorf("error getting plugin params: %v", err)
+		}
+		g.setCachedParams(cacheKey, pluginParams, g.GetRequeueAfter(appSetGenerator))
+	}
+
+	res := make([]map[string]interface{}, 0, len(pluginParams))
+	for _, pluginParam := range pluginParams {
+		params := map[string]interface{}{}
+		if applicationSetInfo.Spec.GoTemplate {
+			for key, value := range pluginParam {
+				params[key] = value
+			}
+		} else {
+			// Without Go templates, nested values are flattened into dot separated string parameters
+			flat, err := flatten.Flatten(pluginParam, "", flatten.DotStyle)
+			if err != nil {
+				return nil, fmt.Errorf("error flattening plugin params: %v", err)
+			}
+			for key, value := range flat {
+				params[key] = fmt.Sprintf("%v", value)
+			}
+		}
+
+		err := appendTemplatedValues(appSetGenerator.Plugin.Values, params, applicationSetInfo.Spec.GoTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %v", err)
+		}
+
+		res = append(res, params)
+	}
+
+	return res, nil
+}
+
+// getCachedParams returns a copy of the cached params, so that the cache is not modified by the templating
+func (g *PluginGenerator) getCachedParams(key string) ([]map[string]interface{}, bool) {
+	g.cacheLock.Lock()
+	defer g.cacheLock.Unlock()
+	entry, ok := g.cache[key]
+	if !ok || !time.Now().Before(entry.expires) {
+		delete(g.cache, key)
+		return nil, false
+	}
+	return copyParams(entry.params), true
+}
+
+// setCachedParams caches a copy of the params, and evicts the expired entries, which are left behind when the input
+// of a generator changes or its ApplicationSet is deleted
+func (g *PluginGenerator) setCachedParams(key string, params []map[string]interface{}, ttl time.Duration) {
+	g.cacheLock.Lock()
+	defer g.cacheLock.Unlock()
+	now := time.Now()
+	for k, entry := range g.cache {
+		if !now.Before(entry.expires) {
+			delete(g.cache, k)
+		}
+	}
+	g.cache[key] = pluginCacheEntry{params: copyParams(params), expires: now.Add(ttl)}
+}
+
+func copyParams(params []map[string]interface{}) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(params))
+	for i := range params {
+		res[i] = utils.DeepCopyMap(params[i])
+	}
+	return res
+}
+
+// getPluginConfig returns the base URL of the plugin service and the token used to authenticate to it from the
+// specified ConfigMap
+func (g *PluginGenerator) getPluginConfig(configMapRef string, namespace string) (string, string, error) {
+	cm := &corev1.ConfigMap{}
+	err := g.client.Get(g.ctx, client.ObjectKey{Name: configMapRef, Namespace: namespace}, cm)
+	if err != nil {
+		return "", "", fmt.Errorf("error fetching ConfigMap %s/%s: %v", namespace, configMapRef, err)
+	}
+	baseURL, ok := cm.Data[pluginBaseURLKey]
+	if !ok || baseURL == "" {
+		return "", "", fmt.Errorf("key %q in ConfigMap %s/%s not found", pluginBaseURLKey, namespace, configMapRef)
+	}
+	tokenRef, ok := cm.Data[pluginTokenKey]
+	if !ok || tokenRef == "" {
+		return baseURL, "", nil
+	}
+	token, err := g.getToken(tokenRef, namespace)
+	if err != nil {
+		return "", "", err
+	}
+	return baseURL, token, nil
+}
+
+// getToken resolves a token reference of the form '$<key>' (a key of argocd-secret) or '$<secret name>:<key>'
+func (g *PluginGenerator) getToken(tokenRef string, namespace string) (string, error) {
+	if !strings.HasPrefix(tokenRef, "$") {
+		return "", fmt.Errorf("plugin token must reference a Secret, e.g. '$<secret name>:<key>'")
+	}
+	secretName := common.ArgoCDSecretName
+	key := tokenRef[1:]
+	if parts := strings.SplitN(key, ":", 2); len(parts) == 2 {
+		secretName, key = parts[0], parts[1]
+	}
+
+	secret := &corev1.Secret{}
+	err := g.client.Get(g.ctx, client.ObjectKey{Name: secretName, Namespace: namespace}, secret)
+	if err != nil {
+		return "", fmt.Errorf("error fetching secret %s/%s: %v", namespace, secretName, err)
+	}
+	tokenBytes, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q in secret %s/%s not found", key, namespace, secretName)
+	}
+	return strings.TrimSpace(string(tokenBytes)), nil
+}
@@ -0,0 +1,194 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/services/plugin"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
+func TestPluginGenerateParams(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-plugin", Namespace: "argocd"},
+		Data: map[string]string{
+			"baseUrl": "http://my-plugin.argocd.svc",
+			"token":   "$plugin-secret:token",
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "plugin-secret", Namespace: "argocd"},
+		Data:       map[string][]byte{"token": []byte("my-token\n")},
+	}
+	argoCDSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+		Data:       map[string][]byte{"plugin.token": []byte("argocd-token")},
+	}
+
+	pluginParams := []map[string]interface{}{
+		{"name": "app-a", "nested": map[string]interface{}{"key": "value"}},
+	}
+
+	cases := []struct {
+		name        string
+		objects     []client.Object
+		generator   *argoprojiov1alpha1.PluginGenerator
+		goTemplate  bool
+		expected    []map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:    "Flattened params",
+			objects: []client.Object{configMap, secret},
+			generator: &argoprojiov1alpha1.PluginGenerator{
+				ConfigMapRef: "my-plugin",
+				Values:       map[string]string{"env": "{{name}}-dev"},
+			},
+			expected: []map[string]interface{}{
+				{"name": "app-a", "nested.key": "value", "values.env": "app-a-dev"},
+			},
+		},
+		{
+			name:    "Go template params",
+			objects: []client.Object{configMap, secret},
+			generator: &argoprojiov1alpha1.PluginGenerator{
+				ConfigMapRef: "my-plugin",
+				Values:       map[string]string{"env": "{{ .name }}-dev"},
+			},
+			goTemplate: true,
+			expected: []map[string]interface{}{
+				{"name": "app-a", "nested": map[string]interface{}{"key": "value"}, "values": map[string]interface{}{"env": "app-a-dev"}},
+			},
+		},
+		{
+			name:        "Missing ConfigMap",
+			objects:     []client.Object{secret},
+			generator:   &argoprojiov1alpha1.PluginGenerator{ConfigMapRef: "my-plugin"},
+			expectedErr: "error getting plugin config: error fetching ConfigMap argocd/my-plugin",
+		},
+		{
+			name:        "Missing Secret",
+			objects:     []client.Object{configMap},
+			generator:   &argoprojiov1alpha1.PluginGenerator{ConfigMapRef: "my-plugin"},
+			expectedErr: "error getting plugin config: error fetching secret argocd/plugin-secret",
+		},
+	}
+
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			g := NewPluginGenerator(fake.NewClientBuilder().WithObjects(cc.objects...).Build(), context.Background()).(*PluginGenerator)
+			g.getParamsFunc = func(ctx context.Context, baseURL, token string, request plugin.ServiceRequest) ([]map[string]interface{}, error) {
+				assert.Equal(t, "http://my-plugin.argocd.svc", baseURL)
+				assert.Equal(t, "my-token", token)
+				return pluginParams, nil
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+				Spec:       argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: cc.goTemplate},
+			}
+			got, err := g.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{Plugin: cc.generator}, appSet)
+			if cc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), cc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cc.expected, got)
+		})
+	}
+
+	t.Run("Token from argocd-secret", func(t *testing.T) {
+		cm := configMap.DeepCopy()
+		cm.Data["token"] = "$plugin.token"
+		g := NewPluginGenerator(fake.NewClientBuilder().WithObjects(cm, argoCDSecret).Build(), context.Background()).(*PluginGenerator)
+		baseURL, token, err := g.getPluginConfig("my-plugin", "argocd")
+		require.NoError(t, err)
+		assert.Equal(t, "http://my-plugin.argocd.svc", baseURL)
+		assert.Equal(t, "argocd-token", token)
+	})
+
+	t.Run("Plain text token", func(t *testing.T) {
+		cm := configMap.DeepCopy()
+		cm.Data["token"] = "my-token"
+		g := NewPluginGenerator(fake.NewClientBuilder().WithObjects(cm).Build(), context.Background()).(*PluginGenerator)
+		_, _, err := g.getPluginConfig("my-plugin", "argocd")
+		assert.Error(t, err)
+	})
+}
+
+func TestPluginGenerateParamsCache(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-plugin", Namespace: "argocd"},
+		Data:       map[string]string{"baseUrl": "http://my-plugin.argocd.svc"},
+	}
+	g := NewPluginGenerator(fake.NewClientBuilder().WithObjects(configMap).Build(), context.Background()).(*PluginGenerator)
+	calls := 0
+	g.getParamsFunc = func(ctx context.Context, baseURL, token string, request plugin.ServiceRequest) ([]map[string]interface{}, error) {
+		calls++
+		return []map[string]interface{}{{"name": fmt.Sprintf("%s-%v", request.Input.Parameters["prefix"], calls)}}, nil
+	}
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"}}
+	generator := func(prefix string, requeueAfterSeconds int64) *argoprojiov1alpha1.ApplicationSetGenerator {
+		return &argoprojiov1alpha1.ApplicationSetGenerator{Plugin: &argoprojiov1alpha1.PluginGenerator{
+			ConfigMapRef: "my-plugin",
+			Input: argoprojiov1alpha1.PluginInput{
+				Parameters: map[string]apiextensionsv1.JSON{"prefix": {Raw: []byte(fmt.Sprintf("%q", prefix))}},
+			},
+			RequeueAfterSeconds: &requeueAfterSeconds,
+		}}
+	}
+
+	got, err := g.GenerateParams(generator("a", 60), appSet)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"name": "a-1"}}, got)
+
+	// the cached response is returned until it expires
+	got, err = g.GenerateParams(generator("a", 60), appSet)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"name": "a-1"}}, got)
+	assert.Equal(t, 1, calls)
+
+	// a different input is not served from the cache
+	got, err = g.GenerateParams(generator("b", 60), appSet)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"name": "b-2"}}, got)
+
+	// an expired response is refreshed, and the expired entries are evicted
+	for key, entry := range g.cache {
+		entry.expires = time.Now().Add(-time.Second)
+		g.cache[key] = entry
+	}
+	got, err = g.GenerateParams(generator("a", 60), appSet)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"name": "a-3"}}, got)
+	assert.Len(t, g.cache, 1)
+
+	// the cached params are not modified through the returned params
+	got[0]["name"] = "changed"
+	got, err = g.GenerateParams(generator("a", 60), appSet)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"name": "a-3"}}, got)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPluginGetRequeueAfter(t *testing.T) {
+	g := &PluginGenerator{}
+	requeueAfter := func(seconds *int64) time.Duration {
+		return g.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{Plugin: &argoprojiov1alpha1.PluginGenerator{RequeueAfterSeconds: seconds}})
+	}
+	zero, ten := int64(0), int64(10)
+	assert.Equal(t, DefaultPluginRequeueAfterSeconds, requeueAfter(nil))
+	assert.Equal(t, DefaultPluginRequeueAfterSeconds, requeueAfter(&zero))
+	assert.Equal(t, 10*time.Second, requeueAfter(&ten))
+}
@@ -18,7 +18,7 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 	ctx := context.Background()
 	cases := []struct {
 		selectFunc  func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error)
-		expected    []map[string]string
+		expected    []map[string]interface{}
 		expectedErr error
 	}{
 		{
@@ -35,13 +35,17 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					nil,
 				)
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{
-					"number":         "1",
-					"branch":         "branch1",
-					"branch_slug":    "branch1",
-					"head_sha":       "089d92cbf9ff857a39e6feccd32798ca700fb958",
-					"head_short_sha": "089d92cb",
+					"number":             "1",
+					"branch":             "branch1",
+					"branch_slug":        "branch1",
+					"target_branch":      "",
+					"target_branch_slug": "",
+					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
+					"head_short_sha":     "089d92cb",
+					"author":             "",
+					"labels":             "",
 				},
 			},
 			expectedErr: nil,
@@ -60,13 +64,17 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					nil,
 				)
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{
-					"number":         "2",
-					"branch":         "feat/areally+long_pull_request_name_to_test_argo_slugification_and_branch_name_shortening_feature",
-					"branch_slug":    "feat-areally-long-pull-request-name-to-test-argo",
-					"head_sha":       "9b34ff5bd418e57d58891eb0aa0728043ca1e8be",
-					"head_short_sha": "9b34ff5b",
+					"number":             "2",
+					"branch":             "feat/areally+long_pull_request_name_to_test_argo_slugification_and_branch_name_shortening_feature",
+					"branch_slug":        "feat-areally-long-pull-request-name-to-test-argo",
+					"target_branch":      "",
+					"target_branch_slug": "",
+					"head_sha":           "9b34ff5bd418e57d58891eb0aa0728043ca1e8be",
+					"head_short_sha":     "9b34ff5b",
+					"author":             "",
+					"labels":             "",
 				},
 			},
 			expectedErr: nil,
@@ -85,13 +93,17 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					nil,
 				)
 			},
-			expected: []map[string]string{
+			expected: []map[string]interface{}{
 				{
-					"number":         "1",
-					"branch":         "a-very-short-sha",
-					"branch_slug":    "a-very-short-sha",
-					"head_sha":       "abcd",
-					"head_short_sha": "abcd",
+					"number":             "1",
+					"branch":             "a-very-short-sha",
+					"branch_slug":        "a-very-short-sha",
+					"target_branch":      "",
+					"target_branch_slug": "",
+					"head_sha":           "abcd",
+					"head_short_sha":     "abcd",
+					"author":             "",
+					"labels":             "",
 				},
 			},
 			expectedErr: nil,
@@ -123,6 +135,44 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 	}
 }
 
+func TestPullRequestGenerateParamsDetails(t *testing.T) {
+	ctx := context.Background()
+	pulls := []*pullrequest.PullRequest{
+		{
+			Number:       1,
+			Branch:       "feature/preview",
+			HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+			TargetBranch: "release/v1.0",
+			Author:       "octocat",
+			Labels:       []string{"preview", "team-a"},
+		},
+	}
+	gen := PullRequestGenerator{
+		selectServiceProviderFunc: func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+			return pullrequest.NewFakeService(ctx, pulls, nil)
+		},
+	}
+	generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+		PullRequest: &argoprojiov1alpha1.PullRequestGenerator{},
+	}
+
+	for _, goTemplate := range []bool{false, true} {
+		appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: goTemplate}}
+		got, err := gen.GenerateParams(&generatorConfig, appSet)
+		assert.NoError(t, err)
+		if assert.Len(t, got, 1) {
+			assert.Equal(t, "release/v1.0", got[0]["target_branch"])
+			assert.Equal(t, "release-v1-0", got[0]["target_branch_slug"])
+			assert.Equal(t, "octocat", got[0]["author"])
+			if goTemplate {
+				assert.Equal(t, []string{"preview", "team-a"}, got[0]["labels"])
+			} else {
+				assert.Equal(t, "preview,team-a", got[0]["labels"])
+			}
+		}
+	}
+}
+
 func TestPullRequestGetSecretRef(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "test"},
@@ -187,3 +237,84 @@ func TestPullRequestGetSecretRef(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestSelectServiceProvider(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "test"},
+		Data: map[string][]byte{
+			"my-token": []byte("secret"),
+		},
+	}
+	gen := &PullRequestGenerator{client: fake.NewClientBuilder().WithObjects(secret).Build()}
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "test"}}
+	validRef := &argoprojiov1alpha1.SecretRef{SecretName: "test-secret", Key: "my-token"}
+	invalidRef := &argoprojiov1alpha1.SecretRef{SecretName: "other", Key: "my-token"}
+
+	cases := []struct {
+		name      string
+		generator *argoprojiov1alpha1.PullRequestGenerator
+		expected  interface{}
+		hasError  bool
+	}{
+		{
+			name: "Bitbucket Cloud with basic auth",
+			generator: &argoprojiov1alpha1.PullRequestGenerator{BitbucketCloud: &argoprojiov1alpha1.PullRequestGeneratorBitbucketCloud{
+				Owner: "owner", Repo: "repo", BasicAuth: &argoprojiov1alpha1.BasicAuthBitbucketServer{Username: "user", PasswordRef: validRef},
+			}},
+			expected: &pullrequest.BitbucketCloudService{},
+		},
+		{
+			name: "Bitbucket Cloud with bearer token",
+			generator: &argoprojiov1alpha1.PullRequestGenerator{BitbucketCloud: &argoprojiov1alpha1.PullRequestGeneratorBitbucketCloud{
+				Owner: "owner", Repo: "repo", TokenRef: validRef,
+			}},
+			expected: &pullrequest.BitbucketCloudService{},
+		},
+		{
+			name: "Bitbucket Cloud with missing secret",
+			generator: &argoprojiov1alpha1.PullRequestGenerator{BitbucketCloud: &argoprojiov1alpha1.PullRequestGeneratorBitbucketCloud{
+				Owner: "owner", Repo: "repo", TokenRef: invalidRef,
+			}},
+			hasError: true,
+		},
+		{
+			name: "Azure DevOps",
+			generator: &argoprojiov1alpha1.PullRequestGenerator{AzureDevOps: &argoprojiov1alpha1.PullRequestGeneratorAzureDevOps{
+				Organization: "org", Project: "project", Repo: "repo", TokenRef: validRef,
+			}},
+			expected: &pullrequest.AzureDevOpsService{},
+		},
+		{
+			name: "Azure DevOps with missing secret",
+			generator: &argoprojiov1alpha1.PullRequestGenerator{AzureDevOps: &argoprojiov1alpha1.PullRequestGeneratorAzureDevOps{
+				Organization: "org", Project: "project", Repo: "repo", TokenRef: invalidRef,
+			}},
+			hasError: true,
+		},
+		{
+			name: "AWS CodeCommit",
+			generator: &argoprojiov1alpha1.PullRequestGenerator{AWSCodeCommit: &argoprojiov1alpha1.PullRequestGeneratorAWSCodeCommit{
+				Repo: "repo", Region: "us-east-1", AccessKeyIDRef: validRef, SecretAccessKeyRef: validRef,
+			}},
+			expected: &pullrequest.AWSCodeCommitService{},
+		},
+		{
+			name:      "No provider",
+			generator: &argoprojiov1alpha1.PullRequestGenerator{},
+			hasError:  true,
+		},
+	}
+
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			svc, err := gen.selectServiceProvider(context.Background(), cc.generator, appSet)
+			if cc.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.IsType(t, cc.expected, svc)
+		})
+	}
+}
@@ -102,7 +102,7 @@ func TestSCMProviderGenerateParams(t *testing.T) {
 	gen := &SCMProviderGenerator{overrideProvider: mockProvider}
 	params, err := gen.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
 		SCMProvider: &argoprojiov1alpha1.SCMProviderGenerator{},
-	}, nil)
+	}, &argoprojiov1alpha1.ApplicationSet{})
 	assert.Nil(t, err)
 	assert.Len(t, params, 2)
 	assert.Equal(t, "myorg", params[0]["organization"])
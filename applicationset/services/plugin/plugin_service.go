@@ -0,0 +1,98 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// GetParamsPath is the path, relative to the base URL of the plugin service, which is called to retrieve parameters
+	GetParamsPath = "/api/v1/getparams.execute"
+
+	// maxResponseSize limits the size of a response read from a plugin service
+	maxResponseSize = 10 * 1024 * 1024
+)
+
+// ServiceRequest is the body which is POSTed to the plugin service
+type ServiceRequest struct {
+	// ApplicationSetName is the name of the ApplicationSet the parameters are generated for
+	ApplicationSetName string `json:"applicationSetName"`
+	// Input is the input configured in the plugin generator
+	Input ServiceInput `json:"input"`
+}
+
+// ServiceInput is the input configured in the plugin generator
+type ServiceInput struct {
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ServiceResponse is the body which is returned by the plugin service
+type ServiceResponse struct {
+	Output ServiceOutput `json:"output"`
+}
+
+// ServiceOutput contains the list of parameter sets returned by the plugin service
+type ServiceOutput struct {
+	Parameters []map[string]interface{} `json:"parameters"`
+}
+
+// Service calls a plugin service over HTTP
+type Service struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewPluginService returns a Service which calls the plugin service at baseURL, authenticating with the given bearer token
+func NewPluginService(baseURL, token string) (*Service, error) {
+	if baseURL == "" {
+		return nil, fmt.Errorf("plugin service base URL is empty")
+	}
+	return &Service{
+		client:  &http.Client{Timeout: 30 * time.Second},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}, nil
+}
+
+// GetParams POSTs the request to the plugin service and returns the list of parameter sets it responds with
+func (s *Service) GetParams(ctx context.Context, request ServiceRequest) ([]map[string]interface{}, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling plugin request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+GetParamsPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating plugin request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling plugin service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading plugin response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("plugin service returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	var res ServiceResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling plugin response: %w", err)
+	}
+	return res.Output.Parameters, nil
+}
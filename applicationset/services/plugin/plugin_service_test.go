@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetParams(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, GetParamsPath, r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer my-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("Unauthorized"))
+			return
+		}
+		var req ServiceRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "my-appset", req.ApplicationSetName)
+		_, _ = w.Write([]byte(`{"output": {"parameters": [{"name": "` + req.Input.Parameters["prefix"].(string) + `-a"}, {"name": "b", "nested": {"key": "value"}}]}}`))
+	}))
+	defer ts.Close()
+
+	request := ServiceRequest{
+		ApplicationSetName: "my-appset",
+		Input:              ServiceInput{Parameters: map[string]interface{}{"prefix": "app"}},
+	}
+
+	t.Run("Valid token", func(t *testing.T) {
+		svc, err := NewPluginService(ts.URL+"/", "my-token")
+		require.NoError(t, err)
+		params, err := svc.GetParams(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]interface{}{
+			{"name": "app-a"},
+			{"name": "b", "nested": map[string]interface{}{"key": "value"}},
+		}, params)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		svc, err := NewPluginService(ts.URL, "wrong-token")
+		require.NoError(t, err)
+		_, err = svc.GetParams(context.Background(), request)
+		assert.EqualError(t, err, "plugin service returned status 401: Unauthorized")
+	})
+
+	t.Run("Empty base URL", func(t *testing.T) {
+		_, err := NewPluginService("", "my-token")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,92 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codecommit/codecommitiface"
+)
+
+type AWSCodeCommitService struct {
+	client codecommitiface.CodeCommitAPI
+	repo   string
+	// Not supported for PRs by AWS CodeCommit
+	// labels         []string
+}
+
+var _ PullRequestService = (*AWSCodeCommitService)(nil)
+
+// NewAWSCodeCommitService returns a service for the AWS CodeCommit API, or an API compatible with it when url is set.
+// If no static credentials are given, the credentials of the environment are used.
+func NewAWSCodeCommitService(ctx context.Context, accessKeyID, secretAccessKey, url, region, repo string) (PullRequestService, error) {
+	config := aws.NewConfig()
+	if region != "" {
+		config = config.WithRegion(region)
+	}
+	if url != "" {
+		config = config.WithEndpoint(url)
+	}
+	if accessKeyID != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""))
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %v", err)
+	}
+	return &AWSCodeCommitService{
+		client: codecommit.New(sess),
+		repo:   repo,
+	}, nil
+}
+
+func (a *AWSCodeCommitService) List(ctx context.Context) ([]*PullRequest, error) {
+	var pullRequestIds []*string
+	input := &codecommit.ListPullRequestsInput{
+		RepositoryName:    aws.String(a.repo),
+		PullRequestStatus: aws.String(codecommit.PullRequestStatusEnumOpen),
+	}
+	err := a.client.ListPullRequestsPagesWithContext(ctx, input, func(page *codecommit.ListPullRequestsOutput, lastPage bool) bool {
+		pullRequestIds = append(pullRequestIds, page.PullRequestIds...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s: %v", a.repo, err)
+	}
+
+	pullRequests := []*PullRequest{}
+	for _, id := range pullRequestIds {
+		output, err := a.client.GetPullRequestWithContext(ctx, &codecommit.GetPullRequestInput{PullRequestId: id})
+		if err != nil {
+			return nil, fmt.Errorf("error getting pull request %s for %s: %v", aws.StringValue(id), a.repo, err)
+		}
+		number, err := strconv.Atoi(aws.StringValue(id))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing pull request id %q for %s: %v", aws.StringValue(id), a.repo, err)
+		}
+		// A pull request may have targets in several repositories, only the target in the scanned one is relevant
+		for _, target := range output.PullRequest.PullRequestTargets {
+			if aws.StringValue(target.RepositoryName) != a.repo {
+				continue
+			}
+			pullRequests = append(pullRequests, &PullRequest{
+				Number:       number,
+				Branch:       strings.TrimPrefix(aws.StringValue(target.SourceReference), "refs/heads/"),
+				HeadSHA:      aws.StringValue(target.SourceCommit),
+				TargetBranch: strings.TrimPrefix(aws.StringValue(target.DestinationReference), "refs/heads/"),
+				Title:        aws.StringValue(output.PullRequest.Title),
+				Author:       aws.StringValue(output.PullRequest.AuthorArn),
+			})
+			break
+		}
+	}
+	return pullRequests, nil
+}
@@ -0,0 +1,77 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codecommit/codecommitiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCodeCommitClient struct {
+	codecommitiface.CodeCommitAPI
+	pages        [][]*string
+	pullRequests map[string]*codecommit.PullRequest
+	err          error
+}
+
+func (c *fakeCodeCommitClient) ListPullRequestsPagesWithContext(_ aws.Context, input *codecommit.ListPullRequestsInput, fn func(*codecommit.ListPullRequestsOutput, bool) bool, _ ...request.Option) error {
+	if c.err != nil {
+		return c.err
+	}
+	for i, page := range c.pages {
+		if !fn(&codecommit.ListPullRequestsOutput{PullRequestIds: page}, i == len(c.pages)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (c *fakeCodeCommitClient) GetPullRequestWithContext(_ aws.Context, input *codecommit.GetPullRequestInput, _ ...request.Option) (*codecommit.GetPullRequestOutput, error) {
+	pull, ok := c.pullRequests[aws.StringValue(input.PullRequestId)]
+	if !ok {
+		return nil, fmt.Errorf("pull request %s not found", aws.StringValue(input.PullRequestId))
+	}
+	return &codecommit.GetPullRequestOutput{PullRequest: pull}, nil
+}
+
+func codeCommitPullRequest(repo, branch, sha string) *codecommit.PullRequest {
+	return &codecommit.PullRequest{
+		PullRequestTargets: []*codecommit.PullRequestTarget{
+			{RepositoryName: aws.String("other-repo"), SourceReference: aws.String("refs/heads/other"), SourceCommit: aws.String("other")},
+			{RepositoryName: aws.String(repo), SourceReference: aws.String("refs/heads/" + branch), SourceCommit: aws.String(sha)},
+		},
+	}
+}
+
+func TestAWSCodeCommitListPullRequests(t *testing.T) {
+	client := &fakeCodeCommitClient{
+		pages: [][]*string{{aws.String("1")}, {aws.String("2")}},
+		pullRequests: map[string]*codecommit.PullRequest{
+			"1": codeCommitPullRequest("repo", "feature/a", "cb3cf2e4d1517c83e720d2585b9402dbef71f992"),
+			"2": codeCommitPullRequest("repo", "feature/b", "6344d9623e3b5f0b4bce87f69d3bb8d2e18d1b25"),
+		},
+	}
+	svc := &AWSCodeCommitService{client: client, repo: "repo"}
+	list, err := svc.List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []*PullRequest{
+		{Number: 1, Branch: "feature/a", HeadSHA: "cb3cf2e4d1517c83e720d2585b9402dbef71f992"},
+		{Number: 2, Branch: "feature/b", HeadSHA: "6344d9623e3b5f0b4bce87f69d3bb8d2e18d1b25"},
+	}, list)
+}
+
+func TestAWSCodeCommitListPullRequestsError(t *testing.T) {
+	svc := &AWSCodeCommitService{client: &fakeCodeCommitClient{err: fmt.Errorf("access denied")}, repo: "repo"}
+	_, err := svc.List(context.Background())
+	assert.EqualError(t, err, "error listing pull requests for repo: access denied")
+
+	svc = &AWSCodeCommitService{client: &fakeCodeCommitClient{pages: [][]*string{{aws.String("1")}}}, repo: "repo"}
+	_, err = svc.List(context.Background())
+	assert.EqualError(t, err, "error getting pull request 1 for repo: pull request 1 not found")
+}
@@ -0,0 +1,156 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+)
+
+const AzureDevOpsDefaultURL = "https://dev.azure.com"
+
+type AzureDevOpsClientFactory interface {
+	// Returns an Azure Devops Client interface.
+	GetClient(ctx context.Context) (git.Client, error)
+}
+
+type devopsFactoryImpl struct {
+	connection *azuredevops.Connection
+}
+
+func (factory *devopsFactoryImpl) GetClient(ctx context.Context) (git.Client, error) {
+	gitClient, err := git.NewClient(ctx, factory.connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get new Azure DevOps git client for pull request generator: %w", err)
+	}
+	return gitClient, nil
+}
+
+type AzureDevOpsService struct {
+	clientFactory AzureDevOpsClientFactory
+	project       string
+	repo          string
+	labels        []string
+}
+
+var _ PullRequestService = (*AzureDevOpsService)(nil)
+var _ AzureDevOpsClientFactory = &devopsFactoryImpl{}
+
+func NewAzureDevOpsService(ctx context.Context, token, url, organization, project, repo string, labels []string) (PullRequestService, error) {
+	if url == "" {
+		url = AzureDevOpsDefaultURL
+	}
+	organizationURL := strings.TrimSuffix(url, "/") + "/" + organization
+
+	var connection *azuredevops.Connection
+	if token == "" {
+		connection = azuredevops.NewAnonymousConnection(organizationURL)
+	} else {
+		connection = azuredevops.NewPatConnection(organizationURL, token)
+	}
+
+	return &AzureDevOpsService{
+		clientFactory: &devopsFactoryImpl{connection: connection},
+		project:       project,
+		repo:          repo,
+		labels:        labels,
+	}, nil
+}
+
+func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	pageSize := 100
+	args := git.GetPullRequestsArgs{
+		Project:      &a.project,
+		RepositoryId: &a.repo,
+		// Only active pull requests are returned by default
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+		Top:            &pageSize,
+	}
+
+	pullRequests := []*PullRequest{}
+	for skip := 0; ; skip += pageSize {
+		skip := skip
+		args.Skip = &skip
+		pulls, err := client.GetPullRequests(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("error listing pull requests for %s/%s: %v", a.project, a.repo, err)
+		}
+		if pulls == nil {
+			break
+		}
+		for _, pull := range *pulls {
+			if pull.PullRequestId == nil || pull.SourceRefName == nil || pull.LastMergeSourceCommit == nil || pull.LastMergeSourceCommit.CommitId == nil {
+				continue
+			}
+			if !containAzureDevOpsLabels(a.labels, pull.Labels) {
+				continue
+			}
+			var author string
+			if pull.CreatedBy != nil && pull.CreatedBy.UniqueName != nil {
+				author = *pull.CreatedBy.UniqueName
+			}
+			pullRequests = append(pullRequests, &PullRequest{
+				Number:       *pull.PullRequestId,
+				Branch:       strings.TrimPrefix(*pull.SourceRefName, "refs/heads/"),
+				HeadSHA:      *pull.LastMergeSourceCommit.CommitId,
+				TargetBranch: strings.TrimPrefix(stringValue(pull.TargetRefName), "refs/heads/"),
+				Title:        stringValue(pull.Title),
+				Author:       author,
+				Labels:       getAzureDevOpsLabelNames(pull.Labels),
+				Draft:        pull.IsDraft != nil && *pull.IsDraft,
+			})
+		}
+		if len(*pulls) < pageSize {
+			break
+		}
+	}
+	return pullRequests, nil
+}
+
+// getAzureDevOpsLabelNames returns the names of the given labels
+func getAzureDevOpsLabelNames(labels *[]core.WebApiTagDefinition) []string {
+	var names []string
+	if labels == nil {
+		return nil
+	}
+	for _, label := range *labels {
+		if label.Name != nil {
+			names = append(names, *label.Name)
+		}
+	}
+	return names
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// containAzureDevOpsLabels returns true if gotLabels contains expectedLabels
+func containAzureDevOpsLabels(expectedLabels []string, gotLabels *[]core.WebApiTagDefinition) bool {
+	for _, expected := range expectedLabels {
+		found := false
+		if gotLabels != nil {
+			for _, got := range *gotLabels {
+				if got.Name != nil && expected == *got.Name {
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
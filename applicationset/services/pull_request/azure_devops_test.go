@@ -0,0 +1,144 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	azureMock "github.com/argoproj/argo-cd/v2/applicationset/services/scm_provider/azure_devops/git/mocks"
+)
+
+type AzureClientFactoryMock struct {
+	mock *mock.Mock
+}
+
+func (m *AzureClientFactoryMock) GetClient(ctx context.Context) (git.Client, error) {
+	args := m.mock.Called(ctx)
+
+	var client git.Client
+	c := args.Get(0)
+	if c != nil {
+		client = c.(git.Client)
+	}
+
+	var err error
+	if len(args) > 1 {
+		if e, ok := args.Get(1).(error); ok {
+			err = e
+		}
+	}
+
+	return client, err
+}
+
+func azureDevOpsPullRequest(id int, branch, sha string, labels ...string) git.GitPullRequest {
+	tags := []core.WebApiTagDefinition{}
+	for i := range labels {
+		tags = append(tags, core.WebApiTagDefinition{Name: pointer.String(labels[i])})
+	}
+	return git.GitPullRequest{
+		PullRequestId:         pointer.Int(id),
+		SourceRefName:         pointer.String("refs/heads/" + branch),
+		TargetRefName:         pointer.String("refs/heads/main"),
+		Title:                 pointer.String("Update " + branch),
+		CreatedBy:             &webapi.IdentityRef{UniqueName: pointer.String("user@example.com")},
+		IsDraft:               pointer.Bool(id == 2),
+		LastMergeSourceCommit: &git.GitCommitRef{CommitId: pointer.String(sha)},
+		Labels:                &tags,
+	}
+}
+
+func TestAzureDevOpsListPullRequests(t *testing.T) {
+	ctx := context.Background()
+	pulls := []git.GitPullRequest{
+		azureDevOpsPullRequest(1, "feature/a", "cb3cf2e4d1517c83e720d2585b9402dbef71f992", "preview"),
+		azureDevOpsPullRequest(2, "feature/b", "6344d9623e3b5f0b4bce87f69d3bb8d2e18d1b25"),
+	}
+
+	cases := []struct {
+		name     string
+		labels   []string
+		expected []*PullRequest
+	}{
+		{
+			name: "No labels",
+			expected: []*PullRequest{
+				{Number: 1, Branch: "feature/a", HeadSHA: "cb3cf2e4d1517c83e720d2585b9402dbef71f992", TargetBranch: "main", Title: "Update feature/a", Author: "user@example.com", Labels: []string{"preview"}},
+				{Number: 2, Branch: "feature/b", HeadSHA: "6344d9623e3b5f0b4bce87f69d3bb8d2e18d1b25", TargetBranch: "main", Title: "Update feature/b", Author: "user@example.com", Draft: true},
+			},
+		},
+		{
+			name:   "Matching labels",
+			labels: []string{"preview"},
+			expected: []*PullRequest{
+				{Number: 1, Branch: "feature/a", HeadSHA: "cb3cf2e4d1517c83e720d2585b9402dbef71f992", TargetBranch: "main", Title: "Update feature/a", Author: "user@example.com", Labels: []string{"preview"}},
+			},
+		},
+		{
+			name:     "No matching labels",
+			labels:   []string{"preview", "other"},
+			expected: []*PullRequest{},
+		},
+	}
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			gitClientMock := azureMock.Client{}
+			gitClientMock.On("GetPullRequests", ctx, mock.MatchedBy(func(args git.GetPullRequestsArgs) bool {
+				return *args.Project == "project" && *args.RepositoryId == "repo" && *args.Skip == 0
+			})).Return(&pulls, nil)
+			clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+			clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+
+			svc := AzureDevOpsService{clientFactory: clientFactoryMock, project: "project", repo: "repo", labels: cc.labels}
+			list, err := svc.List(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, cc.expected, list)
+		})
+	}
+}
+
+func TestAzureDevOpsListPullRequestsPaging(t *testing.T) {
+	ctx := context.Background()
+	firstPage := []git.GitPullRequest{}
+	for i := 0; i < 100; i++ {
+		firstPage = append(firstPage, azureDevOpsPullRequest(i, fmt.Sprintf("branch-%d", i), "sha"))
+	}
+	secondPage := []git.GitPullRequest{azureDevOpsPullRequest(100, "branch-100", "sha")}
+
+	gitClientMock := azureMock.Client{}
+	gitClientMock.On("GetPullRequests", ctx, mock.MatchedBy(func(args git.GetPullRequestsArgs) bool {
+		return *args.Skip == 0
+	})).Return(&firstPage, nil)
+	gitClientMock.On("GetPullRequests", ctx, mock.MatchedBy(func(args git.GetPullRequestsArgs) bool {
+		return *args.Skip == 100
+	})).Return(&secondPage, nil)
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+
+	svc := AzureDevOpsService{clientFactory: clientFactoryMock, project: "project", repo: "repo"}
+	list, err := svc.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, list, 101)
+	assert.Equal(t, "branch-100", list[100].Branch)
+}
+
+func TestAzureDevOpsListPullRequestsError(t *testing.T) {
+	ctx := context.Background()
+	gitClientMock := azureMock.Client{}
+	gitClientMock.On("GetPullRequests", ctx, mock.Anything).Return(nil, fmt.Errorf("boom"))
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+
+	svc := AzureDevOpsService{clientFactory: clientFactoryMock, project: "project", repo: "repo"}
+	_, err := svc.List(ctx)
+	assert.EqualError(t, err, "error listing pull requests for project/repo: boom")
+}
@@ -0,0 +1,142 @@
+package pull_request
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	bitbucket "github.com/ktrysmt/go-bitbucket"
+)
+
+const BitbucketCloudDefaultAPI = "https://api.bitbucket.org/2.0"
+
+type BitbucketCloudService struct {
+	client         *bitbucket.Client
+	owner          string
+	repositorySlug string
+	// Not supported for PRs by Bitbucket Cloud
+	// labels         []string
+}
+
+type BitbucketCloudPullRequestResponse struct {
+	Values []BitbucketCloudPullRequest `json:"values"`
+}
+
+type BitbucketCloudPullRequest struct {
+	ID          int                             `json:"id"`
+	Title       string                          `json:"title"`
+	Draft       bool                            `json:"draft"`
+	Source      BitbucketCloudPullRequestSource `json:"source"`
+	Destination BitbucketCloudPullRequestSource `json:"destination"`
+	Author      struct {
+		Nickname string `json:"nickname"`
+	} `json:"author"`
+}
+
+type BitbucketCloudPullRequestSource struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	Commit struct {
+		// Bitbucket Cloud only reports the abbreviated (12 character) hash of the head commit, see getFullCommitHash
+		Hash string `json:"hash"`
+	} `json:"commit"`
+}
+
+var _ PullRequestService = (*BitbucketCloudService)(nil)
+
+func NewBitbucketCloudServiceBasicAuth(api, username, password, owner, repositorySlug string) (PullRequestService, error) {
+	return newBitbucketCloudService(bitbucket.NewBasicAuth(username, password), api, owner, repositorySlug)
+}
+
+func NewBitbucketCloudServiceBearerToken(api, token, owner, repositorySlug string) (PullRequestService, error) {
+	return newBitbucketCloudService(bitbucket.NewOAuthbearerToken(token), api, owner, repositorySlug)
+}
+
+func NewBitbucketCloudServiceNoAuth(api, owner, repositorySlug string) (PullRequestService, error) {
+	return newBitbucketCloudService(bitbucket.NewBasicAuth("", ""), api, owner, repositorySlug)
+}
+
+func newBitbucketCloudService(client *bitbucket.Client, api, owner, repositorySlug string) (PullRequestService, error) {
+	if api == "" {
+		api = BitbucketCloudDefaultAPI
+	}
+	apiURL, err := url.Parse(strings.TrimSuffix(api, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Bitbucket Cloud API URL %q: %v", api, err)
+	}
+	client.SetApiBaseURL(*apiURL)
+
+	return &BitbucketCloudService{
+		client:         client,
+		owner:          owner,
+		repositorySlug: repositorySlug,
+	}, nil
+}
+
+func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error) {
+	opts := &bitbucket.PullRequestsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		States:   []string{"OPEN"},
+	}
+	response, err := b.client.Repositories.PullRequests.Gets(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %v", b.owner, b.repositorySlug, err)
+	}
+
+	// The client returns the decoded JSON of all the pages, so it is converted back into a typed response
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %v", b.owner, b.repositorySlug, err)
+	}
+	var pulls BitbucketCloudPullRequestResponse
+	if err := json.Unmarshal(data, &pulls); err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %v", b.owner, b.repositorySlug, err)
+	}
+
+	pullRequests := []*PullRequest{}
+	for _, pull := range pulls.Values {
+		headSHA, err := b.getFullCommitHash(pull.Source.Commit.Hash)
+		if err != nil {
+			return nil, err
+		}
+		pullRequests = append(pullRequests, &PullRequest{
+			Number:       pull.ID,
+			Branch:       pull.Source.Branch.Name,
+			HeadSHA:      headSHA,
+			TargetBranch: pull.Destination.Branch.Name,
+			Title:        pull.Title,
+			Author:       pull.Author.Nickname,
+			Draft:        pull.Draft,
+		})
+	}
+	return pullRequests, nil
+}
+
+// getFullCommitHash resolves the abbreviated hash reported in pull requests into the full hash of the commit, so that
+// the head_sha parameter is the same as with the other providers
+func (b *BitbucketCloudService) getFullCommitHash(hash string) (string, error) {
+	if len(hash) == 40 {
+		return hash, nil
+	}
+	response, err := b.client.Repositories.Commits.GetCommit(&bitbucket.CommitsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		Revision: hash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting commit %s of %s/%s: %v", hash, b.owner, b.repositorySlug, err)
+	}
+	commit, ok := response.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("unexpected response for commit %s of %s/%s", hash, b.owner, b.repositorySlug)
+	}
+	fullHash, ok := commit["hash"].(string)
+	if !ok || fullHash == "" {
+		return "", fmt.Errorf("commit %s of %s/%s has no hash", hash, b.owner, b.repositorySlug)
+	}
+	return fullHash, nil
+}
@@ -65,10 +65,17 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 		}
 
 		for _, pull := range pulls {
+			var author string
+			if pull.Author != nil {
+				author = pull.Author.User.Name
+			}
 			pullRequests = append(pullRequests, &PullRequest{
-				Number:  pull.ID,
-				Branch:  pull.FromRef.DisplayID,    // ID: refs/heads/main DisplayID: main
-				HeadSHA: pull.FromRef.LatestCommit, // This is not defined in the official docs, but works in practice
+				Number:       pull.ID,
+				Branch:       pull.FromRef.DisplayID,    // ID: refs/heads/main DisplayID: main
+				HeadSHA:      pull.FromRef.LatestCommit, // This is not defined in the official docs, but works in practice
+				TargetBranch: pull.ToRef.DisplayID,
+				Title:        pull.Title,
+				Author:       author,
 			})
 		}
 
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"os"
+	"strings"
 
 	"code.gitea.io/sdk/gitea"
 )
@@ -53,11 +54,35 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	list := []*PullRequest{}
 	for _, pr := range prs {
+		var author string
+		if pr.Poster != nil {
+			author = pr.Poster.UserName
+		}
+		var targetBranch string
+		if pr.Base != nil {
+			targetBranch = pr.Base.Ref
+		}
+		labels := make([]string, 0, len(pr.Labels))
+		for _, label := range pr.Labels {
+			labels = append(labels, label.Name)
+		}
 		list = append(list, &PullRequest{
-			Number:  int(pr.Index),
-			Branch:  pr.Head.Ref,
-			HeadSHA: pr.Head.Sha,
+			Number:       int(pr.Index),
+			Branch:       pr.Head.Ref,
+			HeadSHA:      pr.Head.Sha,
+			TargetBranch: targetBranch,
+			Title:        pr.Title,
+			Author:       author,
+			Labels:       labels,
+			Draft:        isGiteaDraft(pr.Title),
 		})
 	}
 	return list, nil
 }
+
+// isGiteaDraft returns whether the title marks the pull request as work in progress, which is how Gitea represents
+// draft pull requests
+func isGiteaDraft(title string) bool {
+	title = strings.ToUpper(strings.TrimSpace(title))
+	return strings.HasPrefix(title, "WIP:") || strings.HasPrefix(title, "[WIP]")
+}
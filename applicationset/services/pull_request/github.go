@@ -65,9 +65,14 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 				continue
 			}
 			pullRequests = append(pullRequests, &PullRequest{
-				Number:  *pull.Number,
-				Branch:  *pull.Head.Ref,
-				HeadSHA: *pull.Head.SHA,
+				Number:       *pull.Number,
+				Branch:       *pull.Head.Ref,
+				HeadSHA:      *pull.Head.SHA,
+				TargetBranch: pull.GetBase().GetRef(),
+				Title:        pull.GetTitle(),
+				Author:       pull.GetUser().GetLogin(),
+				Labels:       getGithubLabelNames(pull.Labels),
+				Draft:        pull.GetDraft(),
 			})
 		}
 		if resp.NextPage == 0 {
@@ -78,6 +83,17 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+// getGithubLabelNames returns the names of the given labels
+func getGithubLabelNames(labels []*github.Label) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if label.Name != nil {
+			names = append(names, *label.Name)
+		}
+	}
+	return names
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
@@ -68,10 +68,20 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 			return nil, fmt.Errorf("error listing merge requests for project '%s': %v", g.project, err)
 		}
 		for _, mr := range mrs {
+			var author string
+			if mr.Author != nil {
+				author = mr.Author.Username
+			}
 			pullRequests = append(pullRequests, &PullRequest{
-				Number:  mr.IID,
-				Branch:  mr.SourceBranch,
-				HeadSHA: mr.SHA,
+				Number:       mr.IID,
+				Branch:       mr.SourceBranch,
+				HeadSHA:      mr.SHA,
+				TargetBranch: mr.TargetBranch,
+				Title:        mr.Title,
+				Author:       author,
+				Labels:       mr.Labels,
+				// Older GitLab versions only report drafts as work in progress
+				Draft: mr.Draft || mr.WorkInProgress,
 			})
 		}
 		if resp.NextPage == 0 {
@@ -12,6 +12,16 @@ type PullRequest struct {
 	Branch string
 	// HeadSHA is the SHA of the HEAD from which the pull request originated.
 	HeadSHA string
+	// TargetBranch is the name of the branch the pull request is to be merged into.
+	TargetBranch string
+	// Title is the title of the pull request.
+	Title string
+	// Author is the username of the author of the pull request.
+	Author string
+	// Labels are the names of the labels of the pull request.
+	Labels []string
+	// Draft is whether the pull request is a draft.
+	Draft bool
 }
 
 type PullRequestService interface {
@@ -20,5 +30,9 @@ type PullRequestService interface {
 }
 
 type Filter struct {
-	BranchMatch *regexp.Regexp
+	BranchMatch       *regexp.Regexp
+	TargetBranchMatch *regexp.Regexp
+	TitleMatch        *regexp.Regexp
+	AuthorMatch       *regexp.Regexp
+	Draft             *bool
 }
@@ -19,6 +19,25 @@ func compileFilters(filters []argoprojiov1alpha1.PullRequestGeneratorFilter) ([]
 				return nil, fmt.Errorf("error compiling BranchMatch regexp %q: %v", *filter.BranchMatch, err)
 			}
 		}
+		if filter.TargetBranchMatch != nil {
+			outFilter.TargetBranchMatch, err = regexp.Compile(*filter.TargetBranchMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling TargetBranchMatch regexp %q: %v", *filter.TargetBranchMatch, err)
+			}
+		}
+		if filter.TitleMatch != nil {
+			outFilter.TitleMatch, err = regexp.Compile(*filter.TitleMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling TitleMatch regexp %q: %v", *filter.TitleMatch, err)
+			}
+		}
+		if filter.AuthorMatch != nil {
+			outFilter.AuthorMatch, err = regexp.Compile(*filter.AuthorMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling AuthorMatch regexp %q: %v", *filter.AuthorMatch, err)
+			}
+		}
+		outFilter.Draft = filter.Draft
 		outFilters = append(outFilters, outFilter)
 	}
 	return outFilters, nil
@@ -28,6 +47,18 @@ func matchFilter(pullRequest *PullRequest, filter *Filter) bool {
 	if filter.BranchMatch != nil && !filter.BranchMatch.MatchString(pullRequest.Branch) {
 		return false
 	}
+	if filter.TargetBranchMatch != nil && !filter.TargetBranchMatch.MatchString(pullRequest.TargetBranch) {
+		return false
+	}
+	if filter.TitleMatch != nil && !filter.TitleMatch.MatchString(pullRequest.Title) {
+		return false
+	}
+	if filter.AuthorMatch != nil && !filter.AuthorMatch.MatchString(pullRequest.Author) {
+		return false
+	}
+	if filter.Draft != nil && *filter.Draft != pullRequest.Draft {
+		return false
+	}
 
 	return true
 }
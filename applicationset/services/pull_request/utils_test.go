@@ -137,3 +137,85 @@ func TestNoFilters(t *testing.T) {
 	assert.Equal(t, "one", repos[0].Branch)
 	assert.Equal(t, "two", repos[1].Branch)
 }
+
+func TestFilterBadRegexps(t *testing.T) {
+	provider, _ := NewFakeService(context.Background(), []*PullRequest{{Number: 1, Branch: "branch1"}}, nil)
+	for _, filter := range []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{TargetBranchMatch: strp("(")},
+		{TitleMatch: strp("(")},
+		{AuthorMatch: strp("(")},
+	} {
+		_, err := ListPullRequests(context.Background(), provider, []argoprojiov1alpha1.PullRequestGeneratorFilter{filter})
+		assert.Error(t, err)
+	}
+}
+
+func TestFilterTargetBranchTitleAuthorDraft(t *testing.T) {
+	provider, _ := NewFakeService(
+		context.Background(),
+		[]*PullRequest{
+			{
+				Number:       1,
+				Branch:       "one",
+				TargetBranch: "main",
+				Title:        "Add feature one",
+				Author:       "alice",
+			},
+			{
+				Number:       2,
+				Branch:       "two",
+				TargetBranch: "main",
+				Title:        "WIP: add feature two",
+				Author:       "bob",
+				Draft:        true,
+			},
+			{
+				Number:       3,
+				Branch:       "three",
+				TargetBranch: "release-1.0",
+				Title:        "Fix bug three",
+				Author:       "alice",
+			},
+		},
+		nil,
+	)
+	draft := false
+	cases := []struct {
+		name     string
+		filter   argoprojiov1alpha1.PullRequestGeneratorFilter
+		expected []string
+	}{
+		{
+			name:     "target branch",
+			filter:   argoprojiov1alpha1.PullRequestGeneratorFilter{TargetBranchMatch: strp("^main$")},
+			expected: []string{"one", "two"},
+		},
+		{
+			name:     "title",
+			filter:   argoprojiov1alpha1.PullRequestGeneratorFilter{TitleMatch: strp("(?i)feature")},
+			expected: []string{"one", "two"},
+		},
+		{
+			name:     "author",
+			filter:   argoprojiov1alpha1.PullRequestGeneratorFilter{AuthorMatch: strp("^alice$")},
+			expected: []string{"one", "three"},
+		},
+		{
+			name:     "non-draft against main",
+			filter:   argoprojiov1alpha1.PullRequestGeneratorFilter{TargetBranchMatch: strp("^main$"), Draft: &draft},
+			expected: []string{"one"},
+		},
+	}
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			pullRequests, err := ListPullRequests(context.Background(), provider, []argoprojiov1alpha1.PullRequestGeneratorFilter{cc.filter})
+			assert.NoError(t, err)
+			branches := []string{}
+			for _, pullRequest := range pullRequests {
+				branches = append(branches, pullRequest.Branch)
+			}
+			assert.Equal(t, cc.expected, branches)
+		})
+	}
+}
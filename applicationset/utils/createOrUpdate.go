@@ -2,18 +2,26 @@ package utils
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/itchyny/gojq"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
 )
 
 // CreateOrUpdate overrides "sigs.k8s.io/controller-runtime" function
@@ -27,9 +35,10 @@ import (
 // state inside the passed in callback MutateFn.
 //
 // The MutateFn is called regardless of creating or updating an object.
+// When updating, the fields matched by ignoreAppDifferences keep their value from the existing object.
 //
 // It returns the executed operation and an error.
-func CreateOrUpdate(ctx context.Context, c client.Client, obj client.Object, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+func CreateOrUpdate(ctx context.Context, c client.Client, ignoreAppDifferences []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences, obj *argov1alpha1.Application, f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
 
 	key := client.ObjectKeyFromObject(obj)
 	if err := c.Get(ctx, key, obj); err != nil {
@@ -45,11 +54,27 @@ func CreateOrUpdate(ctx context.Context, c client.Client, obj client.Object, f c
 		return controllerutil.OperationResultCreated, nil
 	}
 
-	existing := obj.DeepCopyObject()
+	existing := obj.DeepCopy()
 	if err := mutate(f, key, obj); err != nil {
 		return controllerutil.OperationResultNone, err
 	}
 
+	if err := ApplyIgnoreDifferences(ignoreAppDifferences, existing, obj); err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to apply ignore differences: %v", err)
+	}
+
+	if EqualApplications(existing, obj) {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	if err := c.Update(ctx, obj); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	return controllerutil.OperationResultUpdated, nil
+}
+
+// EqualApplications returns true if the given Applications are semantically equal.
+func EqualApplications(a, b *argov1alpha1.Application) bool {
 	equality := conversion.EqualitiesOrDie(
 		func(a, b resource.Quantity) bool {
 			// Ignore formatting, only care that numeric value stayed the same.
@@ -75,14 +100,7 @@ func CreateOrUpdate(ctx context.Context, c client.Client, obj client.Object, f c
 		},
 	)
 
-	if equality.DeepEqual(existing, obj) {
-		return controllerutil.OperationResultNone, nil
-	}
-
-	if err := c.Update(ctx, obj); err != nil {
-		return controllerutil.OperationResultNone, err
-	}
-	return controllerutil.OperationResultUpdated, nil
+	return equality.DeepEqual(a, b)
 }
 
 // mutate wraps a MutateFn and applies validation to its result
@@ -95,3 +113,273 @@ func mutate(f controllerutil.MutateFn, key client.ObjectKey, obj client.Object)
 	}
 	return nil
 }
+
+// ApplyIgnoreDifferences sets the fields of the generated Application matched by the ignore rules to their value in the
+// live Application. Fields which are not set in the live Application are removed from the generated one. Each matched
+// field is copied on its own, so that a rule matching an element of a list does not replace the whole list.
+func ApplyIgnoreDifferences(ignoreAppDifferences []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences, live *argov1alpha1.Application, generated *argov1alpha1.Application) error {
+	if len(ignoreAppDifferences) == 0 {
+		return nil
+	}
+
+	liveObj, err := applicationToMap(live)
+	if err != nil {
+		return err
+	}
+	generatedObj, err := applicationToMap(generated)
+	if err != nil {
+		return err
+	}
+
+	var paths [][]interface{}
+	for _, ignore := range ignoreAppDifferences {
+		if ignore.Name != "" && ignore.Name != generated.Name {
+			continue
+		}
+		for _, pointer := range ignore.JSONPointers {
+			path, err := jsonPointerToPath(pointer)
+			if err != nil {
+				return err
+			}
+			paths = append(paths, path)
+		}
+		for _, expression := range ignore.JQPathExpressions {
+			// the expression is evaluated on both Applications, so that fields only set in the generated one are matched
+			for _, obj := range []interface{}{liveObj, generatedObj} {
+				expressionPaths, err := jqPaths(expression, obj)
+				if err != nil {
+					return err
+				}
+				paths = append(paths, expressionPaths...)
+			}
+		}
+	}
+
+	// the root of the Application is an object, which is updated in place
+	var removed [][]interface{}
+	seen := map[string]bool{}
+	for _, path := range paths {
+		key := fmt.Sprintf("%#v", path)
+		if len(path) == 0 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		value, ok := getPath(liveObj, path)
+		if ok {
+			// the live value cannot be placed if the generated Application has fewer list elements along the path, in
+			// which case the field is left out of the generated Application like a field not set in the live one
+			_, ok = setPath(generatedObj, path, runtime.DeepCopyJSONValue(value))
+		}
+		if !ok {
+			removed = append(removed, path)
+		}
+	}
+	// removing list elements shifts the following ones, so the elements of a list are removed last to first
+	sort.SliceStable(removed, func(i, j int) bool {
+		return comparePaths(removed[i], removed[j]) > 0
+	})
+	for _, path := range removed {
+		removePath(generatedObj, path)
+	}
+
+	patchedJSON, err := json.Marshal(generatedObj)
+	if err != nil {
+		return err
+	}
+	typeMeta := generated.TypeMeta
+	*generated = argov1alpha1.Application{}
+	if err := json.Unmarshal(patchedJSON, generated); err != nil {
+		return err
+	}
+	generated.TypeMeta = typeMeta
+	return nil
+}
+
+func applicationToMap(app *argov1alpha1.Application) (map[string]interface{}, error) {
+	data, err := json.Marshal(app)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// jsonPointerToPath splits a JSON pointer (RFC 6901) into the keys and indexes of the path it points to
+func jsonPointerToPath(pointer string) ([]interface{}, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with /", pointer)
+	}
+	var path []interface{}
+	for _, token := range strings.Split(pointer[1:], "/") {
+		path = append(path, strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~"))
+	}
+	return path, nil
+}
+
+// jqPaths returns the paths of the fields of obj matched by the jq path expression
+func jqPaths(expression string, obj interface{}) ([][]interface{}, error) {
+	query, err := gojq.Parse(fmt.Sprintf("path(%s)", expression))
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq path expression %q: %v", expression, err)
+	}
+	var paths [][]interface{}
+	iter := query.Run(obj)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			break
+		}
+		switch v := value.(type) {
+		case error:
+			// the expression does not apply to this object, e.g. it indexes a field which is not a list
+			continue
+		case []interface{}:
+			paths = append(paths, v)
+		}
+	}
+	return paths, nil
+}
+
+// comparePaths orders paths by their elements, comparing list indexes by their value. It returns a negative number if
+// a is ordered before b, a positive number if it is ordered after b, and 0 if the paths are equal.
+func comparePaths(a, b []interface{}) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		indexA, okA := pathIndex(a[i], math.MaxInt)
+		indexB, okB := pathIndex(b[i], math.MaxInt)
+		if okA && okB {
+			if indexA != indexB {
+				return indexA - indexB
+			}
+			continue
+		}
+		if c := strings.Compare(fmt.Sprint(a[i]), fmt.Sprint(b[i])); c != 0 {
+			return c
+		}
+	}
+	return len(a) - len(b)
+}
+
+// pathIndex returns the list index of a path element, which is an int for jq paths and a string for JSON pointers
+func pathIndex(element interface{}, length int) (int, bool) {
+	var index int
+	switch e := element.(type) {
+	case int:
+		index = e
+	case string:
+		i, err := strconv.Atoi(e)
+		if err != nil {
+			return 0, false
+		}
+		index = i
+	default:
+		return 0, false
+	}
+	return index, index >= 0 && index < length
+}
+
+func getPath(obj interface{}, path []interface{}) (interface{}, bool) {
+	if len(path) == 0 {
+		return obj, true
+	}
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		key, ok := path[0].(string)
+		if !ok {
+			return nil, false
+		}
+		child, ok := o[key]
+		if !ok {
+			return nil, false
+		}
+		return getPath(child, path[1:])
+	case []interface{}:
+		index, ok := pathIndex(path[0], len(o))
+		if !ok {
+			return nil, false
+		}
+		return getPath(o[index], path[1:])
+	}
+	return nil, false
+}
+
+// setPath sets the value at the given path, creating the missing objects along the path. It returns the updated
+// object, and false if the path cannot be set, e.g. because a list is too short.
+func setPath(obj interface{}, path []interface{}, value interface{}) (interface{}, bool) {
+	if len(path) == 0 {
+		return value, true
+	}
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		key, ok := path[0].(string)
+		if !ok {
+			return obj, false
+		}
+		child, ok := setPath(o[key], path[1:], value)
+		if ok {
+			o[key] = child
+		}
+		return o, ok
+	case []interface{}:
+		index, ok := pathIndex(path[0], len(o))
+		if !ok {
+			return obj, false
+		}
+		child, ok := setPath(o[index], path[1:], value)
+		if ok {
+			o[index] = child
+		}
+		return o, ok
+	case nil:
+		// lists cannot be created, since the elements before the index are unknown
+		if key, ok := path[0].(string); ok {
+			if _, err := strconv.Atoi(key); err != nil {
+				return setPath(map[string]interface{}{}, path, value)
+			}
+		}
+	}
+	return obj, false
+}
+
+// removePath removes the value at the given path, and returns the updated object
+func removePath(obj interface{}, path []interface{}) (interface{}, bool) {
+	if len(path) == 0 {
+		return obj, false
+	}
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		key, ok := path[0].(string)
+		if !ok {
+			return obj, false
+		}
+		if len(path) == 1 {
+			_, ok := o[key]
+			delete(o, key)
+			return o, ok
+		}
+		child, ok := removePath(o[key], path[1:])
+		if ok {
+			o[key] = child
+		}
+		return o, ok
+	case []interface{}:
+		index, ok := pathIndex(path[0], len(o))
+		if !ok {
+			return obj, false
+		}
+		if len(path) == 1 {
+			return append(o[:index:index], o[index+1:]...), true
+		}
+		child, ok := removePath(o[index], path[1:])
+		if ok {
+			o[index] = child
+		}
+		return o, ok
+	}
+	return obj, false
+}
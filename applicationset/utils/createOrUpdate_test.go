@@ -0,0 +1,228 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
+func ignoreDifferencesApp(name string, targetRevision string, parameters ...argov1alpha1.HelmParameter) *argov1alpha1.Application {
+	app := &argov1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "argocd",
+		},
+		Spec: argov1alpha1.ApplicationSpec{
+			Project: "default",
+			Source: argov1alpha1.ApplicationSource{
+				RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+				Path:           "helm-guestbook",
+				TargetRevision: targetRevision,
+			},
+			Destination: argov1alpha1.ApplicationDestination{
+				Server:    "https://kubernetes.default.svc",
+				Namespace: "guestbook",
+			},
+		},
+	}
+	if len(parameters) > 0 {
+		app.Spec.Source.Helm = &argov1alpha1.ApplicationSourceHelm{Parameters: parameters}
+	}
+	return app
+}
+
+// multiSourceApp returns an Application with a source for each of the given target revisions
+func multiSourceApp(targetRevisions ...string) *argov1alpha1.Application {
+	app := ignoreDifferencesApp("app", "")
+	app.Spec.Source = argov1alpha1.ApplicationSource{}
+	for i, targetRevision := range targetRevisions {
+		app.Spec.Sources = append(app.Spec.Sources, argov1alpha1.ApplicationSource{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+			Path:           fmt.Sprintf("app-%d", i),
+			TargetRevision: targetRevision,
+		})
+	}
+	return app
+}
+
+func TestApplyIgnoreDifferences(t *testing.T) {
+	cases := []struct {
+		name      string
+		ignore    []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences
+		live      *argov1alpha1.Application
+		generated *argov1alpha1.Application
+		expected  *argov1alpha1.Application
+	}{
+		{
+			name:      "no rules",
+			live:      ignoreDifferencesApp("app", "pinned"),
+			generated: ignoreDifferencesApp("app", "HEAD"),
+			expected:  ignoreDifferencesApp("app", "HEAD"),
+		},
+		{
+			name: "json pointer",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JSONPointers: []string{"/spec/source/targetRevision"}},
+			},
+			live:      ignoreDifferencesApp("app", "pinned"),
+			generated: ignoreDifferencesApp("app", "HEAD"),
+			expected:  ignoreDifferencesApp("app", "pinned"),
+		},
+		{
+			name: "jq path expression",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JQPathExpressions: []string{".spec.source.helm.parameters"}},
+			},
+			live:      ignoreDifferencesApp("app", "pinned", argov1alpha1.HelmParameter{Name: "image.tag", Value: "v2"}),
+			generated: ignoreDifferencesApp("app", "HEAD", argov1alpha1.HelmParameter{Name: "image.tag", Value: "v1"}),
+			expected:  ignoreDifferencesApp("app", "HEAD", argov1alpha1.HelmParameter{Name: "image.tag", Value: "v2"}),
+		},
+		{
+			name: "field not set in live Application",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JQPathExpressions: []string{".spec.source.helm"}},
+			},
+			live:      ignoreDifferencesApp("app", "HEAD"),
+			generated: ignoreDifferencesApp("app", "HEAD", argov1alpha1.HelmParameter{Name: "image.tag", Value: "v1"}),
+			expected:  ignoreDifferencesApp("app", "HEAD"),
+		},
+		{
+			name: "json pointer to a list element",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JSONPointers: []string{"/spec/sources/0/targetRevision"}},
+			},
+			live:      multiSourceApp("pinned", "v1"),
+			generated: multiSourceApp("HEAD", "v2", "v3"),
+			expected:  multiSourceApp("pinned", "v2", "v3"),
+		},
+		{
+			name: "json pointer to a list element not set in live Application",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JSONPointers: []string{"/spec/sources/2"}},
+			},
+			live:      multiSourceApp("pinned", "v1"),
+			generated: multiSourceApp("HEAD", "v2", "v3"),
+			expected:  multiSourceApp("HEAD", "v2"),
+		},
+		{
+			name: "json pointers to list elements not set in live Application",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JSONPointers: []string{"/spec/sources/3"}},
+				{JSONPointers: []string{"/spec/sources/1", "/spec/sources/2"}},
+			},
+			live:      multiSourceApp("pinned"),
+			generated: multiSourceApp("HEAD", "v2", "v3", "v4"),
+			expected:  multiSourceApp("HEAD"),
+		},
+		{
+			name: "json pointer to a list element not set in generated Application",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JSONPointers: []string{"/spec/sources/0/targetRevision", "/spec/sources/2/targetRevision"}},
+			},
+			live:      multiSourceApp("pinned", "v1", "v2"),
+			generated: multiSourceApp("HEAD"),
+			expected:  multiSourceApp("pinned"),
+		},
+		{
+			name: "jq path expression selecting list elements not set in generated Application",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JQPathExpressions: []string{".spec.source.helm.parameters[]"}},
+			},
+			live: ignoreDifferencesApp("app", "HEAD",
+				argov1alpha1.HelmParameter{Name: "image.tag", Value: "v2"},
+				argov1alpha1.HelmParameter{Name: "replicas", Value: "1"}),
+			generated: ignoreDifferencesApp("app", "HEAD",
+				argov1alpha1.HelmParameter{Name: "image.tag", Value: "v1"}),
+			expected: ignoreDifferencesApp("app", "HEAD",
+				argov1alpha1.HelmParameter{Name: "image.tag", Value: "v2"}),
+		},
+		{
+			name: "jq path expression selecting list elements",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{JQPathExpressions: []string{`.spec.source.helm.parameters[] | select(.name == "image.tag")`}},
+			},
+			live: ignoreDifferencesApp("app", "pinned",
+				argov1alpha1.HelmParameter{Name: "image.tag", Value: "v2"},
+				argov1alpha1.HelmParameter{Name: "replicas", Value: "1"}),
+			generated: ignoreDifferencesApp("app", "HEAD",
+				argov1alpha1.HelmParameter{Name: "image.tag", Value: "v1"},
+				argov1alpha1.HelmParameter{Name: "replicas", Value: "3"}),
+			expected: ignoreDifferencesApp("app", "HEAD",
+				argov1alpha1.HelmParameter{Name: "image.tag", Value: "v2"},
+				argov1alpha1.HelmParameter{Name: "replicas", Value: "3"}),
+		},
+		{
+			name: "rule for another Application",
+			ignore: []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+				{Name: "other", JSONPointers: []string{"/spec/source/targetRevision"}},
+			},
+			live:      ignoreDifferencesApp("app", "pinned"),
+			generated: ignoreDifferencesApp("app", "HEAD"),
+			expected:  ignoreDifferencesApp("app", "HEAD"),
+		},
+	}
+
+	for _, c := range cases {
+		cc := c
+		t.Run(cc.name, func(t *testing.T) {
+			err := ApplyIgnoreDifferences(cc.ignore, cc.live, cc.generated)
+			require.NoError(t, err)
+			assert.Equal(t, cc.expected, cc.generated)
+		})
+	}
+}
+
+func TestCreateOrUpdateIgnoreDifferences(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argov1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	ignore := []argoprojiov1alpha1.ApplicationSetResourceIgnoreDifferences{
+		{JSONPointers: []string{"/spec/source/targetRevision"}},
+	}
+	ctx := context.Background()
+
+	t.Run("only ignored fields differ", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ignoreDifferencesApp("app", "pinned")).Build()
+		generated := ignoreDifferencesApp("app", "HEAD")
+		found := &argov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"}}
+
+		action, err := CreateOrUpdate(ctx, c, ignore, found, func() error {
+			found.Spec = generated.Spec
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, controllerutil.OperationResultNone, action)
+	})
+
+	t.Run("other fields differ", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ignoreDifferencesApp("app", "pinned")).Build()
+		generated := ignoreDifferencesApp("app", "HEAD")
+		generated.Spec.Source.Path = "guestbook"
+		found := &argov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"}}
+
+		action, err := CreateOrUpdate(ctx, c, ignore, found, func() error {
+			found.Spec = generated.Spec
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, controllerutil.OperationResultUpdated, action)
+
+		updated := &argov1alpha1.Application{}
+		err = c.Get(ctx, client.ObjectKey{Name: "app", Namespace: "argocd"}, updated)
+		require.NoError(t, err)
+		assert.Equal(t, "pinned", updated.Spec.Source.TargetRevision)
+		assert.Equal(t, "guestbook", updated.Spec.Source.Path)
+	})
+}
@@ -2,10 +2,11 @@ package utils
 
 import (
 	"fmt"
+	"reflect"
 )
 
-func CombineStringMaps(a map[string]string, b map[string]string) (map[string]string, error) {
-	res := map[string]string{}
+func CombineMaps(a map[string]interface{}, b map[string]interface{}) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
 
 	for k, v := range a {
 		res[k] = v
@@ -13,8 +14,8 @@ func CombineStringMaps(a map[string]string, b map[string]string) (map[string]str
 
 	for k, v := range b {
 		current, present := res[k]
-		if present && current != v {
-			return nil, fmt.Errorf("found duplicate key %s with different value, a: %s ,b: %s", k, current, v)
+		if present && !reflect.DeepEqual(current, v) {
+			return nil, fmt.Errorf("found duplicate key %s with different value, a: %v ,b: %v", k, current, v)
 		}
 		res[k] = v
 	}
@@ -22,9 +23,9 @@ func CombineStringMaps(a map[string]string, b map[string]string) (map[string]str
 	return res, nil
 }
 
-// CombineStringMapsAllowDuplicates merges two maps. Where there are duplicates, take the latter map's value.
-func CombineStringMapsAllowDuplicates(a map[string]string, b map[string]string) (map[string]string, error) {
-	res := map[string]string{}
+// CombineMapsAllowDuplicates merges two maps. Where there are duplicates, take the latter map's value.
+func CombineMapsAllowDuplicates(a map[string]interface{}, b map[string]interface{}) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
 
 	for k, v := range a {
 		res[k] = v
@@ -36,3 +37,39 @@ func CombineStringMapsAllowDuplicates(a map[string]string, b map[string]string)
 
 	return res, nil
 }
+
+// DeepCopyMap returns a copy of the given params which shares no nested maps or slices with the original, so that
+// the copy can be modified without changing the original.
+func DeepCopyMap(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	res := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		res[k] = deepCopyValue(v)
+	}
+	return res
+}
+
+func deepCopyValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return DeepCopyMap(v)
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, item := range v {
+			res[i] = deepCopyValue(item)
+		}
+		return res
+	case map[string]string:
+		res := make(map[string]string, len(v))
+		for k, item := range v {
+			res[k] = item
+		}
+		return res
+	case []string:
+		return append([]string(nil), v...)
+	default:
+		return v
+	}
+}
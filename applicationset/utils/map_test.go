@@ -7,33 +7,33 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCombineStringMaps(t *testing.T) {
+func TestCombineMaps(t *testing.T) {
 	testCases := []struct {
 		name        string
-		left        map[string]string
-		right       map[string]string
-		expected    map[string]string
+		left        map[string]interface{}
+		right       map[string]interface{}
+		expected    map[string]interface{}
 		expectedErr error
 	}{
 		{
 			name:        "combines the maps",
-			left:        map[string]string{"foo": "bar"},
-			right:       map[string]string{"a": "b"},
-			expected:    map[string]string{"a": "b", "foo": "bar"},
+			left:        map[string]interface{}{"foo": "bar"},
+			right:       map[string]interface{}{"a": "b"},
+			expected:    map[string]interface{}{"a": "b", "foo": "bar"},
 			expectedErr: nil,
 		},
 		{
 			name:        "fails if keys are the same but value isn't",
-			left:        map[string]string{"foo": "bar", "a": "fail"},
-			right:       map[string]string{"a": "b", "c": "d"},
-			expected:    map[string]string{"a": "b", "foo": "bar"},
+			left:        map[string]interface{}{"foo": "bar", "a": "fail"},
+			right:       map[string]interface{}{"a": "b", "c": "d"},
+			expected:    map[string]interface{}{"a": "b", "foo": "bar"},
 			expectedErr: fmt.Errorf("found duplicate key a with different value, a: fail ,b: b"),
 		},
 		{
 			name:        "pass if keys & values are the same",
-			left:        map[string]string{"foo": "bar", "a": "b"},
-			right:       map[string]string{"a": "b", "c": "d"},
-			expected:    map[string]string{"a": "b", "c": "d", "foo": "bar"},
+			left:        map[string]interface{}{"foo": "bar", "a": "b"},
+			right:       map[string]interface{}{"a": "b", "c": "d"},
+			expected:    map[string]interface{}{"a": "b", "c": "d", "foo": "bar"},
 			expectedErr: nil,
 		},
 	}
@@ -44,7 +44,7 @@ func TestCombineStringMaps(t *testing.T) {
 		t.Run(testCaseCopy.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := CombineStringMaps(testCaseCopy.left, testCaseCopy.right)
+			got, err := CombineMaps(testCaseCopy.left, testCaseCopy.right)
 
 			if testCaseCopy.expectedErr != nil {
 				assert.EqualError(t, err, testCaseCopy.expectedErr.Error())
@@ -56,3 +56,23 @@ func TestCombineStringMaps(t *testing.T) {
 		})
 	}
 }
+
+func TestDeepCopyMap(t *testing.T) {
+	original := map[string]interface{}{
+		"path":   map[string]interface{}{"basename": "app1"},
+		"values": []interface{}{map[string]interface{}{"a": "b"}},
+		"labels": map[string]string{"env": "dev"},
+	}
+	copied := DeepCopyMap(original)
+	assert.Equal(t, original, copied)
+
+	copied["path"].(map[string]interface{})["basename"] = "changed"
+	copied["values"].([]interface{})[0].(map[string]interface{})["a"] = "changed"
+	copied["labels"].(map[string]string)["env"] = "changed"
+
+	assert.Equal(t, map[string]interface{}{
+		"path":   map[string]interface{}{"basename": "app1"},
+		"values": []interface{}{map[string]interface{}{"a": "b"}},
+		"labels": map[string]string{"env": "dev"},
+	}, original)
+}
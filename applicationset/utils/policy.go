@@ -1,5 +1,11 @@
 package utils
 
+import (
+	"fmt"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
 // Policy allows to apply different rules to a set of changes.
 type Policy interface {
 	Update() bool
@@ -42,3 +48,33 @@ func (p *CreateOnlyPolicy) Update() bool {
 func (p *CreateOnlyPolicy) Delete() bool {
 	return false
 }
+
+// ApplicationSetPolicy returns the policy applied to the Applications of the ApplicationSet: the given policy of the
+// controller, further restricted by the applications sync policy of the ApplicationSet, if any.
+func ApplicationSetPolicy(controllerPolicy Policy, applicationSet *argoprojiov1alpha1.ApplicationSet) (Policy, error) {
+	if applicationSet.Spec.SyncPolicy == nil || applicationSet.Spec.SyncPolicy.ApplicationsSync == nil {
+		return controllerPolicy, nil
+	}
+	policy, ok := Policies[string(*applicationSet.Spec.SyncPolicy.ApplicationsSync)]
+	if !ok {
+		return nil, fmt.Errorf("invalid applications sync policy %q, must be one of create-only, create-update or sync", *applicationSet.Spec.SyncPolicy.ApplicationsSync)
+	}
+	return RestrictPolicy(controllerPolicy, policy), nil
+}
+
+// RestrictPolicy returns a policy which only allows the changes allowed by both of the given policies.
+func RestrictPolicy(a, b Policy) Policy {
+	return &restrictedPolicy{a: a, b: b}
+}
+
+type restrictedPolicy struct {
+	a, b Policy
+}
+
+func (p *restrictedPolicy) Update() bool {
+	return p.a.Update() && p.b.Update()
+}
+
+func (p *restrictedPolicy) Delete() bool {
+	return p.a.Delete() && p.b.Delete()
+}
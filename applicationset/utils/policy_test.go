@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestrictPolicy(t *testing.T) {
+	for _, c := range []struct {
+		a, b           Policy
+		update, delete bool
+	}{
+		{a: &SyncPolicy{}, b: &SyncPolicy{}, update: true, delete: true},
+		{a: &SyncPolicy{}, b: &CreateUpdatePolicy{}, update: true},
+		{a: &CreateUpdatePolicy{}, b: &SyncPolicy{}, update: true},
+		{a: &SyncPolicy{}, b: &CreateOnlyPolicy{}},
+		{a: &CreateOnlyPolicy{}, b: &CreateUpdatePolicy{}},
+	} {
+		policy := RestrictPolicy(c.a, c.b)
+		assert.Equal(t, c.update, policy.Update())
+		assert.Equal(t, c.delete, policy.Delete())
+	}
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,22 +9,36 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 
+	"github.com/Masterminds/sprig"
 	log "github.com/sirupsen/logrus"
 	"github.com/valyala/fasttemplate"
+	"sigs.k8s.io/yaml"
 
 	argoappsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	argoappsetv1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
 )
 
+var sprigFuncMap = sprig.GenericFuncMap() // a singleton for better performance
+
+func init() {
+	// Avoid allowing the user to learn things about the environment.
+	delete(sprigFuncMap, "env")
+	delete(sprigFuncMap, "expandenv")
+	delete(sprigFuncMap, "getHostByName")
+	sprigFuncMap["toYaml"] = toYAML
+	sprigFuncMap["fromYaml"] = fromYAML
+}
+
 type Renderer interface {
-	RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *argoappsetv1.ApplicationSetSyncPolicy, params map[string]string) (*argoappsv1.Application, error)
+	RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *argoappsetv1.ApplicationSetSyncPolicy, params map[string]interface{}, useGoTemplate bool) (*argoappsv1.Application, error)
 }
 
 type Render struct {
 }
 
-func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *argoappsetv1.ApplicationSetSyncPolicy, params map[string]string) (*argoappsv1.Application, error) {
+func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *argoappsetv1.ApplicationSetSyncPolicy, params map[string]interface{}, useGoTemplate bool) (*argoappsv1.Application, error) {
 	if tmpl == nil {
 		return nil, fmt.Errorf("application template is empty ")
 	}
@@ -37,14 +52,23 @@ func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *
 		return nil, err
 	}
 
-	fstTmpl := fasttemplate.New(string(tmplBytes), "{{", "}}")
-	replacedTmplStr, err := r.Replace(fstTmpl, params, true)
-	if err != nil {
-		return nil, err
+	var replacedTmplBytes []byte
+	if useGoTemplate {
+		replacedTmplBytes, err = r.ReplaceGoTemplateJSON(tmplBytes, params)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		fstTmpl := fasttemplate.New(string(tmplBytes), "{{", "}}")
+		replacedTmplStr, err := r.Replace(fstTmpl, params, true)
+		if err != nil {
+			return nil, err
+		}
+		replacedTmplBytes = []byte(replacedTmplStr)
 	}
 
 	var replacedTmpl argoappsv1.Application
-	err = json.Unmarshal([]byte(replacedTmplStr), &replacedTmpl)
+	err = json.Unmarshal(replacedTmplBytes, &replacedTmpl)
 	if err != nil {
 		return nil, err
 	}
@@ -66,13 +90,13 @@ func (r *Render) RenderTemplateParams(tmpl *argoappsv1.Application, syncPolicy *
 // Replace executes basic string substitution of a template with replacement values.
 // 'allowUnresolved' indicates whether it is acceptable to have unresolved variables
 // remaining in the substituted template.
-func (r *Render) Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]string, allowUnresolved bool) (string, error) {
+func (r *Render) Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]interface{}, allowUnresolved bool) (string, error) {
 	var unresolvedErr error
 	replacedTmpl := fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
 
 		trimmedTag := strings.TrimSpace(tag)
 
-		replacement, ok := replaceMap[trimmedTag]
+		value, ok := replaceMap[trimmedTag]
 		if len(trimmedTag) == 0 || !ok {
 			if allowUnresolved {
 				// just write the same string back
@@ -83,7 +107,7 @@ func (r *Render) Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]s
 		}
 		// The following escapes any special characters (e.g. newlines, tabs, etc...)
 		// in preparation for substitution
-		replacement = strconv.Quote(replacement)
+		replacement := strconv.Quote(fmt.Sprintf("%v", value))
 		replacement = replacement[1 : len(replacement)-1]
 		return w.Write([]byte(replacement))
 	})
@@ -94,6 +118,88 @@ func (r *Render) Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]s
 	return replacedTmpl, nil
 }
 
+// ReplaceGoTemplate renders a single string as a Go text/template, using the given parameters as the template data.
+// Referencing a parameter which does not exist is an error.
+func (r *Render) ReplaceGoTemplate(text string, params map[string]interface{}) (string, error) {
+	tmpl, err := template.New("").Funcs(sprigFuncMap).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template %s: %w", text, err)
+	}
+
+	var replacedTmplBuffer bytes.Buffer
+	if err = tmpl.Execute(&replacedTmplBuffer, params); err != nil {
+		return "", fmt.Errorf("failed to execute go template %s: %w", text, err)
+	}
+	return replacedTmplBuffer.String(), nil
+}
+
+// ReplaceGoTemplateJSON renders every string value of the given JSON document as a Go text/template. Only values are
+// rendered (keys are left untouched), so that templates may contain quotes and other characters which would otherwise
+// need to be escaped to keep the document valid JSON.
+func (r *Render) ReplaceGoTemplateJSON(jsonBytes []byte, params map[string]interface{}) ([]byte, error) {
+	var doc interface{}
+	if err := json.Unmarshal(jsonBytes, &doc); err != nil {
+		return nil, err
+	}
+
+	replacedDoc, err := r.replaceGoTemplateValue(doc, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(replacedDoc)
+}
+
+func (r *Render) replaceGoTemplateValue(value interface{}, params map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if !strings.Contains(v, "{{") {
+			return v, nil
+		}
+		return r.ReplaceGoTemplate(v, params)
+	case map[string]interface{}:
+		for key, item := range v {
+			replaced, err := r.replaceGoTemplateValue(item, params)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = replaced
+		}
+		return v, nil
+	case []interface{}:
+		for i, item := range v {
+			replaced, err := r.replaceGoTemplateValue(item, params)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = replaced
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// toYAML marshals the given value to YAML, trimming the trailing newline. Errors are swallowed, as in the other
+// sprig encoding functions, so that the function can be used inline within a template.
+func toYAML(v interface{}) string {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
+
+// fromYAML unmarshals the given YAML string into a map. If the string cannot be parsed, the returned map contains a
+// single "Error" key describing the failure.
+func fromYAML(str string) map[string]interface{} {
+	m := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(str), &m); err != nil {
+		m["Error"] = err.Error()
+	}
+	return m
+}
+
 // Log a warning if there are unrecognized generators
 func CheckInvalidGenerators(applicationSetInfo *argoappsetv1.ApplicationSet) {
 	hasInvalidGenerators, invalidGenerators := invalidGenerators(applicationSetInfo)
@@ -48,14 +48,14 @@ func TestRenderTemplateParams(t *testing.T) {
 	tests := []struct {
 		name        string
 		fieldVal    string
-		params      map[string]string
+		params      map[string]interface{}
 		expectedVal string
 	}{
 		{
 			name:        "simple substitution",
 			fieldVal:    "{{one}}",
 			expectedVal: "two",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"one": "two",
 			},
 		},
@@ -63,7 +63,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "simple substitution with whitespace",
 			fieldVal:    "{{ one }}",
 			expectedVal: "two",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"one": "two",
 			},
 		},
@@ -72,7 +72,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "template characters but not in a template",
 			fieldVal:    "}} {{",
 			expectedVal: "}} {{",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"one": "two",
 			},
 		},
@@ -81,7 +81,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "nested template",
 			fieldVal:    "{{ }}",
 			expectedVal: "{{ }}",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"one": "{{ }}",
 			},
 		},
@@ -89,7 +89,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "field with whitespace",
 			fieldVal:    "{{ }}",
 			expectedVal: "{{ }}",
-			params: map[string]string{
+			params: map[string]interface{}{
 				" ": "two",
 				"":  "three",
 			},
@@ -99,7 +99,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "template contains itself, containing itself",
 			fieldVal:    "{{one}}",
 			expectedVal: "{{one}}",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"{{one}}": "{{one}}",
 			},
 		},
@@ -108,7 +108,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "template contains itself, containing something else",
 			fieldVal:    "{{one}}",
 			expectedVal: "{{one}}",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"{{one}}": "{{two}}",
 			},
 		},
@@ -117,7 +117,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "templates are case sensitive",
 			fieldVal:    "{{ONE}}",
 			expectedVal: "{{ONE}}",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"{{one}}": "two",
 			},
 		},
@@ -125,7 +125,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "multiple on a line",
 			fieldVal:    "{{one}}{{one}}",
 			expectedVal: "twotwo",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"one": "two",
 			},
 		},
@@ -133,7 +133,7 @@ func TestRenderTemplateParams(t *testing.T) {
 			name:        "multiple different on a line",
 			fieldVal:    "{{one}}{{three}}",
 			expectedVal: "twofour",
-			params: map[string]string{
+			params: map[string]interface{}{
 				"one":   "two",
 				"three": "four",
 			},
@@ -154,7 +154,7 @@ func TestRenderTemplateParams(t *testing.T) {
 
 				// Render the cloned application, into a new application
 				render := Render{}
-				newApplication, err := render.RenderTemplateParams(application, nil, test.params)
+				newApplication, err := render.RenderTemplateParams(application, nil, test.params, false)
 
 				// Retrieve the value of the target field from the newApplication, then verify that
 				// the target field has been templated into the expected value
@@ -255,14 +255,14 @@ func TestRenderTemplateParamsFinalizers(t *testing.T) {
 			application := emptyApplication.DeepCopy()
 			application.Finalizers = c.existingFinalizers
 
-			params := map[string]string{
+			params := map[string]interface{}{
 				"one": "two",
 			}
 
 			// Render the cloned application, into a new application
 			render := Render{}
 
-			res, err := render.RenderTemplateParams(application, c.syncPolicy, params)
+			res, err := render.RenderTemplateParams(application, c.syncPolicy, params, false)
 			assert.Nil(t, err)
 
 			assert.ElementsMatch(t, res.Finalizers, c.expectedFinalizers)
@@ -273,6 +273,123 @@ func TestRenderTemplateParamsFinalizers(t *testing.T) {
 
 }
 
+func TestRenderTemplateParamsGoTemplate(t *testing.T) {
+
+	// Believe it or not, this is actually less complex than the equivalent solution using reflection
+	fieldMap := map[string]func(app *argoappsv1.Application) *string{}
+	fieldMap["Path"] = func(app *argoappsv1.Application) *string { return &app.Spec.Source.Path }
+	fieldMap["RepoURL"] = func(app *argoappsv1.Application) *string { return &app.Spec.Source.RepoURL }
+	fieldMap["Namespace"] = func(app *argoappsv1.Application) *string { return &app.Spec.Destination.Namespace }
+	fieldMap["Project"] = func(app *argoappsv1.Application) *string { return &app.Spec.Project }
+
+	tests := []struct {
+		name         string
+		fieldVal     string
+		params       map[string]interface{}
+		expectedVal  string
+		errorMessage string
+	}{
+		{
+			name:        "simple substitution",
+			fieldVal:    "{{ .one }}",
+			expectedVal: "two",
+			params: map[string]interface{}{
+				"one": "two",
+			},
+		},
+		{
+			name:        "nested substitution",
+			fieldVal:    "{{ .path.basename }}",
+			expectedVal: "app",
+			params: map[string]interface{}{
+				"path": map[string]interface{}{
+					"basename": "app",
+				},
+			},
+		},
+		{
+			name:        "quotes are preserved",
+			fieldVal:    `{{ "\"quoted\"" }}`,
+			expectedVal: `"quoted"`,
+			params: map[string]interface{}{
+				"one": "two",
+			},
+		},
+		{
+			name:        "conditional",
+			fieldVal:    "{{ if .prod }}prod{{ else }}dev{{ end }}",
+			expectedVal: "dev",
+			params: map[string]interface{}{
+				"prod": false,
+			},
+		},
+		{
+			name:        "range over a list",
+			fieldVal:    "{{ range $i, $s := .path.segments }}{{ if $i }}-{{ end }}{{ $s }}{{ end }}",
+			expectedVal: "apps-guestbook",
+			params: map[string]interface{}{
+				"path": map[string]interface{}{
+					"segments": []string{"apps", "guestbook"},
+				},
+			},
+		},
+		{
+			name:        "function library",
+			fieldVal:    "{{ .name | lower | trunc 5 }}-{{ .missing | default \"none\" }}",
+			expectedVal: "clust-none",
+			params: map[string]interface{}{
+				"name":    "CLUSTER",
+				"missing": "",
+			},
+		},
+		{
+			name:        "toYaml",
+			fieldVal:    "{{ toYaml .values }}",
+			expectedVal: "a: b",
+			params: map[string]interface{}{
+				"values": map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+		{
+			name:         "missing parameter is an error",
+			fieldVal:     "{{ .doesnotexist }}",
+			params:       map[string]interface{}{"one": "two"},
+			errorMessage: `failed to execute go template {{ .doesnotexist }}: template: :1:3: executing "" at <.doesnotexist>: map has no entry for key "doesnotexist"`,
+		},
+		{
+			name:         "env is not available",
+			fieldVal:     "{{ env \"HOME\" }}",
+			params:       map[string]interface{}{"one": "two"},
+			errorMessage: `failed to parse template {{ env "HOME" }}: template: :1: function "env" not defined`,
+		},
+	}
+
+	for _, test := range tests {
+
+		t.Run(test.name, func(t *testing.T) {
+
+			for fieldName, getPtrFunc := range fieldMap {
+
+				application := &argoappsv1.Application{}
+				*getPtrFunc(application) = test.fieldVal
+
+				render := Render{}
+				newApplication, err := render.RenderTemplateParams(application, nil, test.params, true)
+
+				if test.errorMessage != "" {
+					assert.EqualError(t, err, test.errorMessage)
+					continue
+				}
+				assert.NoError(t, err)
+				actualValue := *getPtrFunc(newApplication)
+				assert.Equal(t, test.expectedVal, actualValue, "Field '%s' had an unexpected value. expected: '%s' value: '%s'", fieldName, test.expectedVal, actualValue)
+			}
+		})
+	}
+}
+
 func TestCheckInvalidGenerators(t *testing.T) {
 
 	scheme := runtime.NewScheme()
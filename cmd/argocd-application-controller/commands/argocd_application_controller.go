@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
@@ -23,6 +24,7 @@ import (
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
@@ -60,6 +62,10 @@ func NewCommand() *cobra.Command {
 		repoServerPlaintext      bool
 		repoServerStrictTLS      bool
 		otlpAddress              string
+		applicationNamespaces    []string
+		shardingAlgorithm        string
+		dynamicDistribution      bool
+		heartbeatTime            time.Duration
 	)
 	var command = cobra.Command{
 		Use:               cliName,
@@ -132,7 +138,12 @@ func NewCommand() *cobra.Command {
 				appController.InvalidateProjectsCache()
 			}))
 			kubectl := kubeutil.NewKubectl()
-			clusterFilter := getClusterFilter()
+			clusterSharding, err := getClusterSharding(ctx, kubeClient, settingsMgr, cache, namespace, shardingAlgorithm, dynamicDistribution, heartbeatTime)
+			errors.CheckError(err)
+			var clusterFilter func(cluster *v1alpha1.Cluster) bool
+			if clusterSharding != nil {
+				clusterFilter = clusterSharding.IsManagedCluster
+			}
 			appController, err = controller.NewApplicationController(
 				namespace,
 				settingsMgr,
@@ -148,7 +159,8 @@ func NewCommand() *cobra.Command {
 				metricsCacheExpiration,
 				metricsAplicationLabels,
 				kubectlParallelismLimit,
-				clusterFilter)
+				clusterFilter,
+				applicationNamespaces)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer())
 
@@ -164,6 +176,9 @@ func NewCommand() *cobra.Command {
 				defer closeTracer()
 			}
 
+			if clusterSharding != nil {
+				go clusterSharding.Run(ctx, appController.HandleClusterShardingChange)
+			}
 			go appController.Run(ctx, statusProcessors, operationProcessors)
 
 			// Wait forever
@@ -189,26 +204,35 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the repo server")
 	command.Flags().StringSliceVar(&metricsAplicationLabels, "metrics-application-labels", []string{}, "List of Application labels that will be added to the argocd_application_labels metric")
 	command.Flags().StringVar(&otlpAddress, "otlp-address", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_OTLP_ADDRESS", ""), "OpenTelemetry collector address to send traces to")
+	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that applications are allowed to be reconciled from")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, sharding.DefaultShardingAlgorithm), fmt.Sprintf("Algorithm used to distribute clusters across controller replicas. One of: %s", strings.Join(sharding.ShardingAlgorithms, "|")))
+	command.Flags().BoolVar(&dynamicDistribution, "dynamic-cluster-distribution-enabled", env.ParseBoolFromEnv(common.EnvEnableDynamicClusterDistribution, false), "Discover the number of controller replicas at runtime, and redistribute clusters when replicas are added or removed")
+	command.Flags().DurationVar(&heartbeatTime, "sharding-heartbeat-time", env.ParseDurationFromEnv(common.EnvControllerHeartbeatTime, sharding.DefaultHeartbeatInterval, 0, math.MaxInt64), "Interval at which controller replicas record their heartbeat and rebalance clusters")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
 		redisClient = client
 	})
 	return &command
 }
 
-func getClusterFilter() func(cluster *v1alpha1.Cluster) bool {
+// getClusterSharding returns the sharding of the clusters handled by this controller replica, or nil if the replica
+// handles all clusters
+func getClusterSharding(ctx context.Context, kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, clusterInfo sharding.ClusterInfoGetter, namespace string, algorithm string, dynamic bool, heartbeatTime time.Duration) (*sharding.ClusterSharding, error) {
 	replicas := env.ParseNumFromEnv(common.EnvControllerReplicas, 0, 0, math.MaxInt32)
 	shard := env.ParseNumFromEnv(common.EnvControllerShard, -1, -math.MaxInt32, math.MaxInt32)
-	var clusterFilter func(cluster *v1alpha1.Cluster) bool
-	if replicas > 1 {
-		if shard < 0 {
-			var err error
-			shard, err = sharding.InferShard()
-			errors.CheckError(err)
-		}
-		log.Infof("Processing clusters from shard %d", shard)
-		clusterFilter = sharding.GetClusterFilter(replicas, shard)
-	} else {
+	if replicas <= 1 && !dynamic {
 		log.Info("Processing all cluster shards")
+		return nil, nil
+	}
+	if shard < 0 && !dynamic {
+		var err error
+		shard, err = sharding.InferShard()
+		if err != nil {
+			return nil, err
+		}
+	}
+	clusterSharding, err := sharding.NewClusterSharding(db.NewDB(namespace, settingsMgr, kubeClient), clusterInfo, kubeClient, namespace, algorithm, replicas, shard, dynamic, heartbeatTime)
+	if err != nil {
+		return nil, err
 	}
-	return clusterFilter
+	return clusterSharding, clusterSharding.Init(ctx)
 }
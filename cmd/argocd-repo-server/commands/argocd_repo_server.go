@@ -30,6 +30,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/healthz"
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v2/util/trace"
 )
@@ -81,6 +82,9 @@ func NewCommand() *cobra.Command {
 		disableTLS                        bool
 		maxCombinedDirectoryManifestsSize string
 		cmpTarExcludedGlobs               []string
+		ociLayerMediaTypes                []string
+		ociMaxExtractedSize               string
+		ociBlobCacheSize                  string
 	)
 	var command = cobra.Command{
 		Use:               cliName,
@@ -112,6 +116,10 @@ func NewCommand() *cobra.Command {
 
 			maxCombinedDirectoryManifestsQuantity, err := resource.ParseQuantity(maxCombinedDirectoryManifestsSize)
 			errors.CheckError(err)
+			ociMaxExtractedQuantity, err := resource.ParseQuantity(ociMaxExtractedSize)
+			errors.CheckError(err)
+			ociBlobCacheQuantity, err := resource.ParseQuantity(ociBlobCacheSize)
+			errors.CheckError(err)
 
 			askPassServer := askpass.NewServer()
 			metricsServer := metrics.NewMetricsServer()
@@ -124,6 +132,9 @@ func NewCommand() *cobra.Command {
 				SubmoduleEnabled:                             getSubmoduleEnabled(),
 				MaxCombinedDirectoryManifestsSize:            maxCombinedDirectoryManifestsQuantity,
 				CMPTarExcludedGlobs:                          cmpTarExcludedGlobs,
+				OCILayerMediaTypes:                           ociLayerMediaTypes,
+				OCIMaxExtractedSize:                          ociMaxExtractedQuantity,
+				OCIBlobCacheSize:                             ociBlobCacheQuantity,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -201,6 +212,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&disableTLS, "disable-tls", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_TLS", false), "Disable TLS on the gRPC endpoint")
 	command.Flags().StringVar(&maxCombinedDirectoryManifestsSize, "max-combined-directory-manifests-size", env.StringFromEnv("ARGOCD_REPO_SERVER_MAX_COMBINED_DIRECTORY_MANIFESTS_SIZE", "10M"), "Max combined size of manifest files in a directory-type Application")
 	command.Flags().StringArrayVar(&cmpTarExcludedGlobs, "plugin-tar-exclude", env.StringsFromEnv("ARGOCD_REPO_SERVER_PLUGIN_TAR_EXCLUSIONS", []string{}, ";"), "Globs to filter when sending tarballs to plugins.")
+	command.Flags().StringSliceVar(&ociLayerMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", oci.DefaultLayerMediaTypes, ","), "Media types of the OCI artifact layers which are extracted for manifest generation")
+	command.Flags().StringVar(&ociMaxExtractedSize, "oci-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_OCI_MAX_EXTRACTED_SIZE", "1G"), "Max size of the layers of an OCI artifact and of the files extracted from them")
+	command.Flags().StringVar(&ociBlobCacheSize, "oci-blob-cache-size", env.StringFromEnv("ARGOCD_REPO_SERVER_OCI_BLOB_CACHE_SIZE", "2G"), "Max combined size of the cached OCI artifact layers")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
@@ -31,6 +31,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/clusterauth"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
@@ -67,7 +68,7 @@ type ClusterWithInfo struct {
 	Namespaces []string
 }
 
-func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClient *versioned.Clientset, replicas int, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int) ([]ClusterWithInfo, error) {
+func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClient *versioned.Clientset, replicas int, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, shardingAlgorithm string) ([]ClusterWithInfo, error) {
 	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 
 	argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
@@ -116,14 +117,6 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 		batch := clustersList.Items[batchStart:batchEnd]
 		_ = kube.RunAllAsync(len(batch), func(i int) error {
 			cluster := batch[i]
-			clusterShard := 0
-			if replicas > 0 {
-				clusterShard = sharding.GetShardByID(cluster.ID, replicas)
-			}
-
-			if shard != -1 && clusterShard != shard {
-				return nil
-			}
 			nsSet := map[string]bool{}
 			for _, app := range apps {
 				if app.Spec.Destination.Server == cluster.Server {
@@ -135,11 +128,30 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 				namespaces = append(namespaces, ns)
 			}
 			_ = cache.GetClusterInfo(cluster.Server, &cluster.Info)
-			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces}
+			clusters[batchStart+i] = ClusterWithInfo{cluster, 0, namespaces}
 			return nil
 		})
 	}
-	return clusters, nil
+
+	clustersWithInfo := make([]argoappv1.Cluster, len(clusters))
+	for i := range clusters {
+		clustersWithInfo[i] = clusters[i].Cluster
+	}
+	distributionFunction, err := sharding.GetDistributionFunction(shardingAlgorithm, clustersWithInfo, replicas)
+	if err != nil {
+		return nil, err
+	}
+	var res []ClusterWithInfo
+	for _, cluster := range clusters {
+		if replicas > 0 {
+			cluster.Shard = distributionFunction(&cluster.Cluster)
+		}
+		if shard != -1 && cluster.Shard != shard {
+			continue
+		}
+		res = append(res, cluster)
+	}
+	return res, nil
 }
 
 func getControllerReplicas(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string) (int, error) {
@@ -153,11 +165,12 @@ func getControllerReplicas(ctx context.Context, kubeClient *kubernetes.Clientset
 
 func NewClusterShardsCommand() *cobra.Command {
 	var (
-		shard            int
-		replicas         int
-		clientConfig     clientcmd.ClientConfig
-		cacheSrc         func() (*appstatecache.Cache, error)
-		portForwardRedis bool
+		shard             int
+		replicas          int
+		clientConfig      clientcmd.ClientConfig
+		cacheSrc          func() (*appstatecache.Cache, error)
+		portForwardRedis  bool
+		shardingAlgorithm string
 	)
 	var command = cobra.Command{
 		Use:   "shards",
@@ -182,7 +195,7 @@ func NewClusterShardsCommand() *cobra.Command {
 				return
 			}
 
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, namespace, portForwardRedis, cacheSrc, shard)
+			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, namespace, portForwardRedis, cacheSrc, shard, shardingAlgorithm)
 			errors.CheckError(err)
 			if len(clusters) == 0 {
 				return
@@ -195,6 +208,7 @@ func NewClusterShardsCommand() *cobra.Command {
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, sharding.DefaultShardingAlgorithm), fmt.Sprintf("Algorithm used to distribute clusters across controller replicas. One of: %s", strings.Join(sharding.ShardingAlgorithms, "|")))
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 	return &command
 }
@@ -436,11 +450,12 @@ func NewClusterDisableNamespacedMode() *cobra.Command {
 
 func NewClusterStatsCommand() *cobra.Command {
 	var (
-		shard            int
-		replicas         int
-		clientConfig     clientcmd.ClientConfig
-		cacheSrc         func() (*appstatecache.Cache, error)
-		portForwardRedis bool
+		shard             int
+		replicas          int
+		clientConfig      clientcmd.ClientConfig
+		cacheSrc          func() (*appstatecache.Cache, error)
+		portForwardRedis  bool
+		shardingAlgorithm string
 	)
 	var command = cobra.Command{
 		Use:   "stats",
@@ -461,7 +476,7 @@ func NewClusterStatsCommand() *cobra.Command {
 				replicas, err = getControllerReplicas(ctx, kubeClient, namespace)
 				errors.CheckError(err)
 			}
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, namespace, portForwardRedis, cacheSrc, shard)
+			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, namespace, portForwardRedis, cacheSrc, shard, shardingAlgorithm)
 			errors.CheckError(err)
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -476,6 +491,7 @@ func NewClusterStatsCommand() *cobra.Command {
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, sharding.DefaultShardingAlgorithm), fmt.Sprintf("Algorithm used to distribute clusters across controller replicas. One of: %s", strings.Join(sharding.ShardingAlgorithms, "|")))
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 	return &command
 }
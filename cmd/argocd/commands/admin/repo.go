@@ -16,6 +16,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -65,6 +66,9 @@ func NewGenRepoSpecCommand() *cobra.Command {
 
   # Add a private Helm OCI-based repository named 'stable' via HTTPS
   argocd admin repo generate-spec helm-oci-registry.cn-zhangjiakou.cr.aliyuncs.com --type helm --name stable --enable-oci --username test --password test
+
+  # Add a private OCI repository holding manifest artifacts
+  argocd admin repo generate-spec oci://ghcr.io/example/manifests --type oci --name manifests --username test --password test
 `
 
 	var command = &cobra.Command{
@@ -106,7 +110,7 @@ func NewGenRepoSpecCommand() *cobra.Command {
 
 			// Specifying tls-client-cert-path is only valid for HTTPS repositories
 			if repoOpts.TlsClientCertPath != "" {
-				if git.IsHTTPSURL(repoOpts.Repo.Repo) {
+				if git.IsHTTPSURL(repoOpts.Repo.Repo) || oci.IsOCIRepo(repoOpts.Repo.Repo) {
 					tlsCertData, err := os.ReadFile(repoOpts.TlsClientCertPath)
 					errors.CheckError(err)
 					tlsCertKey, err := os.ReadFile(repoOpts.TlsClientCertKeyPath)
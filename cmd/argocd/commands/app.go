@@ -43,6 +43,7 @@ import (
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	repoapiclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/reposerver/repository"
+	apputil "github.com/argoproj/argo-cd/v2/util/app"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
 	"github.com/argoproj/argo-cd/v2/util/cli"
@@ -116,6 +117,7 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		labels       []string
 		annotations  []string
 		setFinalizer bool
+		appNamespace string
 	)
 	var command = &cobra.Command{
 		Use:   "create APPNAME",
@@ -155,6 +157,10 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				if setFinalizer {
 					app.Finalizers = append(app.Finalizers, "resources-finalizer.argocd.argoproj.io")
 				}
+				if appNamespace != "" {
+					app.Namespace = appNamespace
+				}
+				app.Namespace, app.Name = apputil.ParseAppQualifiedName(app.Name, app.Namespace)
 				conn, appIf := argocdClient.NewApplicationClientOrDie()
 				defer argoio.Close(conn)
 				appCreateRequest := applicationpkg.ApplicationCreateRequest{
@@ -164,7 +170,7 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				}
 				created, err := appIf.Create(ctx, &appCreateRequest)
 				errors.CheckError(err)
-				fmt.Printf("application '%s' created\n", created.ObjectMeta.Name)
+				fmt.Printf("application '%s' created\n", created.QualifiedName())
 			}
 
 		},
@@ -175,6 +181,7 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	command.Flags().StringArrayVarP(&labels, "label", "l", []string{}, "Labels to apply to the app")
 	command.Flags().StringArrayVarP(&annotations, "annotations", "", []string{}, "Set metadata annotations (e.g. example=value)")
 	command.Flags().BoolVar(&setFinalizer, "set-finalizer", false, "Sets deletion finalizer on the application, application resources will be cascaded on deletion")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace where the application will be created in")
 	// Only complete files with appropriate extension.
 	err := command.Flags().SetAnnotation("file", cobra.BashCompFilenameExt, []string{"json", "yaml", "yml"})
 	if err != nil {
@@ -383,10 +390,22 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	fmt.Printf(printOpFmtStr, "Server:", getServer(app))
 	fmt.Printf(printOpFmtStr, "Namespace:", app.Spec.Destination.Namespace)
 	fmt.Printf(printOpFmtStr, "URL:", appURL)
-	fmt.Printf(printOpFmtStr, "Repo:", app.Spec.Source.RepoURL)
-	fmt.Printf(printOpFmtStr, "Target:", app.Spec.Source.TargetRevision)
-	fmt.Printf(printOpFmtStr, "Path:", app.Spec.Source.Path)
-	printAppSourceDetails(&app.Spec.Source)
+	for _, source := range app.Spec.GetSources() {
+		fmt.Printf(printOpFmtStr, "Repo:", source.RepoURL)
+		fmt.Printf(printOpFmtStr, "Target:", source.TargetRevision)
+		fmt.Printf(printOpFmtStr, "Path:", source.Path)
+		if source.Ref != "" {
+			fmt.Printf(printOpFmtStr, "Ref:", source.Ref)
+		}
+		printAppSourceDetails(&source)
+	}
+	if app.Spec.Hydration != nil {
+		hydration := app.Spec.Hydration.TargetBranch
+		if status := app.Status.Hydration; status != nil && len(status.HydratedSHA) > 7 {
+			hydration += fmt.Sprintf(" (%s)", status.HydratedSHA[0:7])
+		}
+		fmt.Printf(printOpFmtStr, "Hydrated To:", hydration)
+	}
 	var wds []string
 	var status string
 	var allow, deny, inactiveAllows bool
@@ -523,9 +542,34 @@ func printParams(app *argoappv1.Application) {
 			_, _ = fmt.Fprintf(w, "%s\t%s\n", p.Name, truncateString(p.Value, paramLenLimit))
 		}
 	}
+	if app.Spec.Source.Plugin != nil && len(app.Spec.Source.Plugin.Parameters) > 0 {
+		fmt.Println()
+		_, _ = fmt.Fprintf(w, "NAME\tVALUE\n")
+		for _, p := range app.Spec.Source.Plugin.Parameters {
+			_, _ = fmt.Fprintf(w, "%s\t%s\n", p.Name, truncateString(formatPluginParameterValue(p), paramLenLimit))
+		}
+	}
 	_ = w.Flush()
 }
 
+// formatPluginParameterValue formats the value of a plugin parameter the way it is set with --plugin-param
+func formatPluginParameterValue(p argoappv1.ApplicationSourcePluginParameter) string {
+	switch {
+	case p.String_ != nil:
+		return *p.String_
+	case p.Array != nil:
+		return "[" + strings.Join(p.Array, ",") + "]"
+	case p.Map != nil:
+		var entries []string
+		for k, v := range p.Map {
+			entries = append(entries, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(entries)
+		return "{" + strings.Join(entries, ",") + "}"
+	}
+	return ""
+}
+
 func getServer(app *argoappv1.Application) string {
 	if app.Spec.Destination.Server == "" {
 		return app.Spec.Destination.Name
@@ -585,6 +629,7 @@ type unsetOpts struct {
 	valuesLiteral           bool
 	ignoreMissingValueFiles bool
 	pluginEnvs              []string
+	pluginParams            []string
 	passCredentials         bool
 }
 
@@ -644,6 +689,7 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	command.Flags().BoolVar(&opts.kustomizeVersion, "kustomize-version", false, "Kustomize version")
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images name (e.g. --kustomize-image node --kustomize-image mysql)")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Unset plugin env variables (e.g --plugin-env name)")
+	command.Flags().StringArrayVar(&opts.pluginParams, "plugin-param", []string{}, "Unset plugin parameters (e.g --plugin-param name)")
 	command.Flags().BoolVar(&opts.passCredentials, "pass-credentials", false, "Unset passCredentials")
 	return command
 }
@@ -721,7 +767,7 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 		}
 	}
 	if source.Plugin != nil {
-		if len(opts.pluginEnvs) == 0 {
+		if len(opts.pluginEnvs) == 0 && len(opts.pluginParams) == 0 {
 			return false, true
 		}
 		for _, env := range opts.pluginEnvs {
@@ -730,6 +776,12 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 				updated = true
 			}
 		}
+		for _, param := range opts.pluginParams {
+			err := source.Plugin.RemoveParameter(param)
+			if err == nil {
+				updated = true
+			}
+		}
 	}
 	return updated, false
 }
@@ -1084,7 +1136,7 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 // Print simple list of application names
 func printApplicationNames(apps []argoappv1.Application) {
 	for _, app := range apps {
-		fmt.Println(app.Name)
+		fmt.Println(app.QualifiedName())
 	}
 }
 
@@ -1102,7 +1154,7 @@ func printApplicationTable(apps []argoappv1.Application, output *string) {
 	_, _ = fmt.Fprintf(w, fmtStr, headers...)
 	for _, app := range apps {
 		vals := []interface{}{
-			app.Name,
+			app.QualifiedName(),
 			getServer(&app),
 			app.Spec.Destination.Namespace,
 			app.Spec.GetProject(),
@@ -1122,10 +1174,11 @@ func printApplicationTable(apps []argoappv1.Application, output *string) {
 // NewApplicationListCommand returns a new instance of an `argocd app list` command
 func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output   string
-		selector string
-		projects []string
-		repo     string
+		output       string
+		selector     string
+		projects     []string
+		repo         string
+		appNamespace string
 	)
 	var command = &cobra.Command{
 		Use:   "list",
@@ -1134,13 +1187,16 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app list
 
   # List apps by label, in this example we listing apps that are children of another app (aka app-of-apps)
-  argocd app list -l app.kubernetes.io/instance=my-app`,
+  argocd app list -l app.kubernetes.io/instance=my-app
+
+  # List apps in a namespace other than the one of the Argo CD control plane
+  argocd app list -N my-namespace`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer argoio.Close(conn)
-			apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{Selector: pointer.String(selector)})
+			apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{Selector: pointer.String(selector), AppNamespace: pointer.String(appNamespace)})
 			errors.CheckError(err)
 			appList := apps.Items
 			if len(projects) != 0 {
@@ -1166,6 +1222,7 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVarP(&selector, "selector", "l", "", "List apps by label")
 	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Filter by project name")
 	command.Flags().StringVarP(&repo, "repo", "r", "", "List apps by source repo URL")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only list applications in namespace")
 	return command
 }
 
@@ -1341,6 +1398,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		strategy                string
 		force                   bool
 		replace                 bool
+		serverSideApply         bool
 		async                   bool
 		retryLimit              int64
 		retryBackoffDuration    time.Duration
@@ -1465,6 +1523,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					if replace {
 						items = append(items, common.SyncOptionReplace)
 					}
+					if serverSideApply {
+						items = append(items, common.SyncOptionServerSideApply)
+					}
 
 					if len(items) == 0 {
 						// for prevent send even empty array if not need
@@ -1564,6 +1625,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
+	command.Flags().BoolVar(&serverSideApply, "server-side", false, "Use server-side apply while syncing the application")
 	command.Flags().BoolVar(&async, "async", false, "Do not wait for application to sync before continuing")
 	command.Flags().StringVar(&local, "local", "", "Path to a local directory. When this flag is present no git queries will be made")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
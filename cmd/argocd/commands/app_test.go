@@ -791,6 +791,12 @@ func Test_unset(t *testing.T) {
 					Value: "env-value-2",
 				},
 			},
+			Parameters: v1alpha1.ApplicationSourcePluginParameters{
+				{
+					Name:  "images",
+					Array: []string{"nginx"},
+				},
+			},
 		},
 	}
 
@@ -883,6 +889,15 @@ func Test_unset(t *testing.T) {
 	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
 	assert.False(t, updated)
 	assert.False(t, nothingToUnset)
+
+	assert.Equal(t, 1, len(pluginSource.Plugin.Parameters))
+	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginParams: []string{"images"}})
+	assert.Equal(t, 0, len(pluginSource.Plugin.Parameters))
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginParams: []string{"images"}})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
 }
 
 func Test_unset_nothingToUnset(t *testing.T) {
@@ -0,0 +1,476 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mattn/go-isatty"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	applicationsetpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appsetv1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// NewAppSetCommand returns a new instance of an `argocd appset` command
+func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "appset",
+		Short: "Manage ApplicationSets",
+		Example: `
+	# Get an ApplicationSet and the Applications it generated
+	argocd appset get APPSETNAME
+
+	# List all the ApplicationSets
+	argocd appset list
+
+	# Create an ApplicationSet from a YAML stored in a file or at given URL
+	argocd appset create <filename or URL> (<filename or URL>...)
+
+	# Delete an ApplicationSet
+	argocd appset delete APPSETNAME (APPSETNAME...)
+
+	# Preview the Applications an ApplicationSet would generate without changing anything
+	argocd appset generate <filename or URL> (<filename or URL>...)
+	`,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewAppSetGetCommand(clientOpts))
+	command.AddCommand(NewAppSetCreateCommand(clientOpts))
+	command.AddCommand(NewAppSetListCommand(clientOpts))
+	command.AddCommand(NewAppSetDeleteCommand(clientOpts))
+	command.AddCommand(NewAppSetGenerateCommand(clientOpts))
+	return command
+}
+
+// NewAppSetGetCommand returns a new instance of an `argocd appset get` command
+func NewAppSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "get APPSETNAME",
+		Short: "Get ApplicationSet details",
+		Example: `
+	# Get ApplicationSets
+	argocd appset get APPSETNAME
+	`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			appSet, err := appIf.Get(ctx, &applicationsetpkg.ApplicationSetGetQuery{Name: args[0]})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(appSet, output)
+				errors.CheckError(err)
+			case "wide", "":
+				appConn, appClient := acdClient.NewApplicationClientOrDie()
+				defer argoio.Close(appConn)
+				project := appSet.Spec.Template.Spec.GetProject()
+				apps, err := appClient.List(ctx, &applicationpkg.ApplicationQuery{Projects: []string{project}})
+				errors.CheckError(err)
+
+				printAppSetSummaryTable(appSet)
+				if len(appSet.Status.Conditions) > 0 {
+					fmt.Println()
+					printAppSetConditions(appSet)
+				}
+				fmt.Println()
+				printApplicationTable(getOwnedApplications(appSet, apps.Items), &output)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewAppSetCreateCommand returns a new instance of an `argocd appset create` command
+func NewAppSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var upsert bool
+	var command = &cobra.Command{
+		Use:   "create",
+		Short: "Create one or more ApplicationSets",
+		Example: `
+	# Create ApplicationSets
+	argocd appset create <filename or URL> (<filename or URL>...)
+	`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var appsets []*appsetv1.ApplicationSet
+			for _, fileURL := range args {
+				fileAppSets, err := cmdutil.ConstructApplicationSet(fileURL)
+				errors.CheckError(err)
+				appsets = append(appsets, fileAppSets...)
+			}
+			if len(appsets) == 0 {
+				log.Fatal("No ApplicationSets found while parsing the input file(s)")
+			}
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			for _, appset := range appsets {
+				if appset.Name == "" {
+					errors.CheckError(fmt.Errorf("ApplicationSet does not have Name field set"))
+				}
+
+				// Get app before creating to see if it is being updated or no change
+				existing, err := appIf.Get(ctx, &applicationsetpkg.ApplicationSetGetQuery{Name: appset.Name})
+				if grpcStatus, ok := status.FromError(err); err != nil && (!ok || grpcStatus.Code() != codes.NotFound) {
+					errors.CheckError(err)
+				}
+
+				created, err := appIf.Create(ctx, &applicationsetpkg.ApplicationSetCreateRequest{
+					Applicationset: appset,
+					Upsert:         upsert,
+				})
+				errors.CheckError(err)
+
+				var action string
+				if existing == nil {
+					action = "created"
+				} else if !hasAppSetChanged(existing, created, upsert) {
+					action = "unchanged"
+				} else {
+					action = "updated"
+				}
+				fmt.Printf("ApplicationSet '%s' %s\n", created.Name, action)
+			}
+		},
+	}
+	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override ApplicationSet with the same name even if supplied ApplicationSet spec is different from existing spec")
+	return command
+}
+
+// NewAppSetListCommand returns a new instance of an `argocd appset list` command
+func NewAppSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output   string
+		selector string
+		projects []string
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List ApplicationSets",
+		Example: `
+	# List all ApplicationSets
+	argocd appset list
+	`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+			appsets, err := appIf.List(ctx, &applicationsetpkg.ApplicationSetListQuery{Selector: selector, Projects: projects})
+			errors.CheckError(err)
+
+			appsetList := appsets.Items
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(appsetList, output, false)
+				errors.CheckError(err)
+			case "name":
+				printAppSetNames(appsetList)
+			case "wide", "":
+				printAppSetTable(appsetList, &output)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|name|json|yaml")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "List ApplicationSets by label")
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Filter by project name")
+	return command
+}
+
+// NewAppSetDeleteCommand returns a new instance of an `argocd appset delete` command
+func NewAppSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var noPrompt bool
+	var command = &cobra.Command{
+		Use:   "delete",
+		Short: "Delete one or more ApplicationSets",
+		Example: `
+	# Delete an ApplicationSet
+	argocd appset delete APPSETNAME (APPSETNAME...)
+	`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+			var isTerminal bool = isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+			var isConfirmAll bool = false
+			var numOfApps = len(args)
+			var promptFlag = c.Flag("yes")
+			if promptFlag.Changed && promptFlag.Value.String() == "true" {
+				noPrompt = true
+			}
+			for _, appSetName := range args {
+				appsetDeleteReq := applicationsetpkg.ApplicationSetDeleteRequest{
+					Name: appSetName,
+				}
+				if isTerminal && !noPrompt {
+					var confirmAnswer string = "n"
+					var lowercaseAnswer string
+					if numOfApps == 1 {
+						fmt.Println("Are you sure you want to delete '" + appSetName + "' and all the Applications it generated? [y/n]")
+						fmt.Scan(&confirmAnswer)
+						lowercaseAnswer = strings.ToLower(confirmAnswer)
+					} else {
+						if !isConfirmAll {
+							fmt.Println("Are you sure you want to delete '" + appSetName + "' and all the Applications it generated? [y/n/A] where 'A' is to delete all specified ApplicationSets and their Applications without prompting")
+							fmt.Scan(&confirmAnswer)
+							lowercaseAnswer = strings.ToLower(confirmAnswer)
+							if lowercaseAnswer == "a" || lowercaseAnswer == "all" {
+								lowercaseAnswer = "y"
+								isConfirmAll = true
+							}
+						} else {
+							lowercaseAnswer = "y"
+						}
+					}
+					if lowercaseAnswer == "y" || lowercaseAnswer == "yes" {
+						_, err := appIf.Delete(ctx, &appsetDeleteReq)
+						errors.CheckError(err)
+						fmt.Printf("applicationset '%s' deleted\n", appSetName)
+					} else {
+						fmt.Println("The command to delete '" + appSetName + "' was cancelled.")
+					}
+				} else {
+					_, err := appIf.Delete(ctx, &appsetDeleteReq)
+					errors.CheckError(err)
+				}
+			}
+		},
+	}
+	command.Flags().BoolVarP(&noPrompt, "yes", "y", false, "Turn off prompting to confirm cascaded deletion of Application resources")
+	return command
+}
+
+// NewAppSetGenerateCommand returns a new instance of an `argocd appset generate` command
+func NewAppSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:   "generate",
+		Short: "Preview the Applications generated by one or more ApplicationSets without creating, updating or deleting them",
+		Example: `
+	# Preview the Applications generated by an ApplicationSet and the changes which would be made to them
+	argocd appset generate <filename or URL> (<filename or URL>...)
+
+	# Print the generated Applications
+	argocd appset generate <filename or URL> -o yaml
+	`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var appsets []*appsetv1.ApplicationSet
+			for _, fileURL := range args {
+				fileAppSets, err := cmdutil.ConstructApplicationSet(fileURL)
+				errors.CheckError(err)
+				appsets = append(appsets, fileAppSets...)
+			}
+			if len(appsets) == 0 {
+				log.Fatal("No ApplicationSets found while parsing the input file(s)")
+			}
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer argoio.Close(conn)
+
+			var apps []argoappv1.Application
+			var plan []*applicationsetpkg.ApplicationSetPlanItem
+			for _, appset := range appsets {
+				if appset.Name == "" {
+					errors.CheckError(fmt.Errorf("ApplicationSet does not have Name field set"))
+				}
+				res, err := appIf.Generate(ctx, &applicationsetpkg.ApplicationSetGenerateRequest{ApplicationSet: appset})
+				errors.CheckError(err)
+				for _, app := range res.Applications {
+					apps = append(apps, *app)
+				}
+				plan = append(plan, res.Plan...)
+			}
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(apps, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printAppSetPlanTable(plan)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// hasAppSetChanged returns whether the spec, labels or annotations of the ApplicationSet differ after a create call
+func hasAppSetChanged(appReq, appRes *appsetv1.ApplicationSet, upsert bool) bool {
+	// upsert==false, no change occurred from create command
+	if !upsert {
+		return false
+	}
+
+	return !reflect.DeepEqual(appRes.Spec, appReq.Spec) ||
+		!reflect.DeepEqual(appRes.ObjectMeta.GetAnnotations(), appReq.GetAnnotations()) ||
+		!reflect.DeepEqual(appRes.Labels, appReq.Labels)
+}
+
+// getOwnedApplications returns the applications which are owned by the given application set
+func getOwnedApplications(appSet *appsetv1.ApplicationSet, apps []argoappv1.Application) []argoappv1.Application {
+	owned := make([]argoappv1.Application, 0)
+	for _, app := range apps {
+		for _, ref := range app.OwnerReferences {
+			if ref.Kind == "ApplicationSet" && ref.Name == appSet.Name && (appSet.UID == "" || ref.UID == appSet.UID) {
+				owned = append(owned, app)
+				break
+			}
+		}
+	}
+	return owned
+}
+
+// Print simple list of ApplicationSet names
+func printAppSetNames(apps []appsetv1.ApplicationSet) {
+	for _, app := range apps {
+		fmt.Println(app.Name)
+	}
+}
+
+// Print table of ApplicationSet info
+func printAppSetTable(apps []appsetv1.ApplicationSet, output *string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	var fmtStr string
+	headers := []interface{}{"NAME", "PROJECT", "SYNCPOLICY", "CONDITIONS"}
+	if *output == "wide" {
+		fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+		headers = append(headers, "REPO", "PATH", "TARGET")
+	} else {
+		fmtStr = "%s\t%s\t%s\t%s\n"
+	}
+	_, _ = fmt.Fprintf(w, fmtStr, headers...)
+	for _, app := range apps {
+		vals := []interface{}{
+			app.Name,
+			app.Spec.Template.Spec.GetProject(),
+			formatAppSetSyncPolicy(app.Spec.SyncPolicy),
+			formatAppSetConditionsSummary(app),
+		}
+		if *output == "wide" {
+			vals = append(vals, app.Spec.Template.Spec.Source.RepoURL, app.Spec.Template.Spec.Source.Path, app.Spec.Template.Spec.Source.TargetRevision)
+		}
+		_, _ = fmt.Fprintf(w, fmtStr, vals...)
+	}
+	_ = w.Flush()
+}
+
+func formatAppSetSyncPolicy(syncPolicy *appsetv1.ApplicationSetSyncPolicy) string {
+	if syncPolicy == nil {
+		return "<none>"
+	}
+	var items []string
+	if syncPolicy.ApplicationsSync != nil {
+		items = append(items, string(*syncPolicy.ApplicationsSync))
+	}
+	if syncPolicy.PreserveResourcesOnDeletion {
+		items = append(items, "PreserveResourcesOnDeletion")
+	}
+	if len(items) == 0 {
+		return "<none>"
+	}
+	return strings.Join(items, ",")
+}
+
+// formatAppSetConditionsSummary returns the types of the conditions which are currently true
+func formatAppSetConditionsSummary(app appsetv1.ApplicationSet) string {
+	items := make([]string, 0)
+	for _, condition := range app.Status.Conditions {
+		if condition.Status == appsetv1.ApplicationSetConditionStatusTrue {
+			items = append(items, string(condition.Type))
+		}
+	}
+	summary := "<none>"
+	if len(items) > 0 {
+		summary = strings.Join(items, ",")
+	}
+	return summary
+}
+
+func printAppSetSummaryTable(appSet *appsetv1.ApplicationSet) {
+	source := appSet.Spec.Template.Spec.Source
+	fmt.Printf(printOpFmtStr, "Name:", appSet.Name)
+	fmt.Printf(printOpFmtStr, "Project:", appSet.Spec.Template.Spec.GetProject())
+	fmt.Printf(printOpFmtStr, "Server:", appSet.Spec.Template.Spec.Destination.Server)
+	fmt.Printf(printOpFmtStr, "Namespace:", appSet.Spec.Template.Spec.Destination.Namespace)
+	fmt.Printf(printOpFmtStr, "Repo:", source.RepoURL)
+	fmt.Printf(printOpFmtStr, "Target:", source.TargetRevision)
+	fmt.Printf(printOpFmtStr, "Path:", source.Path)
+	printAppSourceDetails(&source)
+	fmt.Printf(printOpFmtStr, "SyncPolicy:", formatAppSetSyncPolicy(appSet.Spec.SyncPolicy))
+}
+
+// printAppSetPlanTable prints the changes which would be made to the generated applications
+func printAppSetPlanTable(plan []*applicationsetpkg.ApplicationSetPlanItem) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tACTION\n")
+	for _, item := range plan {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", item.Name, item.Action)
+	}
+	_ = w.Flush()
+}
+
+func printAppSetConditions(appSet *appsetv1.ApplicationSet) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "CONDITION\tSTATUS\tMESSAGE\tLAST TRANSITION\n")
+	for _, item := range appSet.Status.Conditions {
+		lastTransition := ""
+		if item.LastTransitionTime != nil {
+			lastTransition = item.LastTransitionTime.String()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Type, item.Status, item.Message, lastTransition)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	applicationsetpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appsetv1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
+func TestGetOwnedApplications(t *testing.T) {
+	appSet := &appsetv1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", UID: "appset-uid"},
+	}
+	newApp := func(name string, refs ...metav1.OwnerReference) argoappv1.Application {
+		return argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: refs}}
+	}
+	apps := []argoappv1.Application{
+		newApp("owned", metav1.OwnerReference{Kind: "ApplicationSet", Name: "guestbook", UID: "appset-uid"}),
+		newApp("other-appset", metav1.OwnerReference{Kind: "ApplicationSet", Name: "other", UID: "other-uid"}),
+		newApp("recreated-appset", metav1.OwnerReference{Kind: "ApplicationSet", Name: "guestbook", UID: "old-uid"}),
+		newApp("unowned"),
+	}
+
+	owned := getOwnedApplications(appSet, apps)
+	if assert.Len(t, owned, 1) {
+		assert.Equal(t, "owned", owned[0].Name)
+	}
+}
+
+func TestFormatAppSetConditionsSummary(t *testing.T) {
+	appSet := appsetv1.ApplicationSet{}
+	assert.Equal(t, "<none>", formatAppSetConditionsSummary(appSet))
+
+	appSet.Status.Conditions = []appsetv1.ApplicationSetCondition{
+		{Type: appsetv1.ApplicationSetConditionErrorOccurred, Status: appsetv1.ApplicationSetConditionStatusTrue},
+		{Type: appsetv1.ApplicationSetConditionResourcesUpToDate, Status: appsetv1.ApplicationSetConditionStatusFalse},
+	}
+	assert.Equal(t, "ErrorOccurred", formatAppSetConditionsSummary(appSet))
+}
+
+func TestFormatAppSetSyncPolicy(t *testing.T) {
+	assert.Equal(t, "<none>", formatAppSetSyncPolicy(nil))
+	assert.Equal(t, "<none>", formatAppSetSyncPolicy(&appsetv1.ApplicationSetSyncPolicy{}))
+	assert.Equal(t, "PreserveResourcesOnDeletion", formatAppSetSyncPolicy(&appsetv1.ApplicationSetSyncPolicy{PreserveResourcesOnDeletion: true}))
+	createOnly := appsetv1.ApplicationsSyncPolicyCreateOnly
+	assert.Equal(t, "create-only,PreserveResourcesOnDeletion", formatAppSetSyncPolicy(&appsetv1.ApplicationSetSyncPolicy{ApplicationsSync: &createOnly, PreserveResourcesOnDeletion: true}))
+}
+
+func TestPrintAppSetPlanTable(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printAppSetPlanTable([]*applicationsetpkg.ApplicationSetPlanItem{
+			{Name: "in-cluster-guestbook", Action: "create"},
+			{Name: "staging-guestbook", Action: "delete"},
+		})
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "NAME                  ACTION\nin-cluster-guestbook  create\nstaging-guestbook     delete\n", output)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 )
 
 // NewRepoCommand returns a new instance of an `argocd repo` command
@@ -65,6 +66,9 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
   # Add a private Helm OCI-based repository named 'stable' via HTTPS
   argocd repo add helm-oci-registry.cn-zhangjiakou.cr.aliyuncs.com --type helm --name stable --enable-oci --username test --password test
 
+  # Add a private OCI repository holding manifest artifacts
+  argocd repo add oci://ghcr.io/example/manifests --type oci --name manifests --username test --password test
+
   # Add a private Git repository on GitHub.com via GitHub App
   argocd repo add https://git.example.com/repos/repo --github-app-id 1 --github-app-installation-id 2 --github-app-private-key-path test.private-key.pem
 
@@ -110,7 +114,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
 			// Specifying tls-client-cert-path is only valid for HTTPS repositories
 			if repoOpts.TlsClientCertPath != "" {
-				if git.IsHTTPSURL(repoOpts.Repo.Repo) {
+				if git.IsHTTPSURL(repoOpts.Repo.Repo) || oci.IsOCIRepo(repoOpts.Repo.Repo) {
 					tlsCertData, err := os.ReadFile(repoOpts.TlsClientCertPath)
 					errors.CheckError(err)
 					tlsCertKey, err := os.ReadFile(repoOpts.TlsClientCertKeyPath)
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -53,6 +54,10 @@ type AppOptions struct {
 	autoPrune                       bool
 	selfHeal                        bool
 	allowEmpty                      bool
+	maxPrunedResources              int64
+	maxChangedResourcesPercent      int64
+	autoRollback                    bool
+	autoRollbackDegradedTimeout     string
 	namePrefix                      string
 	nameSuffix                      string
 	directoryRecurse                bool
@@ -69,6 +74,7 @@ type AppOptions struct {
 	kustomizeForceCommonLabels      bool
 	kustomizeForceCommonAnnotations bool
 	pluginEnvs                      []string
+	pluginParams                    []string
 	Validate                        bool
 	directoryExclude                string
 	directoryInclude                string
@@ -76,6 +82,9 @@ type AppOptions struct {
 	retryBackoffDuration            time.Duration
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
+	healthVerificationDuration      time.Duration
+	hydrationTargetBranch           string
+	hydrationPath                   string
 }
 
 func AddAppFlags(command *cobra.Command, opts *AppOptions) {
@@ -105,6 +114,10 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
 	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
 	command.Flags().BoolVar(&opts.allowEmpty, "allow-empty", false, "Set allow zero live resources when sync is automated")
+	command.Flags().Int64Var(&opts.maxPrunedResources, "max-pruned-resources", 0, "Set the maximum number of resources an automated sync may prune (0 for no limit)")
+	command.Flags().Int64Var(&opts.maxChangedResourcesPercent, "max-changed-resources-percent", 0, "Set the maximum percentage of resources an automated sync may change (0 for no limit)")
+	command.Flags().BoolVar(&opts.autoRollback, "auto-rollback", false, "Set automatic rollback to the last healthy revision when an automated sync fails")
+	command.Flags().StringVar(&opts.autoRollbackDegradedTimeout, "auto-rollback-degraded-timeout", "", "Set how long the application may remain Degraded after an automated sync before it is rolled back (e.g. 10m)")
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
 	command.Flags().StringVar(&opts.nameSuffix, "namesuffix", "", "Kustomize namesuffix")
 	command.Flags().StringVar(&opts.kustomizeVersion, "kustomize-version", "", "Kustomize version")
@@ -117,6 +130,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.jsonnetLibs, "jsonnet-libs", []string{}, "Additional jsonnet libs (prefixed by repoRoot)")
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images (e.g. --kustomize-image node:8.15.0 --kustomize-image mysql=mariadb,alpine@sha256:24a0c4b4a4c0eb97a1aabb8e29f18e917d05abfe1b7a7c07857230879ce7d3d)")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Additional plugin envs")
+	command.Flags().StringArrayVar(&opts.pluginParams, "plugin-param", []string{}, "Set a plugin parameter (e.g. --plugin-param name=value), add an item to an array parameter (e.g. --plugin-param 'images[]=nginx') or an entry to a map parameter (e.g. --plugin-param 'labels[team]=a'). Arrays and maps replace the existing value of the parameter")
 	command.Flags().BoolVar(&opts.Validate, "validate", true, "Validation of repo and cluster")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonLabels, "kustomize-common-label", []string{}, "Set common labels in Kustomize")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonAnnotations, "kustomize-common-annotation", []string{}, "Set common labels in Kustomize")
@@ -128,6 +142,9 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffDuration, "sync-retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Sync retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
+	command.Flags().DurationVar(&opts.healthVerificationDuration, "health-verification-duration", 0, "Verify the application health for the given duration after a sync and fail the sync if the application turns Degraded (e.g. 2m). Set to 0 to disable")
+	command.Flags().StringVar(&opts.hydrationTargetBranch, "hydration-target-branch", "", "Commit the rendered manifests to the given branch and sync the application from it. Set to empty to disable")
+	command.Flags().StringVar(&opts.hydrationPath, "hydration-path", "", "Directory in the hydration target branch to commit the rendered manifests to. Defaults to the path of the application source")
 }
 
 func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, appOpts *AppOptions) int {
@@ -239,6 +256,8 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			setJsonnetOptLibs(&spec.Source, appOpts.jsonnetLibs)
 		case "plugin-env":
 			setPluginOptEnvs(&spec.Source, appOpts.pluginEnvs)
+		case "plugin-param":
+			setPluginOptParameters(&spec.Source, appOpts.pluginParams)
 		case "sync-policy":
 			switch appOpts.syncPolicy {
 			case "none":
@@ -294,6 +313,36 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			} else {
 				log.Fatalf("Invalid sync-retry-limit [%d]", appOpts.retryLimit)
 			}
+		case "health-verification-duration":
+			if appOpts.healthVerificationDuration > 0 {
+				if spec.SyncPolicy == nil {
+					spec.SyncPolicy = &argoappv1.SyncPolicy{}
+				}
+				spec.SyncPolicy.HealthVerification = &argoappv1.SyncHealthVerification{Duration: appOpts.healthVerificationDuration.String()}
+			} else if appOpts.healthVerificationDuration == 0 {
+				if spec.SyncPolicy != nil {
+					spec.SyncPolicy.HealthVerification = nil
+				}
+				if spec.SyncPolicy.IsZero() {
+					spec.SyncPolicy = nil
+				}
+			} else {
+				log.Fatalf("Invalid health-verification-duration [%v]", appOpts.healthVerificationDuration)
+			}
+		case "hydration-target-branch":
+			if appOpts.hydrationTargetBranch == "" {
+				spec.Hydration = nil
+			} else {
+				if spec.Hydration == nil {
+					spec.Hydration = &argoappv1.ApplicationHydration{}
+				}
+				spec.Hydration.TargetBranch = appOpts.hydrationTargetBranch
+			}
+		case "hydration-path":
+			if spec.Hydration == nil {
+				spec.Hydration = &argoappv1.ApplicationHydration{}
+			}
+			spec.Hydration.Path = appOpts.hydrationPath
 		}
 	})
 	if flags.Changed("auto-prune") {
@@ -314,6 +363,36 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		}
 		spec.SyncPolicy.Automated.AllowEmpty = appOpts.allowEmpty
 	}
+	if flags.Changed("max-pruned-resources") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+			log.Fatal("Cannot set --max-pruned-resources: application not configured with automatic sync")
+		}
+		spec.SyncPolicy.Automated.MaxPrunedResources = appOpts.maxPrunedResources
+	}
+	if flags.Changed("max-changed-resources-percent") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+			log.Fatal("Cannot set --max-changed-resources-percent: application not configured with automatic sync")
+		}
+		spec.SyncPolicy.Automated.MaxChangedResourcesPercent = appOpts.maxChangedResourcesPercent
+	}
+	if flags.Changed("auto-rollback") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+			log.Fatal("Cannot set --auto-rollback: application not configured with automatic sync")
+		}
+		if appOpts.autoRollback {
+			if spec.SyncPolicy.Automated.Rollback == nil {
+				spec.SyncPolicy.Automated.Rollback = &argoappv1.AutomatedRollback{}
+			}
+		} else {
+			spec.SyncPolicy.Automated.Rollback = nil
+		}
+	}
+	if flags.Changed("auto-rollback-degraded-timeout") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil || spec.SyncPolicy.Automated.Rollback == nil {
+			log.Fatal("Cannot set --auto-rollback-degraded-timeout: application not configured with automatic rollback")
+		}
+		spec.SyncPolicy.Automated.Rollback.DegradedTimeout = appOpts.autoRollbackDegradedTimeout
+	}
 
 	return visited
 }
@@ -376,6 +455,56 @@ func setPluginOptEnvs(src *argoappv1.ApplicationSource, envs []string) {
 	}
 }
 
+var pluginParamMapEntryRegex = regexp.MustCompile(`^(.+)\[(.+)\]$`)
+
+// parsePluginParameters parses plugin parameters in the format name=value, name[]=value or name[key]=value into string,
+// array and map parameters respectively
+func parsePluginParameters(params []string) ([]argoappv1.ApplicationSourcePluginParameter, error) {
+	var parsed []argoappv1.ApplicationSourcePluginParameter
+	index := map[string]int{}
+	get := func(name string) *argoappv1.ApplicationSourcePluginParameter {
+		if i, ok := index[name]; ok {
+			return &parsed[i]
+		}
+		index[name] = len(parsed)
+		parsed = append(parsed, argoappv1.ApplicationSourcePluginParameter{Name: name})
+		return &parsed[len(parsed)-1]
+	}
+	for _, text := range params {
+		key, value, ok := strings.Cut(text, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected plugin parameter of the form: name=value, name[]=value or name[key]=value. Received: %s", text)
+		}
+		if name := strings.TrimSuffix(key, "[]"); name != key {
+			param := get(name)
+			param.Array = append(param.Array, value)
+		} else if match := pluginParamMapEntryRegex.FindStringSubmatch(key); match != nil {
+			param := get(match[1])
+			if param.Map == nil {
+				param.Map = map[string]string{}
+			}
+			param.Map[match[2]] = value
+		} else {
+			param := get(key)
+			param.String_ = pointer.String(value)
+		}
+	}
+	return parsed, nil
+}
+
+func setPluginOptParameters(src *argoappv1.ApplicationSource, params []string) {
+	parsed, err := parsePluginParameters(params)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if src.Plugin == nil {
+		src.Plugin = &argoappv1.ApplicationSourcePlugin{}
+	}
+	for _, param := range parsed {
+		src.Plugin.SetParameter(param)
+	}
+}
+
 type helmOpts struct {
 	valueFiles              []string
 	ignoreMissingValueFiles bool
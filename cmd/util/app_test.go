@@ -8,6 +8,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -133,6 +134,30 @@ func Test_setPluginOptEnvs(t *testing.T) {
 	})
 }
 
+func Test_setPluginOptParameters(t *testing.T) {
+	t.Run("PluginParameters", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setPluginOptParameters(&src, []string{"replicas=1", "images[]=nginx", "images[]=redis", "labels[team]=a", "labels[env]=prod"})
+		assert.Equal(t, v1alpha1.ApplicationSourcePluginParameters{
+			{Name: "replicas", String_: pointer.String("1")},
+			{Name: "images", Array: []string{"nginx", "redis"}},
+			{Name: "labels", Map: map[string]string{"team": "a", "env": "prod"}},
+		}, src.Plugin.Parameters)
+		setPluginOptParameters(&src, []string{"replicas=2", "images[]=busybox"})
+		assert.Equal(t, v1alpha1.ApplicationSourcePluginParameters{
+			{Name: "replicas", String_: pointer.String("2")},
+			{Name: "images", Array: []string{"busybox"}},
+			{Name: "labels", Map: map[string]string{"team": "a", "env": "prod"}},
+		}, src.Plugin.Parameters)
+	})
+	t.Run("InvalidParameter", func(t *testing.T) {
+		_, err := parsePluginParameters([]string{"replicas"})
+		assert.Error(t, err)
+		_, err = parsePluginParameters([]string{"=1"})
+		assert.Error(t, err)
+	})
+}
+
 type appOptionsFixture struct {
 	spec    *v1alpha1.ApplicationSpec
 	command *cobra.Command
@@ -190,6 +215,37 @@ func Test_setAppSpecOptions(t *testing.T) {
 		assert.NoError(t, f.SetFlag("sync-retry-limit", "0"))
 		assert.Nil(t, f.spec.SyncPolicy.Retry)
 	})
+	t.Run("HealthVerification", func(t *testing.T) {
+		assert.NoError(t, f.SetFlag("health-verification-duration", "2m"))
+		assert.Equal(t, &v1alpha1.SyncHealthVerification{Duration: "2m0s"}, f.spec.SyncPolicy.HealthVerification)
+
+		assert.NoError(t, f.SetFlag("health-verification-duration", "0"))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
+	t.Run("Hydration", func(t *testing.T) {
+		assert.NoError(t, f.SetFlag("hydration-target-branch", "env/prod"))
+		assert.Equal(t, &v1alpha1.ApplicationHydration{TargetBranch: "env/prod"}, f.spec.Hydration)
+
+		assert.NoError(t, f.SetFlag("hydration-path", "guestbook"))
+		assert.Equal(t, &v1alpha1.ApplicationHydration{TargetBranch: "env/prod", Path: "guestbook"}, f.spec.Hydration)
+
+		assert.NoError(t, f.SetFlag("hydration-target-branch", ""))
+		assert.Nil(t, f.spec.Hydration)
+	})
+	t.Run("AutoSyncLimits", func(t *testing.T) {
+		assert.NoError(t, f.SetFlag("sync-policy", "automated"))
+		assert.NoError(t, f.SetFlag("max-pruned-resources", "3"))
+		assert.Equal(t, int64(3), f.spec.SyncPolicy.Automated.MaxPrunedResources)
+
+		assert.NoError(t, f.SetFlag("max-changed-resources-percent", "20"))
+		assert.Equal(t, int64(20), f.spec.SyncPolicy.Automated.MaxChangedResourcesPercent)
+	})
+	t.Run("AutoRollback", func(t *testing.T) {
+		assert.NoError(t, f.SetFlag("sync-policy", "automated"))
+		assert.NoError(t, f.SetFlag("auto-rollback", "true"))
+		assert.NoError(t, f.SetFlag("auto-rollback-degraded-timeout", "10m"))
+		assert.Equal(t, &v1alpha1.AutomatedRollback{DegradedTimeout: "10m"}, f.spec.SyncPolicy.Automated.Rollback)
+	})
 }
 
 func Test_setAnnotations(t *testing.T) {
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/config"
+)
+
+// ConstructApplicationSet reads one or more application sets from the given file, URL or stdin ("-")
+func ConstructApplicationSet(fileURL string) ([]*v1alpha1.ApplicationSet, error) {
+	var (
+		yml []byte
+		err error
+	)
+	if fileURL == "-" {
+		yml, err = ioutil.ReadAll(bufio.NewReader(os.Stdin))
+	} else {
+		parsedURL, parseErr := url.ParseRequestURI(fileURL)
+		if parseErr != nil || !(parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
+			yml, err = ioutil.ReadFile(fileURL)
+		} else {
+			yml, err = config.ReadRemoteFile(fileURL)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read ApplicationSet manifest from %s: %w", fileURL, err)
+	}
+	return readAppSets(yml)
+}
+
+func readAppSets(yml []byte) ([]*v1alpha1.ApplicationSet, error) {
+	yamls, err := kube.SplitYAMLToString(yml)
+	if err != nil {
+		return nil, err
+	}
+	appsets := make([]*v1alpha1.ApplicationSet, 0, len(yamls))
+	for _, yml := range yamls {
+		var appset v1alpha1.ApplicationSet
+		if err := config.Unmarshal([]byte(yml), &appset); err != nil {
+			return nil, err
+		}
+		if appset.Kind != "" && appset.Kind != "ApplicationSet" {
+			return nil, fmt.Errorf("unexpected kind %q, expected ApplicationSet", appset.Kind)
+		}
+		appsets = append(appsets, &appset)
+	}
+	return appsets, nil
+}
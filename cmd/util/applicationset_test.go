@@ -0,0 +1,73 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var appSetsYaml = `
+apiVersion: argoproj.io/v1alpha1
+kind: ApplicationSet
+metadata:
+  name: guestbook
+spec:
+  generators:
+  - list:
+      elements:
+      - cluster: engineering-dev
+        url: https://kubernetes.default.svc
+  template:
+    metadata:
+      name: '{{cluster}}-guestbook'
+    spec:
+      project: default
+      source:
+        repoURL: https://github.com/argoproj/argocd-example-apps.git
+        path: guestbook
+      destination:
+        server: '{{url}}'
+        namespace: guestbook
+---
+apiVersion: argoproj.io/v1alpha1
+kind: ApplicationSet
+metadata:
+  name: helm-guestbook
+spec:
+  generators:
+  - clusters: {}
+  template:
+    metadata:
+      name: '{{name}}-helm-guestbook'
+    spec:
+      project: my-proj
+      source:
+        repoURL: https://github.com/argoproj/argocd-example-apps.git
+        path: helm-guestbook
+      destination:
+        server: '{{server}}'
+        namespace: helm-guestbook
+`
+
+func TestReadAppSets(t *testing.T) {
+	appsets, err := readAppSets([]byte(appSetsYaml))
+	require.NoError(t, err)
+	require.Len(t, appsets, 2)
+	assert.Equal(t, "guestbook", appsets[0].Name)
+	assert.Equal(t, "default", appsets[0].Spec.Template.Spec.Project)
+	assert.Len(t, appsets[0].Spec.Generators[0].List.Elements, 1)
+	assert.Equal(t, "helm-guestbook", appsets[1].Name)
+	assert.Equal(t, "my-proj", appsets[1].Spec.Template.Spec.Project)
+	assert.NotNil(t, appsets[1].Spec.Generators[0].Clusters)
+}
+
+func TestReadAppSetsWrongKind(t *testing.T) {
+	_, err := readAppSets([]byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: guestbook
+`))
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v2/cmpserver/apiclient"
 	"github.com/argoproj/argo-cd/v2/common"
 	configUtil "github.com/argoproj/argo-cd/v2/util/config"
 )
@@ -21,12 +22,13 @@ type PluginConfig struct {
 }
 
 type PluginConfigSpec struct {
-	Version          string   `json:"version"`
-	Init             Command  `json:"init,omitempty"`
-	Generate         Command  `json:"generate"`
-	Discover         Discover `json:"discover"`
-	AllowConcurrency bool     `json:"allowConcurrency"`
-	LockRepo         bool     `json:"lockRepo"`
+	Version          string     `json:"version"`
+	Init             Command    `json:"init,omitempty"`
+	Generate         Command    `json:"generate"`
+	Discover         Discover   `json:"discover"`
+	AllowConcurrency bool       `json:"allowConcurrency"`
+	LockRepo         bool       `json:"lockRepo"`
+	Parameters       Parameters `json:"parameters"`
 }
 
 //Discover holds find and fileName
@@ -41,6 +43,15 @@ type Command struct {
 	Args    []string `json:"args,omitempty"`
 }
 
+// Parameters holds static and dynamic configurations
+type Parameters struct {
+	// Static parameter announcements are sent to the UI and CLI for all applications handled by the plugin
+	Static []*apiclient.ParameterAnnouncement `json:"static"`
+	// Dynamic is a command which prints parameter announcements as a JSON list, it overrides static announcements of
+	// the same name
+	Dynamic Command `json:"dynamic,omitempty"`
+}
+
 // Find holds find command or glob pattern
 type Find struct {
 	Command
@@ -76,6 +87,39 @@ func ValidatePluginConfig(config PluginConfig) error {
 	if config.Spec.Discover.Find.Glob == "" && len(config.Spec.Discover.Find.Command.Command) == 0 && config.Spec.Discover.FileName == "" {
 		return fmt.Errorf("invalid plugin configuration file. atleast one of discover.find.command or discover.find.glob or discover.fineName should be non-empty")
 	}
+	if err := ValidateParameterAnnouncements(config.Spec.Parameters.Static); err != nil {
+		return fmt.Errorf("invalid plugin configuration file. spec.parameters.static is invalid: %w", err)
+	}
+	return nil
+}
+
+const (
+	// CollectionTypeString is the collection type of parameters holding a single value, which is the default
+	CollectionTypeString = "string"
+	// CollectionTypeArray is the collection type of parameters holding a list of values
+	CollectionTypeArray = "array"
+	// CollectionTypeMap is the collection type of parameters holding a map of values
+	CollectionTypeMap = "map"
+)
+
+// ValidateParameterAnnouncements returns an error if any of the given parameter announcements has no name, has a
+// duplicate name or an unknown collection type
+func ValidateParameterAnnouncements(announcements []*apiclient.ParameterAnnouncement) error {
+	names := map[string]bool{}
+	for _, announcement := range announcements {
+		if announcement == nil || announcement.Name == "" {
+			return fmt.Errorf("parameter name should be non-empty")
+		}
+		if names[announcement.Name] {
+			return fmt.Errorf("parameter %q is announced more than once", announcement.Name)
+		}
+		names[announcement.Name] = true
+		switch announcement.CollectionType {
+		case "", CollectionTypeString, CollectionTypeArray, CollectionTypeMap:
+		default:
+			return fmt.Errorf("parameter %q has invalid collection type %q, must be one of: %s, %s, %s", announcement.Name, announcement.CollectionType, CollectionTypeString, CollectionTypeArray, CollectionTypeMap)
+		}
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package plugin
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -296,3 +297,90 @@ func (s *Service) matchRepository(ctx context.Context, workdir string, envEntrie
 	}
 	return false, nil
 }
+
+// GetParametersAnnouncement receives the application stream and returns the parameters announced by the config
+// management plugin for the application
+func (s *Service) GetParametersAnnouncement(stream apiclient.ConfigManagementPluginService_GetParametersAnnouncementServer) error {
+	bufferedCtx, cancel := buffered_context.WithEarlierDeadline(stream.Context(), cmpTimeoutBuffer)
+	defer cancel()
+
+	workDir, err := files.CreateTempDir(common.GetCMPWorkDir())
+	if err != nil {
+		return fmt.Errorf("error creating parameters announcement workdir: %s", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			// we panic here as the workDir may contain sensitive information
+			panic(fmt.Sprintf("error removing parameters announcement workdir: %s", err))
+		}
+	}()
+
+	metadata, err := cmp.ReceiveRepoStream(bufferedCtx, stream, workDir)
+	if err != nil {
+		return fmt.Errorf("parameters announcement error receiving stream: %s", err)
+	}
+
+	appPath := filepath.Clean(filepath.Join(workDir, metadata.AppRelPath))
+	if !strings.HasPrefix(appPath, workDir) {
+		return fmt.Errorf("illegal appPath: out of workDir bound")
+	}
+	repoResponse, err := s.getParametersAnnouncement(bufferedCtx, appPath, metadata.GetEnv())
+	if err != nil {
+		return fmt.Errorf("get parameters announcement error: %s", err)
+	}
+
+	err = stream.SendAndClose(repoResponse)
+	if err != nil {
+		return fmt.Errorf("error sending parameters announcement response: %s", err)
+	}
+	return nil
+}
+
+// getParametersAnnouncement returns the static parameter announcements of the plugin, overridden by the announcements
+// printed by the dynamic parameters command if configured
+func (s *Service) getParametersAnnouncement(ctx context.Context, appDir string, envEntries []*apiclient.EnvEntry) (*apiclient.ParametersAnnouncementResponse, error) {
+	config := s.initConstants.PluginConfig
+	announcements := config.Spec.Parameters.Static
+
+	if len(config.Spec.Parameters.Dynamic.Command) > 0 {
+		env := append(os.Environ(), environ(envEntries)...)
+		out, err := runCommand(ctx, config.Spec.Parameters.Dynamic, appDir, env)
+		if err != nil {
+			return nil, fmt.Errorf("error running dynamic parameters command: %s", err)
+		}
+		var dynamic []*apiclient.ParameterAnnouncement
+		if err := json.Unmarshal([]byte(out), &dynamic); err != nil {
+			return nil, fmt.Errorf("error unmarshaling dynamic parameter announcements: %s", err)
+		}
+		if err := ValidateParameterAnnouncements(dynamic); err != nil {
+			return nil, fmt.Errorf("invalid dynamic parameter announcements: %s", err)
+		}
+		announcements = mergeParameterAnnouncements(announcements, dynamic)
+	}
+
+	return &apiclient.ParametersAnnouncementResponse{ParameterAnnouncements: announcements}, nil
+}
+
+// mergeParameterAnnouncements returns the static announcements, with announcements of the same name replaced by the
+// dynamic ones, followed by the remaining dynamic announcements
+func mergeParameterAnnouncements(static, dynamic []*apiclient.ParameterAnnouncement) []*apiclient.ParameterAnnouncement {
+	dynamicByName := map[string]*apiclient.ParameterAnnouncement{}
+	for _, announcement := range dynamic {
+		dynamicByName[announcement.Name] = announcement
+	}
+	var merged []*apiclient.ParameterAnnouncement
+	for _, announcement := range static {
+		if override, ok := dynamicByName[announcement.Name]; ok {
+			merged = append(merged, override)
+			delete(dynamicByName, announcement.Name)
+		} else {
+			merged = append(merged, announcement)
+		}
+	}
+	for _, announcement := range dynamic {
+		if _, ok := dynamicByName[announcement.Name]; ok {
+			merged = append(merged, announcement)
+		}
+	}
+	return merged
+}
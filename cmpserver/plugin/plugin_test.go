@@ -266,3 +266,51 @@ func TestRunCommandContextTimeout(t *testing.T) {
 	assert.Error(t, err) // The command should time out, causing an error.
 	assert.Less(t, after.Sub(before), 1*time.Second)
 }
+
+func TestGetParametersAnnouncement(t *testing.T) {
+	static := []*apiclient.ParameterAnnouncement{
+		{Name: "static-a", Title: "Static A", String_: "a"},
+		{Name: "static-b", CollectionType: "array", Array: []string{"b"}},
+	}
+	withParameters := func(p Parameters) pluginOpt {
+		return func(cic *CMPServerInitConstants) {
+			cic.PluginConfig.Spec.Parameters = p
+		}
+	}
+
+	t.Run("static", func(t *testing.T) {
+		s := NewService(*buildPluginConfig(withParameters(Parameters{Static: static})))
+		res, err := s.getParametersAnnouncement(context.Background(), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, static, res.ParameterAnnouncements)
+	})
+	t.Run("dynamic overrides static", func(t *testing.T) {
+		s := NewService(*buildPluginConfig(withParameters(Parameters{
+			Static: static,
+			Dynamic: Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`echo "[{\"name\": \"static-b\", \"collectionType\": \"map\", \"map\": {\"key\": \"$VALUE\"}}, {\"name\": \"dynamic\"}]"`},
+			},
+		})))
+		res, err := s.getParametersAnnouncement(context.Background(), "", []*apiclient.EnvEntry{{Name: "VALUE", Value: "from-env"}})
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.ParameterAnnouncement{
+			static[0],
+			{Name: "static-b", CollectionType: "map", Map: map[string]string{"key": "from-env"}},
+			{Name: "dynamic"},
+		}, res.ParameterAnnouncements)
+	})
+	t.Run("invalid dynamic output", func(t *testing.T) {
+		s := NewService(*buildPluginConfig(withParameters(Parameters{
+			Dynamic: Command{Command: []string{"echo", `[{"name": "a", "collectionType": "list"}]`}},
+		})))
+		_, err := s.getParametersAnnouncement(context.Background(), "", nil)
+		assert.ErrorContains(t, err, `invalid collection type "list"`)
+	})
+}
+
+func TestValidateParameterAnnouncements(t *testing.T) {
+	assert.NoError(t, ValidateParameterAnnouncements([]*apiclient.ParameterAnnouncement{{Name: "a"}, {Name: "b", CollectionType: "map"}}))
+	assert.ErrorContains(t, ValidateParameterAnnouncements([]*apiclient.ParameterAnnouncement{{Title: "a"}}), "name should be non-empty")
+	assert.ErrorContains(t, ValidateParameterAnnouncements([]*apiclient.ParameterAnnouncement{{Name: "a"}, {Name: "a"}}), "announced more than once")
+}
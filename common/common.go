@@ -29,6 +29,10 @@ const (
 	// Contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
 	ArgoCDGPGKeysConfigMapName  = "argocd-gpg-keys-cm"
+	// Contains the cosign public keys which the signatures of Helm charts are verified with. Will get mounted as volume to the repo server
+	ArgoCDCosignKeysConfigMapName = "argocd-cosign-keys-cm"
+	// Contains the heartbeats of the application controller replicas, which are used to distribute clusters across them
+	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 )
 
 // Some default configurables
@@ -61,6 +65,8 @@ const (
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// Default path to GnuPG home directory
 	DefaultGnuPgHomePath = "/app/config/gpg/keys"
+	// Default path where the cosign public keys are located
+	DefaultCosignKeysPath = "/app/config/cosign"
 	// Default path to repo server TLS endpoint config
 	DefaultAppConfigPath = "/app/config"
 	// Default path to cmp server plugin socket file
@@ -114,6 +120,9 @@ const (
 	ArgoCDCLIClientAppName = "Argo CD CLI"
 	// ArgoCDCLIClientAppID is the Oauth client ID we will use when registering our CLI to dex
 	ArgoCDCLIClientAppID = "argo-cd-cli"
+	// ArgoCDSSAManager is the field manager of the resources which the application controller applies with
+	// server-side apply
+	ArgoCDSSAManager = "argocd-controller"
 )
 
 // Resource metadata labels and annotations (keys and values) used by Argo CD components
@@ -143,6 +152,10 @@ const (
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
 	AnnotationValueManagedByArgoCD = "argocd.argoproj.io"
 
+	// AnnotationKeyManagedNamespaceMetadata records the labels and annotations set on a namespace from the managed
+	// namespace metadata of an application, so that they can be removed once they are no longer part of it
+	AnnotationKeyManagedNamespaceMetadata = "argocd.argoproj.io/managed-namespace-metadata"
+
 	// AnnotationKeyLinkPrefix tells the UI to add an external link icon to the application node
 	// that links to the value given in the annotation.
 	// The annotation key must be followed by a unique identifier. Ex: link.argocd.argoproj.io/dashboard
@@ -176,6 +189,8 @@ const (
 	EnvGitSubmoduleEnabled = "ARGOCD_GIT_MODULES_ENABLED"
 	// EnvGnuPGHome is the path to ArgoCD's GnuPG keyring for signature verification
 	EnvGnuPGHome = "ARGOCD_GNUPGHOME"
+	// EnvCosignKeysPath is the path to the cosign public keys for Helm chart signature verification
+	EnvCosignKeysPath = "ARGOCD_COSIGN_KEYS_PATH"
 	// EnvWatchAPIBufferSize is the buffer size used to transfer K8S watch events to watch API consumer
 	EnvWatchAPIBufferSize = "ARGOCD_WATCH_API_BUFFER_SIZE"
 	// EnvPauseGenerationAfterFailedAttempts will pause manifest generation after the specified number of failed generation attempts
@@ -188,6 +203,12 @@ const (
 	EnvControllerReplicas = "ARGOCD_CONTROLLER_REPLICAS"
 	// EnvControllerShard is the shard number that should be handled by controller
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
+	// EnvControllerShardingAlgorithm is the algorithm used to distribute clusters across controller shards
+	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvEnableDynamicClusterDistribution enables discovering the number of controller replicas at runtime
+	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
+	// EnvControllerHeartbeatTime is the interval at which controller replicas record their heartbeat and rebalance clusters
+	EnvControllerHeartbeatTime = "ARGOCD_CONTROLLER_HEARTBEAT_TIME"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
 	EnvEnableGRPCTimeHistogramEnv = "ARGOCD_ENABLE_GRPC_TIME_HISTOGRAM"
 	// EnvGithubAppCredsExpirationDuration controls the caching of Github app credentials. This value is in minutes (default: 60)
@@ -250,6 +271,14 @@ func GetGnuPGHomePath() string {
 	}
 }
 
+// GetCosignKeysPath retrieves the path to the cosign public keys, which is either taken from the ARGOCD_COSIGN_KEYS_PATH environment or a default value
+func GetCosignKeysPath() string {
+	if path := os.Getenv(EnvCosignKeysPath); path != "" {
+		return path
+	}
+	return DefaultCosignKeysPath
+}
+
 // GetPluginSockFilePath retrieves the path of plugin sock file, which is either taken from PluginSockFilePath environment or a default value
 func GetPluginSockFilePath() string {
 	if pluginSockFilePath := os.Getenv(EnvPluginSockFilePath); pluginSockFilePath == "" {
@@ -45,6 +45,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	apputil "github.com/argoproj/argo-cd/v2/util/app"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
@@ -113,6 +114,7 @@ type ApplicationController struct {
 	kubectlSemaphore              *semaphore.Weighted
 	clusterFilter                 func(cluster *appv1.Cluster) bool
 	projByNameCache               sync.Map
+	applicationNamespaces         []string
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -132,6 +134,7 @@ func NewApplicationController(
 	metricsApplicationLabels []string,
 	kubectlParallelismLimit int64,
 	clusterFilter func(cluster *appv1.Cluster) bool,
+	applicationNamespaces []string,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v", appResyncPeriod, appHardResyncPeriod)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -156,6 +159,7 @@ func NewApplicationController(
 		selfHealTimeout:               selfHealTimeout,
 		clusterFilter:                 clusterFilter,
 		projByNameCache:               sync.Map{},
+		applicationNamespaces:         applicationNamespaces,
 	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
@@ -286,7 +290,26 @@ func (projCache *appProjCache) GetAppProject(ctx context.Context) (*appv1.AppPro
 
 func (ctrl *ApplicationController) getAppProj(app *appv1.Application) (*appv1.AppProject, error) {
 	projCache, _ := ctrl.projByNameCache.LoadOrStore(app.Spec.GetProject(), ctrl.newAppProjCache(app.Spec.GetProject()))
-	return projCache.(*appProjCache).GetAppProject(context.TODO())
+	proj, err := projCache.(*appProjCache).GetAppProject(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+	if !proj.IsAppNamespacePermitted(app, ctrl.namespace) {
+		return nil, argo.ErrProjectNotPermitted(app.Name, app.Namespace, proj.Name)
+	}
+	return proj, nil
+}
+
+// toAppKey returns the informer key of the application with the given instance name. The instance name of applications
+// outside of the control plane namespace is prefixed with their namespace.
+func (ctrl *ApplicationController) toAppKey(appName string) string {
+	if strings.Contains(appName, "/") {
+		return appName
+	}
+	if strings.Contains(appName, "_") {
+		return strings.Replace(appName, "_", "/", 1)
+	}
+	return ctrl.namespace + "/" + appName
 }
 
 func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]bool, ref v1.ObjectReference) {
@@ -300,12 +323,12 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 					continue
 				}
 
-				managedByApp[app.Name] = true
+				managedByApp[app.InstanceName(ctrl.namespace)] = true
 			}
 		}
 	}
 	for appName, isManagedResource := range managedByApp {
-		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.namespace + "/" + appName)
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.toAppKey(appName))
 		if app, ok := obj.(*appv1.Application); exists && err == nil && ok && isSelfReferencedApp(app, ref) {
 			// Don't force refresh app if related resource is application itself. This prevents infinite reconciliation loop.
 			continue
@@ -348,11 +371,11 @@ func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application,
 	if err != nil {
 		return nil, fmt.Errorf("error getting resource tree: %s", err)
 	}
-	err = ctrl.cache.SetAppResourcesTree(a.Name, tree)
+	err = ctrl.cache.SetAppResourcesTree(a.InstanceName(ctrl.namespace), tree)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app resource tree: %s", err)
 	}
-	err = ctrl.cache.SetAppManagedResources(a.Name, managedResources)
+	err = ctrl.cache.SetAppManagedResources(a.InstanceName(ctrl.namespace), managedResources)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app managed resources: %s", err)
 	}
@@ -443,7 +466,7 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 			err := ctrl.stateCache.IterateHierarchy(a.Spec.Destination.Server, k, func(child appv1.ResourceNode, appName string) bool {
 				belongToAnotherApp := false
 				if appName != "" {
-					if _, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.namespace + "/" + appName); exists && err == nil {
+					if _, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.toAppKey(appName)); exists && err == nil {
 						belongToAnotherApp = true
 					}
 				}
@@ -706,14 +729,14 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 }
 
 func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration) {
-	key := fmt.Sprintf("%s/%s", ctrl.namespace, appName)
+	key := ctrl.toAppKey(appName)
 
 	if compareWith != nil && after != nil {
 		ctrl.appComparisonTypeRefreshQueue.AddAfter(fmt.Sprintf("%s/%d", key, compareWith), *after)
 	} else {
 		if compareWith != nil {
 			ctrl.refreshRequestedAppsMutex.Lock()
-			ctrl.refreshRequestedApps[appName] = compareWith.Max(ctrl.refreshRequestedApps[appName])
+			ctrl.refreshRequestedApps[key] = compareWith.Max(ctrl.refreshRequestedApps[key])
 			ctrl.refreshRequestedAppsMutex.Unlock()
 		}
 		if after != nil {
@@ -727,11 +750,12 @@ func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith
 }
 
 func (ctrl *ApplicationController) isRefreshRequested(appName string) (bool, CompareWith) {
+	key := ctrl.toAppKey(appName)
 	ctrl.refreshRequestedAppsMutex.Lock()
 	defer ctrl.refreshRequestedAppsMutex.Unlock()
-	level, ok := ctrl.refreshRequestedApps[appName]
+	level, ok := ctrl.refreshRequestedApps[key]
 	if ok {
-		delete(ctrl.refreshRequestedApps, appName)
+		delete(ctrl.refreshRequestedApps, key)
 	}
 	return ok, level
 }
@@ -770,7 +794,7 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		// If we get here, we are about process an operation but we cannot rely on informer since it might has stale data.
 		// So always retrieve the latest version to ensure it is not stale to avoid unnecessary syncing.
 		// We cannot rely on informer since applications might be updated by both application controller and api server.
-		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(context.Background(), app.ObjectMeta.Name, metav1.GetOptions{})
+		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.ObjectMeta.Name, metav1.GetOptions{})
 		if err != nil {
 			log.Errorf("Failed to retrieve latest application state: %v", err)
 			return
@@ -816,7 +840,7 @@ func (ctrl *ApplicationController) processAppComparisonTypeQueueItem() (processN
 			log.Warnf("Unable to parse comparison type: %v", err)
 			return
 		} else {
-			ctrl.requestAppRefresh(parts[1], CompareWith(compareWith).Pointer(), nil)
+			ctrl.requestAppRefresh(parts[0]+"/"+parts[1], CompareWith(compareWith).Pointer(), nil)
 		}
 	}
 	return
@@ -860,13 +884,13 @@ func (ctrl *ApplicationController) processProjectQueueItem() (processNext bool)
 }
 
 func (ctrl *ApplicationController) finalizeProjectDeletion(proj *appv1.AppProject) error {
-	apps, err := ctrl.appLister.Applications(ctrl.namespace).List(labels.Everything())
+	apps, err := ctrl.appLister.List(labels.Everything())
 	if err != nil {
 		return err
 	}
 	appsCount := 0
 	for i := range apps {
-		if apps[i].Spec.GetProject() == proj.Name {
+		if apps[i].Spec.GetProject() == proj.Name && ctrl.isAppNamespaceAllowed(apps[i]) {
 			appsCount++
 		}
 	}
@@ -1001,11 +1025,11 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		}
 	}
 
-	if err := ctrl.cache.SetAppManagedResources(app.Name, nil); err != nil {
+	if err := ctrl.cache.SetAppManagedResources(app.InstanceName(ctrl.namespace), nil); err != nil {
 		return objs, err
 	}
 
-	if err := ctrl.cache.SetAppResourcesTree(app.Name, nil); err != nil {
+	if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), nil); err != nil {
 		return objs, err
 	}
 
@@ -1080,9 +1104,17 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	if isOperationInProgress(app) {
 		state = app.Status.OperationState.DeepCopy()
 		terminating = state.Phase == synccommon.OperationTerminating
+		if state.HealthVerificationStartedAt != nil {
+			// the sync itself has completed, only the health of the application is being verified
+			if ctrl.verifyHealth(app, state, terminating) {
+				ctrl.setOperationState(app, state)
+				ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), nil)
+			}
+			return
+		}
 		// Failed  operation with retry strategy might have be in-progress and has completion time
 		if state.FinishedAt != nil && !terminating {
-			retryAt, err := app.Status.OperationState.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount)
+			retryAt, err := app.Status.OperationState.Operation.Retry.ForFailureCategory(state.FailureCategory).NextRetryAt(state.FinishedAt.Time, state.RetryCount)
 			if err != nil {
 				state.Phase = synccommon.OperationFailed
 				state.Message = err.Error()
@@ -1092,7 +1124,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			retryAfter := time.Until(retryAt)
 			if retryAfter > 0 {
 				logCtx.Infof("Skipping retrying in-progress operation. Attempting again at: %s", retryAt.Format(time.RFC3339))
-				ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), &retryAfter)
+				ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
 				return
 			} else {
 				// retrying operation. remove previous failure time in app since it is used as a trigger
@@ -1118,10 +1150,14 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		ctrl.appStateManager.SyncAppState(app, state)
 	}
 
+	if ctrl.startHealthVerification(app, state) {
+		logCtx.Infof("Verifying application health after successful sync")
+	}
+
 	if state.Phase == synccommon.OperationRunning {
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
-		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(context.Background(), app.ObjectMeta.Name, metav1.GetOptions{})
+		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.ObjectMeta.Name, metav1.GetOptions{})
 		if err == nil {
 			if freshApp.Status.OperationState != nil && freshApp.Status.OperationState.Phase == synccommon.OperationTerminating {
 				state.Phase = synccommon.OperationTerminating
@@ -1132,10 +1168,12 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			}
 		}
 	} else if state.Phase == synccommon.OperationFailed || state.Phase == synccommon.OperationError {
-		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
+		state.FailureCategory = classifySyncFailure(state)
+		retry := state.Operation.Retry.ForFailureCategory(state.FailureCategory)
+		if !terminating && (state.RetryCount < retry.Limit || retry.Limit < 0) {
 			now := metav1.Now()
 			state.FinishedAt = &now
-			if retryAt, err := state.Operation.Retry.NextRetryAt(now.Time, state.RetryCount); err != nil {
+			if retryAt, err := retry.NextRetryAt(now.Time, state.RetryCount); err != nil {
 				state.Phase = synccommon.OperationFailed
 				state.Message = fmt.Sprintf("%s (failed to retry: %v)", state.Message, err)
 			} else {
@@ -1145,8 +1183,11 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			}
 		} else if state.RetryCount > 0 {
 			state.Message = fmt.Sprintf("%s (retried %d times).", state.Message, state.RetryCount)
+		} else if !terminating && retry.Limit == 0 && state.Operation.Retry.Limit != 0 {
+			state.Message = fmt.Sprintf("%s (%s failures are not retried).", state.Message, state.FailureCategory)
 		}
-
+	} else {
+		state.FailureCategory = ""
 	}
 
 	ctrl.setOperationState(app, state)
@@ -1155,7 +1196,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		// sync/health information
 		if _, err := cache.MetaNamespaceKeyFunc(app); err == nil {
 			// force app refresh with using CompareWithLatest comparison type and trigger app reconciliation loop
-			ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), nil)
 		} else {
 			logCtx.Warnf("Fails to requeue application: %v", err)
 		}
@@ -1196,8 +1237,14 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 				return err
 			}
 		}
+		if app.Status.OperationState != nil && app.Status.OperationState.FailureCategory != "" && state.FailureCategory == "" {
+			patchJSON, err = jsonpatch.MergeMergePatches(patchJSON, []byte(`{"status": {"operationState": {"failureCategory": null}}}`))
+			if err != nil {
+				return err
+			}
+		}
 
-		appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace)
+		appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 		_, err = appClient.Patch(context.Background(), app.Name, types.MergePatchType, patchJSON, metav1.PatchOptions{})
 		if err != nil {
 			// Stop retrying updating deleted application
@@ -1216,7 +1263,11 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 				messages = []string{"Sync operation"}
 			}
 			if state.SyncResult != nil {
-				messages = append(messages, "to", state.SyncResult.Revision)
+				if len(state.SyncResult.Revisions) > 0 {
+					messages = append(messages, "to", strings.Join(state.SyncResult.Revisions, ", "))
+				} else {
+					messages = append(messages, "to", state.SyncResult.Revision)
+				}
 			}
 			if state.Phase.Successful() {
 				eventInfo.Type = v1.EventTypeNormal
@@ -1284,13 +1335,13 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 
 	if comparisonLevel == ComparisonWithNothing {
 		managedResources := make([]*appv1.ResourceDiff, 0)
-		if err := ctrl.cache.GetAppManagedResources(app.Name, &managedResources); err != nil {
+		if err := ctrl.cache.GetAppManagedResources(app.InstanceName(ctrl.namespace), &managedResources); err != nil {
 			logCtx.Warnf("Failed to get cached managed resources for tree reconciliation, fall back to full reconciliation")
 		} else {
 			var tree *appv1.ApplicationTree
 			if tree, err = ctrl.getResourceTree(app, managedResources); err == nil {
 				app.Status.Summary = tree.GetSummary()
-				if err := ctrl.cache.SetAppResourcesTree(app.Name, tree); err != nil {
+				if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), tree); err != nil {
 					logCtx.Errorf("Failed to cache resources tree: %v", err)
 					return
 				}
@@ -1307,10 +1358,10 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Health.Status = health.HealthStatusUnknown
 		ctrl.persistAppStatus(origApp, &app.Status)
 
-		if err := ctrl.cache.SetAppResourcesTree(app.Name, &appv1.ApplicationTree{}); err != nil {
+		if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), &appv1.ApplicationTree{}); err != nil {
 			log.Warnf("failed to set app resource tree: %v", err)
 		}
-		if err := ctrl.cache.SetAppManagedResources(app.Name, nil); err != nil {
+		if err := ctrl.cache.SetAppManagedResources(app.InstanceName(ctrl.namespace), nil); err != nil {
 			log.Warnf("failed to set app managed resources tree: %v", err)
 		}
 		return
@@ -1321,15 +1372,20 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		localManifests = opState.Operation.Sync.Manifests
 	}
 
-	revision := app.Spec.Source.TargetRevision
+	sources := app.Spec.GetSources()
+	revisions := make([]string, len(sources))
 	if comparisonLevel == CompareWithRecent {
-		revision = app.Status.Sync.Revision
+		if app.Spec.HasMultipleSources() {
+			copy(revisions, app.Status.Sync.Revisions)
+		} else {
+			revisions[0] = app.Status.Sync.Revision
+		}
 	}
 
 	now := metav1.Now()
-	compareResult := ctrl.appStateManager.CompareAppState(app, project, revision, app.Spec.Source,
+	compareResult := ctrl.appStateManager.CompareAppState(app, project, revisions, sources,
 		refreshType == appv1.RefreshTypeHard,
-		comparisonLevel == CompareWithLatestForceResolve, localManifests)
+		comparisonLevel == CompareWithLatestForceResolve, localManifests, app.Spec.HasMultipleSources())
 	for k, v := range compareResult.timings {
 		logCtx = logCtx.WithField(k, v.Milliseconds())
 	}
@@ -1343,8 +1399,26 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary()
 	}
 
+	setHealthTransitionTime(app, compareResult.healthStatus, now)
+	markHealthyRevision(app, compareResult.healthStatus)
+
 	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
-		syncErrCond := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+		rollbackCond, rolledBack := ctrl.autoRollback(app, compareResult.healthStatus)
+		if rollbackCond != nil {
+			app.Status.SetConditions(
+				[]appv1.ApplicationCondition{*rollbackCond},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutoRollbackWarning: true},
+			)
+		} else {
+			app.Status.SetConditions(
+				[]appv1.ApplicationCondition{},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutoRollbackWarning: true},
+			)
+		}
+		var syncErrCond *appv1.ApplicationCondition
+		if !rolledBack {
+			syncErrCond = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+		}
 		if syncErrCond != nil {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{*syncErrCond},
@@ -1370,6 +1444,12 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
 	})
 	app.Status.SourceType = compareResult.appSourceType
+	// the last hydration is kept if the manifests could not be hydrated this time
+	if app.Spec.Hydration == nil {
+		app.Status.Hydration = nil
+	} else if compareResult.hydrationStatus != nil {
+		app.Status.Hydration = compareResult.hydrationStatus
+	}
 	ctrl.persistAppStatus(origApp, &app.Status)
 	return
 }
@@ -1378,6 +1458,16 @@ func resourceStatusKey(res appv1.ResourceStatus) string {
 	return strings.Join([]string{res.Group, res.Kind, res.Namespace, res.Name}, "/")
 }
 
+// setHealthTransitionTime sets the last transition time of the given health status to the given time if the health
+// status of the application changed, or keeps the last transition time of the application otherwise
+func setHealthTransitionTime(app *appv1.Application, healthStatus *appv1.HealthStatus, now metav1.Time) {
+	if app.Status.Health.Status == healthStatus.Status && app.Status.Health.LastTransitionTime != nil {
+		healthStatus.LastTransitionTime = app.Status.Health.LastTransitionTime
+	} else {
+		healthStatus.LastTransitionTime = &now
+	}
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
 // Additionally returns whether full refresh was requested or not.
@@ -1395,9 +1485,12 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 		// user requested app refresh.
 		refreshType = requestedType
 		reason = fmt.Sprintf("%s refresh requested", refreshType)
-	} else if !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
+	} else if !app.Spec.HasMultipleSources() && !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
 		reason = "spec.source differs"
 		compareWith = CompareWithLatestForceResolve
+	} else if app.Spec.HasMultipleSources() && !reflect.DeepEqual(app.Spec.Sources, app.Status.Sync.ComparedTo.Sources) {
+		reason = "spec.sources differ"
+		compareWith = CompareWithLatestForceResolve
 	} else if hardExpired || softExpired {
 		// The commented line below mysteriously crashes if app.Status.ReconciledAt is nil
 		// reason = fmt.Sprintf("comparison expired. reconciledAt: %v, expiry: %v", app.Status.ReconciledAt, statusRefreshTimeout)
@@ -1413,7 +1506,7 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 		}
 	} else if !app.Spec.Destination.Equals(app.Status.Sync.ComparedTo.Destination) {
 		reason = "spec.destination differs"
-	} else if requested, level := ctrl.isRefreshRequested(app.Name); requested {
+	} else if requested, level := ctrl.isRefreshRequested(app.QualifiedName()); requested {
 		compareWith = level
 		reason = "controller refresh requested"
 	}
@@ -1553,11 +1646,13 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 
 	desiredCommitSHA := syncStatus.Revision
-	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA)
+	desiredCommitSHAs := syncStatus.Revisions
+	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA, desiredCommitSHAs, app.Spec.HasMultipleSources())
 	selfHeal := app.Spec.SyncPolicy.Automated.SelfHeal
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Revision:    desiredCommitSHA,
+			Revisions:   desiredCommitSHAs,
 			Prune:       app.Spec.SyncPolicy.Automated.Prune,
 			SyncOptions: app.Spec.SyncPolicy.SyncOptions,
 		},
@@ -1567,6 +1662,16 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	if app.Spec.SyncPolicy.Retry != nil {
 		op.Retry = *app.Spec.SyncPolicy.Retry
 	}
+	if app.Spec.HasMultipleSources() {
+		// only used for the messages below, the operation holds the revision of each source
+		desiredCommitSHA = strings.Join(desiredCommitSHAs, ", ")
+	}
+	if app.Status.OperationState != nil {
+		if from, _ := autoRollbackInfo(&app.Status.OperationState.Operation); from != "" && from == desiredCommitSHA {
+			logCtx.Infof("Skipping auto-sync: automated sync to %s is paused after an automated rollback", desiredCommitSHA)
+			return nil
+		}
+	}
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
@@ -1592,7 +1697,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 			}
 		} else {
 			logCtx.Infof("Skipping auto-sync: already attempted sync to %s with timeout %v (retrying in %v)", desiredCommitSHA, ctrl.selfHealTimeout, retryAfter)
-			ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), &retryAfter)
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
 			return nil
 		}
 
@@ -1612,6 +1717,12 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		}
 	}
 
+	if reason := exceedsAutoSyncLimits(app.Spec.SyncPolicy.Automated, resources); reason != "" {
+		message := fmt.Sprintf("Skipping sync attempt to %s: %s", desiredCommitSHA, reason)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
+	}
+
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 	_, err := argo.SetAppOperation(appIf, app.Name, &op)
 	if err != nil {
@@ -1624,12 +1735,45 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil
 }
 
+// exceedsAutoSyncLimits returns the reason why an automated sync of the given resources would exceed the limits of the
+// automated sync policy, or an empty string if it would not exceed them
+func exceedsAutoSyncLimits(automated *appv1.SyncPolicyAutomated, resources []appv1.ResourceStatus) string {
+	pruned := 0
+	changed := 0
+	for _, r := range resources {
+		if r.Status == appv1.SyncStatusCodeSynced {
+			continue
+		}
+		if r.RequiresPruning {
+			if !automated.Prune {
+				continue
+			}
+			pruned++
+		}
+		changed++
+	}
+	if automated.MaxPrunedResources > 0 && int64(pruned) > automated.MaxPrunedResources {
+		return fmt.Sprintf("auto-sync would prune %d resources, exceeding the maximum of %d", pruned, automated.MaxPrunedResources)
+	}
+	if automated.MaxChangedResourcesPercent > 0 && int64(changed)*100 > automated.MaxChangedResourcesPercent*int64(len(resources)) {
+		return fmt.Sprintf("auto-sync would change %d of %d resources (%d%%), exceeding the maximum of %d%%", changed, len(resources), changed*100/len(resources), automated.MaxChangedResourcesPercent)
+	}
+	return ""
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
-// commitSHA and with the same app source config which are currently set in the app
-func alreadyAttemptedSync(app *appv1.Application, commitSHA string) (bool, synccommon.OperationPhase) {
+// commitSHA (or the commitSHAs of all sources for applications with multiple sources) and with
+// the same app source config which are currently set in the app
+func alreadyAttemptedSync(app *appv1.Application, commitSHA string, commitSHAs []string, hasMultipleSources bool) (bool, synccommon.OperationPhase) {
 	if app.Status.OperationState == nil || app.Status.OperationState.Operation.Sync == nil || app.Status.OperationState.SyncResult == nil {
 		return false, ""
 	}
+	if hasMultipleSources {
+		if !reflect.DeepEqual(app.Status.OperationState.SyncResult.Revisions, commitSHAs) {
+			return false, ""
+		}
+		return reflect.DeepEqual(app.Spec.Sources, app.Status.OperationState.SyncResult.Sources), app.Status.OperationState.Phase
+	}
 	if app.Status.OperationState.SyncResult.Revision != commitSHA {
 		return false, ""
 	}
@@ -1661,6 +1805,9 @@ func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
 	if !ok {
 		return false
 	}
+	if !ctrl.isAppNamespaceAllowed(app) {
+		return false
+	}
 	if ctrl.clusterFilter != nil {
 		cluster, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
 		if err != nil {
@@ -1672,6 +1819,33 @@ func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
 	return true
 }
 
+// HandleClusterShardingChange stops watching the clusters which are no longer handled by the controller, and requests a
+// refresh of the applications it handles, so that the applications of newly assigned clusters are processed right away
+func (ctrl *ApplicationController) HandleClusterShardingChange() {
+	ctrl.stateCache.InvalidateUnmanagedClusters()
+	for _, obj := range ctrl.appInformer.GetStore().List() {
+		if !ctrl.canProcessApp(obj) {
+			continue
+		}
+		app := obj.(*appv1.Application)
+		ctrl.requestAppRefresh(app.QualifiedName(), nil, nil)
+	}
+}
+
+// isAppNamespaceAllowed returns whether applications in the namespace of the given application are handled by the controller
+func (ctrl *ApplicationController) isAppNamespaceAllowed(app *appv1.Application) bool {
+	return apputil.IsAppNamespaceEnabled(app.Namespace, ctrl.namespace, ctrl.applicationNamespaces)
+}
+
+// watchedNamespace returns the namespace the application informer watches, which is every namespace if applications
+// are allowed outside of the control plane namespace
+func (ctrl *ApplicationController) watchedNamespace() string {
+	if len(ctrl.applicationNamespaces) > 0 {
+		return metav1.NamespaceAll
+	}
+	return ctrl.namespace
+}
+
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
 	refreshTimeout := ctrl.statusRefreshTimeout
 	if ctrl.statusHardRefreshTimeout.Seconds() != 0 && (ctrl.statusHardRefreshTimeout < ctrl.statusRefreshTimeout) {
@@ -1680,10 +1854,10 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (apiruntime.Object, error) {
-				return ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).List(context.TODO(), options)
+				return ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.watchedNamespace()).List(context.TODO(), options)
 			},
 			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Watch(context.TODO(), options)
+				return ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.watchedNamespace()).Watch(context.TODO(), options)
 			},
 		},
 		&appv1.Application{},
@@ -1712,7 +1886,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 
 				proj, err := applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()).AppProjects(ctrl.namespace).Get(app.Spec.GetProject())
-				if err != nil {
+				if err != nil || !proj.IsAppNamespacePermitted(app, ctrl.namespace) {
 					return nil, nil
 				}
 				if proj.Spec.OrphanedResources != nil {
@@ -1751,7 +1925,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 					log.WithField("application", newApp.Name).Info("Enabled automated sync")
 					compareWith = CompareWithLatest.Pointer()
 				}
-				ctrl.requestAppRefresh(newApp.Name, compareWith, nil)
+				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, nil)
 				ctrl.appOperationQueue.Add(key)
 			},
 			DeleteFunc: func(obj interface{}) {
@@ -1771,7 +1945,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(ctrl.namespace), ctrl.cache, ctrl.clusterFilter)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(ctrl.watchedNamespace()), ctrl.cache, ctrl.clusterFilter)
 	go updater.Run(ctx)
 }
 
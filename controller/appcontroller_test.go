@@ -14,6 +14,7 @@ import (
 	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 
 	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
 
 	mockstatecache "github.com/argoproj/argo-cd/v2/controller/cache/mocks"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -54,6 +56,8 @@ type fakeData struct {
 	namespacedResources    map[kube.ResourceKey]namespacedResource
 	configMapData          map[string]string
 	metricsCacheExpiration time.Duration
+	applicationNamespaces  []string
+	hydrateResponse        *apiclient.HydrateManifestsResponse
 }
 
 func newFakeController(data *fakeData) *ApplicationController {
@@ -66,6 +70,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 	// Mock out call to GenerateManifest
 	mockRepoClient := mockrepoclient.RepoServerServiceClient{}
 	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, nil)
+	mockRepoClient.On("HydrateManifests", mock.Anything, mock.Anything).Return(data.hydrateResponse, nil)
 	mockRepoClientset := mockrepoclient.Clientset{RepoServerServiceClient: &mockRepoClient}
 
 	secret := corev1.Secret{
@@ -110,6 +115,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 		[]string{},
 		0,
 		nil,
+		data.applicationNamespaces,
 	)
 	if err != nil {
 		panic(err)
@@ -328,6 +334,64 @@ func TestAutoSyncAllowEmpty(t *testing.T) {
 	assert.Nil(t, cond)
 }
 
+func TestAutoSyncLimits(t *testing.T) {
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []argoappv1.ResourceStatus{
+		{Name: "guestbook", Kind: kube.DeploymentKind, Status: argoappv1.SyncStatusCodeOutOfSync},
+		{Name: "guestbook", Kind: kube.ServiceKind, Status: argoappv1.SyncStatusCodeSynced},
+		{Name: "guestbook-1", Kind: "ConfigMap", Status: argoappv1.SyncStatusCodeOutOfSync, RequiresPruning: true},
+		{Name: "guestbook-2", Kind: "ConfigMap", Status: argoappv1.SyncStatusCodeOutOfSync, RequiresPruning: true},
+	}
+
+	t.Run("MaxPrunedResourcesExceeded", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.Prune = true
+		app.Spec.SyncPolicy.Automated.MaxPrunedResources = 1
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionSyncError, cond.Type)
+		assert.Equal(t, "Skipping sync attempt to bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb: auto-sync would prune 2 resources, exceeding the maximum of 1", cond.Message)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("MaxPrunedResourcesIgnoredWithoutPrune", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.MaxPrunedResources = 1
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("MaxChangedResourcesPercentExceeded", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.Prune = true
+		app.Spec.SyncPolicy.Automated.MaxChangedResourcesPercent = 50
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.NotNil(t, cond)
+		assert.Equal(t, "Skipping sync attempt to bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb: auto-sync would change 3 of 4 resources (75%), exceeding the maximum of 50%", cond.Message)
+	})
+
+	t.Run("WithinLimits", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.Prune = true
+		app.Spec.SyncPolicy.Automated.MaxPrunedResources = 2
+		app.Spec.SyncPolicy.Automated.MaxChangedResourcesPercent = 75
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.autoSync(app, &syncStatus, resources)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+}
+
 func TestSkipAutoSync(t *testing.T) {
 	// Verify we skip when we previously synced to it in our most recent history
 	// Set current to 'aaaaa', desired to 'aaaa' and mark system OutOfSync
@@ -996,6 +1060,21 @@ func TestRefreshAppConditions(t *testing.T) {
 	})
 }
 
+func TestSetHealthTransitionTime(t *testing.T) {
+	degradedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	now := metav1.Now()
+
+	app := newFakeApp()
+	app.Status.Health = argoappv1.HealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: &degradedAt}
+	healthStatus := &argoappv1.HealthStatus{Status: health.HealthStatusDegraded}
+	setHealthTransitionTime(app, healthStatus, now)
+	assert.Equal(t, &degradedAt, healthStatus.LastTransitionTime)
+
+	healthStatus = &argoappv1.HealthStatus{Status: health.HealthStatusHealthy}
+	setHealthTransitionTime(app, healthStatus, now)
+	assert.Equal(t, &now, healthStatus.LastTransitionTime)
+}
+
 func TestUpdateReconciledAt(t *testing.T) {
 	app := newFakeApp()
 	reconciledAt := metav1.NewTime(time.Now().Add(-1 * time.Second))
@@ -1172,6 +1251,35 @@ func TestProcessRequestedAppOperation_FailedHasRetries(t *testing.T) {
 	assert.Equal(t, float64(1), retryCount)
 }
 
+func TestProcessRequestedAppOperation_FailureCategoryNotRetried(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "invalid-project"
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{},
+		Retry: argoappv1.RetryStrategy{Limit: 1, FailureCategories: []argoappv1.RetryFailureCategory{
+			{Category: argoappv1.SyncFailureCategoryUnknown, Limit: pointer.Int64Ptr(0)},
+		}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationError), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Contains(t, message, "Unknown failures are not retried")
+	failureCategory, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "failureCategory")
+	assert.Equal(t, string(argoappv1.SyncFailureCategoryUnknown), failureCategory)
+}
+
 func TestProcessRequestedAppOperation_RunningPreviouslyFailed(t *testing.T) {
 	app := newFakeApp()
 	app.Operation = &argoappv1.Operation{
@@ -1311,3 +1419,72 @@ func TestMetricsExpiration(t *testing.T) {
 	ctrl = newFakeController(&fakeData{apps: []runtime.Object{app}, metricsCacheExpiration: 10 * time.Second})
 	assert.True(t, ctrl.metricsServer.HasExpiration())
 }
+
+func TestToAppKey(t *testing.T) {
+	ctrl := newFakeController(&fakeData{})
+	tests := []struct {
+		name     string
+		appName  string
+		expected string
+	}{
+		{"From instance name", "foo_bar", "foo/bar"},
+		{"From qualified name", "foo/bar", "foo/bar"},
+		{"From unqualified name", "bar", ctrl.namespace + "/bar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ctrl.toAppKey(tt.appName))
+		})
+	}
+}
+
+func TestAppsInAnyNamespace(t *testing.T) {
+	defaultProj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "default",
+			Namespace: test.FakeArgoCDNamespace,
+		},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos: []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{
+				{
+					Server:    "*",
+					Namespace: "*",
+				},
+			},
+		},
+	}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.SourceNamespaces = []string{"apps-*"}
+
+	newApp := func(namespace string) *argoappv1.Application {
+		app := newFakeApp()
+		app.Namespace = namespace
+		return app
+	}
+
+	t.Run("Enabled namespaces", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{proj}, applicationNamespaces: []string{"apps-*"}})
+		assert.True(t, ctrl.canProcessApp(newApp(test.FakeArgoCDNamespace)))
+		assert.True(t, ctrl.canProcessApp(newApp("apps-team")))
+		assert.False(t, ctrl.canProcessApp(newApp("other")))
+	})
+
+	t.Run("Namespace not permitted by project", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{&defaultProj}, applicationNamespaces: []string{"apps-*"}})
+		app := newApp("apps-team")
+		_, hasErrors := ctrl.refreshAppConditions(app)
+		assert.True(t, hasErrors)
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, "application 'my-app' in namespace 'apps-team' is not permitted to use project 'default'", app.Status.Conditions[0].Message)
+	})
+
+	t.Run("Namespace permitted by project", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{proj}, applicationNamespaces: []string{"apps-*"}})
+		app := newApp("apps-team")
+		_, hasErrors := ctrl.refreshAppConditions(app)
+		assert.False(t, hasErrors)
+		assert.Len(t, app.Status.Conditions, 0)
+	})
+}
@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+const (
+	// autoRollbackFromInfo is the name of the operation info which records the revision an automated rollback rolled back from
+	autoRollbackFromInfo = "Rolled back from"
+	// autoRollbackReasonInfo is the name of the operation info which records why an automated rollback was performed
+	autoRollbackReasonInfo = "Rollback reason"
+)
+
+// markHealthyRevision marks the most recent entry of the revision history as healthy once the application is Healthy
+// after a sync, which makes it a candidate for automated rollbacks
+func markHealthyRevision(app *appv1.Application, healthStatus *appv1.HealthStatus) {
+	if app.Operation != nil || healthStatus.Status != health.HealthStatusHealthy || len(app.Status.History) == 0 {
+		return
+	}
+	app.Status.History[len(app.Status.History)-1].Healthy = true
+}
+
+// autoRollbackInfo returns the revision the given operation rolled back from and the reason of the rollback, or empty
+// strings if the operation is not an automated rollback
+func autoRollbackInfo(op *appv1.Operation) (string, string) {
+	if op == nil || !op.InitiatedBy.Automated {
+		return "", ""
+	}
+	var from, reason string
+	for _, info := range op.Info {
+		switch info.Name {
+		case autoRollbackFromInfo:
+			from = info.Value
+		case autoRollbackReasonInfo:
+			reason = info.Value
+		}
+	}
+	return from, reason
+}
+
+// autoRollbackCondition returns the condition which informs about the given automated rollback operation
+func autoRollbackCondition(op *appv1.Operation) *appv1.ApplicationCondition {
+	from, reason := autoRollbackInfo(op)
+	message := fmt.Sprintf("Rolled back from %s to %s: %s. Automated sync is paused until a new revision is committed or the application is synced manually.",
+		from, syncOperationRevision(op.Sync), reason)
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoRollbackWarning, Message: message}
+}
+
+// syncOperationRevision returns the revision of the given sync operation, or the revisions of all sources joined for
+// operations of applications with multiple sources
+func syncOperationRevision(op *appv1.SyncOperation) string {
+	if op == nil {
+		return ""
+	}
+	if len(op.Revisions) > 0 {
+		return strings.Join(op.Revisions, ", ")
+	}
+	return op.Revision
+}
+
+// historyRevision returns the revision of the given history entry, or the revisions of all sources joined for entries
+// of applications with multiple sources
+func historyRevision(h appv1.RevisionHistory) string {
+	if len(h.Revisions) > 0 {
+		return strings.Join(h.Revisions, ", ")
+	}
+	return h.Revision
+}
+
+// autoRollback rolls the application back to the most recent healthy revision of its history if its most recent
+// automated sync failed, or left it Degraded for longer than the degraded timeout of the rollback policy. Returns the
+// condition informing about the rollback, and whether a rollback was initiated.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, healthStatus *appv1.HealthStatus) (*appv1.ApplicationCondition, bool) {
+	if app.Operation != nil {
+		if from, _ := autoRollbackInfo(app.Operation); from != "" {
+			return autoRollbackCondition(app.Operation), false
+		}
+		return nil, false
+	}
+	state := app.Status.OperationState
+	if state == nil {
+		return nil, false
+	}
+	if from, _ := autoRollbackInfo(&state.Operation); from != "" {
+		// automated sync stays paused after a rollback until a new revision is committed or the application is synced manually
+		return autoRollbackCondition(&state.Operation), false
+	}
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil || app.Spec.SyncPolicy.Automated.Rollback == nil {
+		return nil, false
+	}
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		return nil, false
+	}
+	if !state.Phase.Completed() || state.Operation.Sync == nil || !state.Operation.InitiatedBy.Automated {
+		return nil, false
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName()})
+
+	failedRevision := syncOperationRevision(state.Operation.Sync)
+	for _, h := range app.Status.History {
+		if h.Healthy && historyRevision(h) == failedRevision {
+			// the revision has been healthy before, so rolling back is unlikely to help
+			return nil, false
+		}
+	}
+
+	var reason string
+	if !state.Phase.Successful() {
+		reason = fmt.Sprintf("automated sync failed: %s", state.Message)
+	} else if healthStatus.Status == health.HealthStatusDegraded && state.FinishedAt != nil {
+		timeout, err := app.Spec.SyncPolicy.Automated.Rollback.GetDegradedTimeout()
+		if err != nil {
+			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoRollbackWarning, Message: fmt.Sprintf("Invalid degraded timeout: %v", err)}, false
+		}
+		// the application is Degraded since the later of its health transition and the end of the sync
+		degradedSince := time.Now()
+		if healthStatus.LastTransitionTime != nil {
+			degradedSince = healthStatus.LastTransitionTime.Time
+			if state.FinishedAt.After(degradedSince) {
+				degradedSince = state.FinishedAt.Time
+			}
+		}
+		if degradedFor := time.Since(degradedSince); degradedFor < timeout {
+			retryAfter := timeout - degradedFor
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
+			return nil, false
+		}
+		reason = fmt.Sprintf("application remained Degraded for more than %v after automated sync", timeout)
+	} else {
+		return nil, false
+	}
+
+	var target *appv1.RevisionHistory
+	for i := len(app.Status.History) - 1; i >= 0; i-- {
+		h := app.Status.History[i]
+		if h.Healthy && historyRevision(h) != failedRevision && (len(h.Sources) > 0 || !h.Source.IsZero()) {
+			target = &h
+			break
+		}
+	}
+	if target == nil {
+		message := fmt.Sprintf("Unable to roll back from %s: no healthy revision in history", failedRevision)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoRollbackWarning, Message: message}, false
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:    target.Revision,
+			Prune:       app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions: app.Spec.SyncPolicy.SyncOptions,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Info: []*appv1.Info{
+			{Name: autoRollbackFromInfo, Value: failedRevision},
+			{Name: autoRollbackReasonInfo, Value: reason},
+		},
+		Retry: appv1.RetryStrategy{Limit: 5},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+	if len(target.Sources) > 0 {
+		op.Sync.Sources = target.Sources
+		op.Sync.Revisions = target.Revisions
+	} else {
+		op.Sync.Source = &target.Source
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	if _, err := argo.SetAppOperation(appIf, app.Name, &op); err != nil {
+		logCtx.Errorf("Failed to initiate automated rollback from %s to %s: %v", failedRevision, historyRevision(*target), err)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoRollbackWarning, Message: err.Error()}, false
+	}
+	message := fmt.Sprintf("Initiated automated rollback from '%s' to '%s': %s", failedRevision, historyRevision(*target), reason)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeWarning}, message)
+	logCtx.Info(message)
+	return autoRollbackCondition(&op), true
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+// newFakeRollbackApp returns an application with automated rollback enabled, whose most recent automated sync to
+// revision "bbb" finished with the given phase, and whose history contains the healthy revision "aaa"
+func newFakeRollbackApp(phase synccommon.OperationPhase) *argoappv1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.Rollback = &argoappv1.AutomatedRollback{DegradedTimeout: "1m"}
+	finishedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync:        &argoappv1.SyncOperation{Revision: "bbb"},
+			InitiatedBy: argoappv1.OperationInitiator{Automated: true},
+		},
+		Phase:      phase,
+		Message:    "one or more objects failed to apply",
+		FinishedAt: &finishedAt,
+	}
+	app.Status.History = argoappv1.RevisionHistories{
+		{ID: 1, Revision: "aaa", Source: app.Spec.GetSource(), Healthy: true},
+	}
+	if phase.Successful() {
+		app.Status.History = append(app.Status.History, argoappv1.RevisionHistory{ID: 2, Revision: "bbb", Source: app.Spec.GetSource()})
+	}
+	return app
+}
+
+func TestAutoRollback(t *testing.T) {
+	t.Run("failed sync is rolled back", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationFailed)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy})
+		assert.True(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionAutoRollbackWarning, cond.Type)
+		assert.Contains(t, cond.Message, "Rolled back from bbb to aaa: automated sync failed: one or more objects failed to apply")
+
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+		assert.True(t, app.Operation.InitiatedBy.Automated)
+		from, _ := autoRollbackInfo(app.Operation)
+		assert.Equal(t, "bbb", from)
+	})
+
+	t.Run("degraded application is rolled back after timeout", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationSucceeded)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		degradedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: &degradedAt})
+		assert.True(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Contains(t, cond.Message, "application remained Degraded for more than 1m0s")
+	})
+
+	t.Run("degraded application is not rolled back before timeout", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationSucceeded)
+		app.Spec.SyncPolicy.Automated.Rollback.DegradedTimeout = "1h"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		degradedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: &degradedAt})
+		assert.False(t, rolledBack)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("recently degraded application is not rolled back", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationSucceeded)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		degradedAt := metav1.NewTime(time.Now().Add(-10 * time.Second))
+		cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: &degradedAt})
+		assert.False(t, rolledBack)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("rollback is disabled", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationFailed)
+		app.Spec.SyncPolicy.Automated.Rollback = nil
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy})
+		assert.False(t, rolledBack)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("manual sync is not rolled back", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationFailed)
+		app.Status.OperationState.Operation.InitiatedBy = argoappv1.OperationInitiator{Username: "admin"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		_, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy})
+		assert.False(t, rolledBack)
+	})
+
+	t.Run("previously healthy revision is not rolled back", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationFailed)
+		app.Status.History[0].Revision = "bbb"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		_, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy})
+		assert.False(t, rolledBack)
+	})
+
+	t.Run("no healthy revision", func(t *testing.T) {
+		app := newFakeRollbackApp(synccommon.OperationFailed)
+		app.Status.History[0].Healthy = false
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy})
+		assert.False(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Equal(t, "Unable to roll back from bbb: no healthy revision in history", cond.Message)
+	})
+}
+
+func TestAutoSyncPausedAfterRollback(t *testing.T) {
+	app := newFakeRollbackApp(synccommon.OperationSucceeded)
+	app.Status.OperationState.Operation = argoappv1.Operation{
+		Sync:        &argoappv1.SyncOperation{Revision: "aaa"},
+		InitiatedBy: argoappv1.OperationInitiator{Automated: true},
+		Info:        []*argoappv1.Info{{Name: autoRollbackFromInfo, Value: "bbb"}, {Name: autoRollbackReasonInfo, Value: "automated sync failed"}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy})
+	assert.False(t, rolledBack)
+	require.NotNil(t, cond)
+	assert.Contains(t, cond.Message, "Automated sync is paused")
+
+	resources := []argoappv1.ResourceStatus{{Name: "guestbook", Kind: "Deployment", Status: argoappv1.SyncStatusCodeOutOfSync}}
+	assert.Nil(t, ctrl.autoSync(app, &argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeOutOfSync, Revision: "bbb"}, resources))
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+
+	// a new revision resumes automated sync
+	assert.Nil(t, ctrl.autoSync(app, &argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeOutOfSync, Revision: "ccc"}, resources))
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.Equal(t, "ccc", app.Operation.Sync.Revision)
+}
+
+func TestMarkHealthyRevision(t *testing.T) {
+	app := newFakeRollbackApp(synccommon.OperationSucceeded)
+	markHealthyRevision(app, &argoappv1.HealthStatus{Status: health.HealthStatusProgressing})
+	assert.False(t, app.Status.History[1].Healthy)
+	markHealthyRevision(app, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy})
+	assert.True(t, app.Status.History[1].Healthy)
+}
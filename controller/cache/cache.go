@@ -121,6 +121,8 @@ type LiveStateCache interface {
 	GetClustersInfo() []clustercache.ClusterInfo
 	// Init must be executed before cache can be used
 	Init() error
+	// Stops watching the clusters which are no longer handled by the controller
+	InvalidateUnmanagedClusters()
 }
 
 type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref v1.ObjectReference)
@@ -529,7 +531,7 @@ func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*
 		return nil, err
 	}
 	return clusterInfo.GetManagedLiveObjs(targetObjs, func(r *clustercache.Resource) bool {
-		return resInfo(r).AppName == a.Name
+		return resInfo(r).AppName == a.InstanceName(c.settingsMgr.GetNamespace())
 	})
 }
 
@@ -620,6 +622,30 @@ func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
 	return c.clusterFilter(cluster)
 }
 
+func (c *liveStateCache) InvalidateUnmanagedClusters() {
+	c.lock.RLock()
+	servers := make([]string, 0, len(c.clusters))
+	for server := range c.clusters {
+		servers = append(servers, server)
+	}
+	c.lock.RUnlock()
+
+	for _, server := range servers {
+		cluster, err := c.db.GetCluster(context.Background(), server)
+		if err != nil || c.canHandleCluster(cluster) {
+			continue
+		}
+		c.lock.Lock()
+		clusterCache, ok := c.clusters[server]
+		delete(c.clusters, server)
+		c.lock.Unlock()
+		if ok {
+			log.Infof("Stopped watching cluster %s which is no longer handled by this shard", server)
+			clusterCache.Invalidate()
+		}
+	}
+}
+
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
 	if !c.canHandleCluster(cluster) {
 		log.Infof("Ignoring cluster %s", cluster.Server)
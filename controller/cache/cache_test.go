@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
 )
 
 type netError string
@@ -106,6 +107,32 @@ func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	assert.Len(t, clustersCache.clusters, 0)
 }
 
+func TestInvalidateUnmanagedClusters(t *testing.T) {
+	unmanagedCache := &mocks.ClusterCache{}
+	unmanagedCache.On("Invalidate").Return(nil).Once()
+	managedCache := &mocks.ClusterCache{}
+	managedCache.On("Invalidate").Panic("should not invalidate")
+
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("GetCluster", mock.Anything, "https://unmanaged").Return(&appv1.Cluster{Server: "https://unmanaged"}, nil)
+	argoDB.On("GetCluster", mock.Anything, "https://managed").Return(&appv1.Cluster{Server: "https://managed"}, nil)
+	clustersCache := liveStateCache{
+		db: argoDB,
+		clusters: map[string]cache.ClusterCache{
+			"https://unmanaged": unmanagedCache,
+			"https://managed":   managedCache,
+		},
+		clusterFilter: func(cluster *appv1.Cluster) bool {
+			return cluster.Server == "https://managed"
+		},
+	}
+	clustersCache.InvalidateUnmanagedClusters()
+
+	assert.Len(t, clustersCache.clusters, 1)
+	assert.Contains(t, clustersCache.clusters, "https://managed")
+	unmanagedCache.AssertExpectations(t)
+}
+
 func TestIsRetryableError(t *testing.T) {
 	var (
 		tlsHandshakeTimeoutErr net.Error = netError("net/http: TLS handshake timeout")
@@ -154,6 +154,11 @@ func (_m *LiveStateCache) Init() error {
 	return r0
 }
 
+// InvalidateUnmanagedClusters provides a mock function with given fields:
+func (_m *LiveStateCache) InvalidateUnmanagedClusters() {
+	_m.Called()
+}
+
 // IsNamespaced provides a mock function with given fields: server, gk
 func (_m *LiveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool, error) {
 	ret := _m.Called(server, gk)
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// healthVerificationDuration returns the amount of time the health of the application is verified after a sync
+func healthVerificationDuration(app *appv1.Application) time.Duration {
+	if app.Spec.SyncPolicy == nil {
+		return 0
+	}
+	duration, err := app.Spec.SyncPolicy.HealthVerification.GetDuration()
+	if err != nil {
+		log.WithField("application", app.QualifiedName()).Warnf("Skipping health verification: %v", err)
+		return 0
+	}
+	return duration
+}
+
+// startHealthVerification keeps the given successfully completed sync operation running while the health of the
+// application is verified, if the sync policy of the application requires it. Returns whether the verification started.
+func (ctrl *ApplicationController) startHealthVerification(app *appv1.Application, state *appv1.OperationState) bool {
+	if state.Phase != synccommon.OperationSucceeded || state.Operation.Sync == nil || state.Operation.Sync.DryRun {
+		return false
+	}
+	duration := healthVerificationDuration(app)
+	if duration <= 0 {
+		return false
+	}
+	now := metav1.Now()
+	state.Phase = synccommon.OperationRunning
+	state.Message = fmt.Sprintf("Verifying application health for %v", duration)
+	state.HealthVerificationStartedAt = &now
+	ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &duration)
+	return true
+}
+
+// verifyHealth fails the given operation if the aggregated health of the application turned Degraded since the
+// verification started, and completes it successfully once the verification period elapsed. The health is only judged
+// once the application has been reconciled after the verification started, since the status may still hold the health
+// from before the sync. Returns whether the operation has been completed.
+func (ctrl *ApplicationController) verifyHealth(app *appv1.Application, state *appv1.OperationState, terminating bool) bool {
+	duration := healthVerificationDuration(app)
+	elapsed := time.Since(state.HealthVerificationStartedAt.Time)
+	switch {
+	case terminating:
+		state.Phase = synccommon.OperationFailed
+		state.Message = "Operation terminated during health verification"
+	case app.Status.ReconciledAt == nil || !app.Status.ReconciledAt.After(state.HealthVerificationStartedAt.Time):
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), nil)
+		return false
+	case app.Status.Health.Status == health.HealthStatusDegraded:
+		state.Phase = synccommon.OperationFailed
+		state.Message = fmt.Sprintf("Application became Degraded %v after the sync", elapsed.Round(time.Second))
+		if resources := degradedResources(app.Status.Resources); len(resources) > 0 {
+			state.Message = fmt.Sprintf("%s: %s", state.Message, strings.Join(resources, ", "))
+		}
+	case elapsed >= duration:
+		state.Phase = synccommon.OperationSucceeded
+		state.Message = fmt.Sprintf("successfully synced and verified application health for %v", duration)
+	default:
+		retryAfter := duration - elapsed
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
+		return false
+	}
+	return true
+}
+
+// degradedResources returns the descriptions of the given resources which are Degraded
+func degradedResources(resources []appv1.ResourceStatus) []string {
+	var degraded []string
+	for _, res := range resources {
+		if res.Health == nil || res.Health.Status != health.HealthStatusDegraded {
+			continue
+		}
+		description := fmt.Sprintf("%s/%s is Degraded", res.Kind, res.Name)
+		if res.Health.Message != "" {
+			description = fmt.Sprintf("%s (%s)", description, res.Health.Message)
+		}
+		degraded = append(degraded, description)
+	}
+	return degraded
+}
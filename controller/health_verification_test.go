@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+)
+
+// newFakeVerifyingApp returns an application whose sync completed the given amount of time ago, whose health is
+// verified for one minute, and which has been reconciled since
+func newFakeVerifyingApp(startedAgo time.Duration, healthStatus health.HealthStatusCode) *argoappv1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.HealthVerification = &argoappv1.SyncHealthVerification{Duration: "1m"}
+	app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}
+	startedAt := metav1.NewTime(time.Now().Add(-startedAgo))
+	reconciledAt := metav1.Now()
+	app.Status.ReconciledAt = &reconciledAt
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation:                   *app.Operation,
+		Phase:                       synccommon.OperationRunning,
+		Message:                     "Verifying application health for 1m0s",
+		HealthVerificationStartedAt: &startedAt,
+	}
+	app.Status.Health = argoappv1.HealthStatus{Status: healthStatus}
+	app.Status.Resources = []argoappv1.ResourceStatus{{
+		Kind:   "Deployment",
+		Name:   "guestbook-ui",
+		Health: &argoappv1.HealthStatus{Status: healthStatus, Message: "Deployment has crash looping pods"},
+	}}
+	return app
+}
+
+func processVerifyingApp(t *testing.T, app *argoappv1.Application) map[string]interface{} {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var receivedPatch map[string]interface{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+	ctrl.processRequestedAppOperation(app)
+	return receivedPatch
+}
+
+func TestProcessRequestedAppOperation_HealthVerification(t *testing.T) {
+	t.Run("degraded application fails the operation", func(t *testing.T) {
+		patch := processVerifyingApp(t, newFakeVerifyingApp(30*time.Second, health.HealthStatusDegraded))
+		phase, _, _ := unstructured.NestedString(patch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+		message, _, _ := unstructured.NestedString(patch, "status", "operationState", "message")
+		assert.Equal(t, "Application became Degraded 30s after the sync: Deployment/guestbook-ui is Degraded (Deployment has crash looping pods)", message)
+	})
+
+	t.Run("degraded health from before the sync keeps the operation running", func(t *testing.T) {
+		app := newFakeVerifyingApp(30*time.Second, health.HealthStatusDegraded)
+		reconciledAt := metav1.NewTime(time.Now().Add(-time.Minute))
+		app.Status.ReconciledAt = &reconciledAt
+		patch := processVerifyingApp(t, app)
+		assert.Nil(t, patch)
+	})
+
+	t.Run("healthy application completes the operation after the verification period", func(t *testing.T) {
+		patch := processVerifyingApp(t, newFakeVerifyingApp(2*time.Minute, health.HealthStatusHealthy))
+		phase, _, _ := unstructured.NestedString(patch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+		message, _, _ := unstructured.NestedString(patch, "status", "operationState", "message")
+		assert.Equal(t, "successfully synced and verified application health for 1m0s", message)
+	})
+
+	t.Run("healthy application keeps the operation running during the verification period", func(t *testing.T) {
+		patch := processVerifyingApp(t, newFakeVerifyingApp(30*time.Second, health.HealthStatusHealthy))
+		assert.Nil(t, patch)
+	})
+
+	t.Run("terminated verification fails the operation", func(t *testing.T) {
+		app := newFakeVerifyingApp(30*time.Second, health.HealthStatusHealthy)
+		app.Status.OperationState.Phase = synccommon.OperationTerminating
+		patch := processVerifyingApp(t, app)
+		phase, _, _ := unstructured.NestedString(patch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+	})
+}
+
+func TestStartHealthVerification(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	newState := func() *argoappv1.OperationState {
+		return &argoappv1.OperationState{
+			Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+			Phase:     synccommon.OperationSucceeded,
+			Message:   "successfully synced (all tasks run)",
+		}
+	}
+
+	state := newState()
+	assert.False(t, ctrl.startHealthVerification(app, state))
+	assert.Equal(t, synccommon.OperationSucceeded, state.Phase)
+
+	app.Spec.SyncPolicy.HealthVerification = &argoappv1.SyncHealthVerification{Duration: "2m"}
+	state = newState()
+	assert.True(t, ctrl.startHealthVerification(app, state))
+	assert.Equal(t, synccommon.OperationRunning, state.Phase)
+	assert.Equal(t, "Verifying application health for 2m0s", state.Message)
+	assert.NotNil(t, state.HealthVerificationStartedAt)
+
+	state = newState()
+	state.Operation.Sync.DryRun = true
+	assert.False(t, ctrl.startHealthVerification(app, state))
+
+	state = newState()
+	state.Phase = synccommon.OperationFailed
+	assert.False(t, ctrl.startHealthVerification(app, state))
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// hydrateManifests commits the manifests rendered from the given source to the hydration branch of the application,
+// and returns the target objects generated from the hydrated commit together with the resulting hydration status. The
+// manifests are not committed again as long as neither the dry revision nor the application spec has changed since the
+// last hydration, unless the refresh ignores the caches.
+func (m *appStateManager) hydrateManifests(app *appv1.Application, project *appv1.AppProject, source appv1.ApplicationSource, manifestInfo *apiclient.ManifestResponse, appLabelKey string, noCache, noRevisionCache bool) ([]*unstructured.Unstructured, *appv1.HydrationStatus, error) {
+	hydration := app.Spec.Hydration
+	if app.Spec.HasMultipleSources() {
+		return nil, nil, fmt.Errorf("hydration is not supported for applications with multiple sources")
+	}
+	if !project.IsHydrationTargetBranchPermitted(hydration.TargetBranch) {
+		return nil, nil, fmt.Errorf("hydration to branch %s is not permitted in project '%s'", hydration.TargetBranch, project.Name)
+	}
+
+	path := hydration.GetPath(source)
+	var hydratedSHA string
+	var err error
+	if prev := app.Status.Hydration; !noCache && prev != nil && prev.DrySHA == manifestInfo.Revision &&
+		prev.TargetBranch == hydration.TargetBranch && prev.Path == path && prev.HydratedSHA != "" &&
+		source.Equals(app.Status.Sync.ComparedTo.Source) && app.Spec.Destination.Equals(app.Status.Sync.ComparedTo.Destination) {
+		hydratedSHA = prev.HydratedSHA
+	} else {
+		hydratedSHA, err = m.commitHydratedManifests(app, source, manifestInfo, path)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// the application is synced from the hydrated commit, which holds plain manifests
+	hydratedSource := appv1.ApplicationSource{
+		RepoURL:        source.RepoURL,
+		Path:           path,
+		TargetRevision: hydratedSHA,
+		Directory:      &appv1.ApplicationSourceDirectory{},
+	}
+	targetObjs, _, err := m.getRepoObjs(app, []appv1.ApplicationSource{hydratedSource}, appLabelKey, nil, noCache, noRevisionCache, false, false, false, project)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate manifests from hydrated revision %s: %w", hydratedSHA, err)
+	}
+
+	status := &appv1.HydrationStatus{
+		DrySHA:       manifestInfo.Revision,
+		HydratedSHA:  hydratedSHA,
+		TargetBranch: hydration.TargetBranch,
+		Path:         path,
+	}
+	// the time of the hydration only changes with the hydrated revision, to not update the status on every refresh
+	if prev := app.Status.Hydration; prev != nil && prev.HydratedSHA == status.HydratedSHA {
+		status.HydratedAt = prev.HydratedAt
+	} else {
+		now := metav1.Now()
+		status.HydratedAt = &now
+		log.WithField("application", app.QualifiedName()).Infof("Hydrated revision %s to %s", status.DrySHA, status.HydratedSHA)
+	}
+	return targetObjs, status, nil
+}
+
+// commitHydratedManifests commits the given rendered manifests to the hydration branch of the application, and returns
+// the revision of the branch holding them
+func (m *appStateManager) commitHydratedManifests(app *appv1.Application, source appv1.ApplicationSource, manifestInfo *apiclient.ManifestResponse, path string) (string, error) {
+	repo, err := m.db.GetRepository(context.Background(), source.RepoURL)
+	if err != nil {
+		return "", err
+	}
+	conn, repoClient, err := m.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return "", err
+	}
+	defer io.Close(conn)
+
+	res, err := repoClient.HydrateManifests(context.Background(), &apiclient.HydrateManifestsRequest{
+		Repo:         repo,
+		AppName:      app.InstanceName(m.namespace),
+		DrySource:    &source,
+		DrySHA:       manifestInfo.Revision,
+		TargetBranch: app.Spec.Hydration.TargetBranch,
+		Path:         path,
+		Manifests:    manifestInfo.Manifests,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hydrate manifests to branch %s: %w", app.Spec.Hydration.TargetBranch, err)
+	}
+	return res.HydratedSHA, nil
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	mockrepoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func TestCompareAppState_Hydration(t *testing.T) {
+	newData := func() *fakeData {
+		return &fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook"}}`},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			hydrateResponse: &apiclient.HydrateManifestsResponse{HydratedSHA: "def456"},
+		}
+	}
+
+	proj := defaultProj.DeepCopy()
+	proj.Spec.HydrationTargetBranches = []string{"env/*"}
+
+	t.Run("Hydrated", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Hydration = &argoappv1.ApplicationHydration{TargetBranch: "env/prod"}
+		ctrl := newFakeController(newData())
+		compRes := ctrl.appStateManager.CompareAppState(app, proj, nil, app.Spec.GetSources(), false, false, nil, false)
+		require.NotNil(t, compRes.hydrationStatus)
+		assert.Equal(t, "abc123", compRes.hydrationStatus.DrySHA)
+		assert.Equal(t, "def456", compRes.hydrationStatus.HydratedSHA)
+		assert.Equal(t, "env/prod", compRes.hydrationStatus.TargetBranch)
+		assert.Equal(t, app.Spec.Source.Path, compRes.hydrationStatus.Path)
+		assert.NotNil(t, compRes.hydrationStatus.HydratedAt)
+		assert.Len(t, compRes.managedResources, 1)
+
+		repoClient := ctrl.appStateManager.(*appStateManager).repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+		repoClient.AssertCalled(t, "HydrateManifests", mock.Anything, mock.MatchedBy(func(req *apiclient.HydrateManifestsRequest) bool {
+			return req.DrySHA == "abc123" && req.TargetBranch == "env/prod" && len(req.Manifests) == 1
+		}))
+		// the target state is generated from the hydrated revision
+		repoClient.AssertCalled(t, "GenerateManifest", mock.Anything, mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+			return req.Revision == "def456" && req.ApplicationSource.Directory != nil
+		}))
+	})
+	t.Run("DryRevisionAlreadyHydrated", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Hydration = &argoappv1.ApplicationHydration{TargetBranch: "env/prod"}
+		app.Status.Hydration = &argoappv1.HydrationStatus{DrySHA: "abc123", HydratedSHA: "def000", TargetBranch: "env/prod", Path: app.Spec.Source.Path}
+		app.Status.Sync.ComparedTo = argoappv1.ComparedTo{Source: app.Spec.GetSource(), Destination: app.Spec.Destination}
+		ctrl := newFakeController(newData())
+		compRes := ctrl.appStateManager.CompareAppState(app, proj, nil, app.Spec.GetSources(), false, false, nil, false)
+		require.NotNil(t, compRes.hydrationStatus)
+		assert.Equal(t, "def000", compRes.hydrationStatus.HydratedSHA)
+		repoClient := ctrl.appStateManager.(*appStateManager).repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+		repoClient.AssertNotCalled(t, "HydrateManifests", mock.Anything, mock.Anything)
+		repoClient.AssertCalled(t, "GenerateManifest", mock.Anything, mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+			return req.Revision == "def000"
+		}))
+
+		// the manifests are committed again once the application spec changes
+		app.Spec.Source.Path = "other"
+		ctrl = newFakeController(newData())
+		compRes = ctrl.appStateManager.CompareAppState(app, proj, nil, app.Spec.GetSources(), false, false, nil, false)
+		require.NotNil(t, compRes.hydrationStatus)
+		assert.Equal(t, "def456", compRes.hydrationStatus.HydratedSHA)
+	})
+	t.Run("BranchNotPermitted", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Hydration = &argoappv1.ApplicationHydration{TargetBranch: "env/prod"}
+		ctrl := newFakeController(newData())
+		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
+		assert.Nil(t, compRes.hydrationStatus)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, "hydration to branch env/prod is not permitted in project 'default'", app.Status.Conditions[0].Message)
+		repoClient := ctrl.appStateManager.(*appStateManager).repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+		repoClient.AssertNotCalled(t, "HydrateManifests", mock.Anything, mock.Anything)
+	})
+	t.Run("KeepHydratedAt", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Hydration = &argoappv1.ApplicationHydration{TargetBranch: "env/prod", Path: "hydrated"}
+		hydratedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+		app.Status.Hydration = &argoappv1.HydrationStatus{HydratedSHA: "def456", HydratedAt: &hydratedAt}
+		ctrl := newFakeController(newData())
+		compRes := ctrl.appStateManager.CompareAppState(app, proj, nil, app.Spec.GetSources(), false, false, nil, false)
+		require.NotNil(t, compRes.hydrationStatus)
+		assert.Equal(t, "hydrated", compRes.hydrationStatus.Path)
+		assert.Equal(t, &hydratedAt, compRes.hydrationStatus.HydratedAt)
+	})
+	t.Run("NotHydrated", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData())
+		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
+		assert.Nil(t, compRes.hydrationStatus)
+		repoClient := ctrl.appStateManager.(*appStateManager).repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+		repoClient.AssertNotCalled(t, "HydrateManifests", mock.Anything, mock.Anything)
+	})
+}
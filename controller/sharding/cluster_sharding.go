@@ -0,0 +1,285 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/db"
+)
+
+const (
+	// DefaultHeartbeatInterval is the default interval at which replicas record their heartbeat and rebalance clusters
+	DefaultHeartbeatInterval = 10 * time.Second
+	// heartbeatTimeoutFactor is the number of heartbeat intervals after which a replica which did not record its
+	// heartbeat is considered to be gone
+	heartbeatTimeoutFactor = 3
+	// rebalanceHoldFactor is the number of heartbeat intervals a changed distribution of the clusters has to remain
+	// the same before it is applied, so that short-lived changes do not move clusters back and forth between replicas
+	rebalanceHoldFactor = 3
+)
+
+// ClusterInfoGetter returns the cached info of a cluster
+type ClusterInfoGetter interface {
+	GetClusterInfo(server string, res *v1alpha1.ClusterInfo) error
+}
+
+// ClusterSharding distributes clusters across the replicas of the application controller. The distribution is
+// periodically recomputed, so that it follows added and removed clusters, changing cluster weights and, if dynamic
+// cluster distribution is enabled, replicas which are added or removed.
+type ClusterSharding struct {
+	db          db.ArgoDB
+	clusterInfo ClusterInfoGetter
+	kubeClient  kubernetes.Interface
+	namespace   string
+	hostname    string
+	algorithm   string
+	// dynamic indicates whether the number of replicas and the shard of this replica are discovered using heartbeats
+	dynamic           bool
+	heartbeatInterval time.Duration
+	now               func() time.Time
+
+	lock         sync.RWMutex
+	replicas     int
+	shard        int
+	distribution DistributionFunction
+	clusters     map[string]bool
+	// pending is the changed distribution which is applied once it has remained the same for the hold time
+	pending *pendingDistribution
+}
+
+// pendingDistribution is a changed distribution of the clusters which has not been applied yet
+type pendingDistribution struct {
+	replicas int
+	shard    int
+	clusters map[string]bool
+	since    time.Time
+}
+
+// NewClusterSharding returns a cluster sharding for the given replica. If dynamic cluster distribution is enabled,
+// the given number of replicas and shard are ignored, and discovered using the shared heartbeat ConfigMap instead.
+func NewClusterSharding(
+	argoDB db.ArgoDB,
+	clusterInfo ClusterInfoGetter,
+	kubeClient kubernetes.Interface,
+	namespace string,
+	algorithm string,
+	replicas int,
+	shard int,
+	dynamic bool,
+	heartbeatInterval time.Duration,
+) (*ClusterSharding, error) {
+	if _, err := GetDistributionFunction(algorithm, nil, replicas); err != nil {
+		return nil, err
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = DefaultHeartbeatInterval
+	}
+	return &ClusterSharding{
+		db:                argoDB,
+		clusterInfo:       clusterInfo,
+		kubeClient:        kubeClient,
+		namespace:         namespace,
+		hostname:          hostname,
+		algorithm:         algorithm,
+		dynamic:           dynamic,
+		heartbeatInterval: heartbeatInterval,
+		now:               time.Now,
+		replicas:          replicas,
+		shard:             shard,
+	}, nil
+}
+
+// IsManagedCluster returns whether the given cluster is handled by this replica
+func (s *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.distribution == nil {
+		return false
+	}
+	return s.distribution(c) == s.shard
+}
+
+// Init computes the initial distribution of the clusters, and must be executed before the sharding can be used
+func (s *ClusterSharding) Init(ctx context.Context) error {
+	_, err := s.rebalance(ctx)
+	return err
+}
+
+// Run periodically recomputes the distribution of the clusters, and invokes the given handler whenever the clusters
+// handled by this replica changed. A changed distribution is only applied once it remained the same for
+// rebalanceHoldFactor heartbeat intervals.
+func (s *ClusterSharding) Run(ctx context.Context, onChange func()) {
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := s.rebalance(ctx)
+			if err != nil {
+				log.Warnf("Failed to rebalance clusters: %v", err)
+				continue
+			}
+			if changed && onChange != nil {
+				onChange()
+			}
+		}
+	}
+}
+
+// rebalance recomputes the distribution of the clusters, and returns whether the clusters handled by this replica changed.
+// The initial distribution is applied immediately, while changes are held until they remained the same for the hold time.
+func (s *ClusterSharding) rebalance(ctx context.Context) (bool, error) {
+	s.lock.RLock()
+	replicas, shard := s.replicas, s.shard
+	s.lock.RUnlock()
+	if s.dynamic {
+		var err error
+		if replicas, shard, err = s.heartbeat(ctx); err != nil {
+			return false, fmt.Errorf("error recording heartbeat: %w", err)
+		}
+	}
+
+	clusterList, err := s.db.ListClusters(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error listing clusters: %w", err)
+	}
+	clusters := clusterList.Items
+	if s.clusterInfo != nil && (s.algorithm == WeightedAppsShardingAlgorithm || s.algorithm == WeightedResourcesShardingAlgorithm) {
+		for i := range clusters {
+			_ = s.clusterInfo.GetClusterInfo(clusters[i].Server, &clusters[i].Info)
+		}
+	}
+	distribution, err := GetDistributionFunction(s.algorithm, clusters, replicas)
+	if err != nil {
+		return false, err
+	}
+	managed := map[string]bool{}
+	for i := range clusters {
+		if distribution(&clusters[i]) == shard {
+			managed[clusters[i].Server] = true
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	changed := s.distribution != nil && (s.replicas != replicas || s.shard != shard || !sameClusters(s.clusters, managed))
+	if changed {
+		now := s.now()
+		if s.pending == nil || s.pending.replicas != replicas || s.pending.shard != shard || !sameClusters(s.pending.clusters, managed) {
+			s.pending = &pendingDistribution{replicas: replicas, shard: shard, clusters: managed, since: now}
+		}
+		if now.Sub(s.pending.since) < rebalanceHoldFactor*s.heartbeatInterval {
+			return false, nil
+		}
+	}
+	if s.distribution == nil || changed {
+		log.Infof("Processing %d clusters from shard %d of %d replicas using the %s sharding algorithm", len(managed), shard, replicas, s.algorithm)
+	}
+	s.replicas, s.shard, s.distribution, s.clusters, s.pending = replicas, shard, distribution, managed, nil
+	return changed, nil
+}
+
+func sameClusters(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for server := range a {
+		if !b[server] {
+			return false
+		}
+	}
+	return true
+}
+
+// heartbeat records the heartbeat of this replica in the shared heartbeat ConfigMap, drops the heartbeats of replicas
+// which are gone, and returns the number of live replicas and the shard of this replica. Shards are assigned to the
+// live replicas in the order of their StatefulSet ordinal.
+func (s *ClusterSharding) heartbeat(ctx context.Context) (int, int, error) {
+	var replicas []string
+	configMaps := s.kubeClient.CoreV1().ConfigMaps(s.namespace)
+	err := retry.OnError(retry.DefaultBackoff, func(err error) bool {
+		return apierr.IsConflict(err) || apierr.IsAlreadyExists(err)
+	}, func() error {
+		cm, err := configMaps.Get(ctx, common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		exists := err == nil
+		if err != nil {
+			if !apierr.IsNotFound(err) {
+				return err
+			}
+			cm = &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.ArgoCDAppControllerShardConfigMapName,
+					Namespace: s.namespace,
+					Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+				},
+			}
+		}
+		now := s.now()
+		data := map[string]string{s.hostname: now.UTC().Format(time.RFC3339)}
+		replicas = []string{s.hostname}
+		for hostname, value := range cm.Data {
+			if hostname == s.hostname {
+				continue
+			}
+			heartbeat, err := time.Parse(time.RFC3339, value)
+			if err != nil || now.Sub(heartbeat) > heartbeatTimeoutFactor*s.heartbeatInterval {
+				continue
+			}
+			data[hostname] = value
+			replicas = append(replicas, hostname)
+		}
+		cm.Data = data
+		if !exists {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		return err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	sortReplicas(replicas)
+	for shard, hostname := range replicas {
+		if hostname == s.hostname {
+			return len(replicas), shard, nil
+		}
+	}
+	return len(replicas), 0, nil
+}
+
+// sortReplicas orders the given replica hostnames by their StatefulSet ordinal, and by name if they have none
+func sortReplicas(replicas []string) {
+	sort.Slice(replicas, func(i, j int) bool {
+		ordinalI, errI := parseShard(replicas[i])
+		ordinalJ, errJ := parseShard(replicas[j])
+		switch {
+		case errI == nil && errJ == nil && ordinalI != ordinalJ:
+			return ordinalI < ordinalJ
+		case errI == nil && errJ != nil:
+			return true
+		case errI != nil && errJ == nil:
+			return false
+		}
+		return replicas[i] < replicas[j]
+	})
+}
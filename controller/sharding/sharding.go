@@ -4,17 +4,47 @@ import (
 	"fmt"
 	"hash/fnv"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
+const (
+	// LegacyShardingAlgorithm assigns clusters to shards by the hash of the cluster ID
+	LegacyShardingAlgorithm = "legacy"
+	// RoundRobinShardingAlgorithm assigns the clusters, ordered by their ID, to the shards in turn
+	RoundRobinShardingAlgorithm = "round-robin"
+	// WeightedAppsShardingAlgorithm balances the number of applications handled by each shard
+	WeightedAppsShardingAlgorithm = "weighted-apps"
+	// WeightedResourcesShardingAlgorithm balances the number of cached Kubernetes resources handled by each shard
+	WeightedResourcesShardingAlgorithm = "weighted-resources"
+	// DefaultShardingAlgorithm is the sharding algorithm used if none is configured
+	DefaultShardingAlgorithm = LegacyShardingAlgorithm
+)
+
+// ShardingAlgorithms contains all supported sharding algorithms
+var ShardingAlgorithms = []string{
+	LegacyShardingAlgorithm,
+	RoundRobinShardingAlgorithm,
+	WeightedAppsShardingAlgorithm,
+	WeightedResourcesShardingAlgorithm,
+}
+
+// DistributionFunction returns the shard which handles the given cluster
+type DistributionFunction func(c *v1alpha1.Cluster) int
+
 func InferShard() (int, error) {
 	hostname, err := os.Hostname()
 	if err != nil {
 		return 0, err
 	}
+	return parseShard(hostname)
+}
+
+// parseShard returns the shard number the given hostname ends with
+func parseShard(hostname string) (int, error) {
 	parts := strings.Split(hostname, "-")
 	if len(parts) == 0 {
 		return 0, fmt.Errorf("hostname should ends with shard number separated by '-' but got: %s", hostname)
@@ -28,7 +58,7 @@ func InferShard() (int, error) {
 
 // GetShardByID calculates cluster shard as `clusterSecret.UID % replicas count`
 func GetShardByID(id string, replicas int) int {
-	if id == "" {
+	if id == "" || replicas < 1 {
 		return 0
 	} else {
 		h := fnv.New32a()
@@ -37,17 +67,105 @@ func GetShardByID(id string, replicas int) int {
 	}
 }
 
-func GetClusterFilter(replicas int, shard int) func(c *v1alpha1.Cluster) bool {
-	return func(c *v1alpha1.Cluster) bool {
-		clusterShard := 0
+// GetDistributionFunction returns a function which distributes the given clusters across the given number of replicas
+// using the given sharding algorithm. Clusters with an explicitly configured shard are always assigned to that shard,
+// and clusters which are not part of the given list fall back to the legacy algorithm. The weighted algorithms expect
+// the info of the given clusters to be populated.
+func GetDistributionFunction(algorithm string, clusters []v1alpha1.Cluster, replicas int) (DistributionFunction, error) {
+	if replicas < 1 {
+		replicas = 1
+	}
+	var shards map[string]int
+	switch algorithm {
+	case LegacyShardingAlgorithm, "":
+	case RoundRobinShardingAlgorithm:
+		shards = getRoundRobinShards(clusters, replicas)
+	case WeightedAppsShardingAlgorithm:
+		shards = getWeightedShards(clusters, replicas, func(c *v1alpha1.Cluster) int64 {
+			return c.Info.ApplicationsCount
+		})
+	case WeightedResourcesShardingAlgorithm:
+		shards = getWeightedShards(clusters, replicas, func(c *v1alpha1.Cluster) int64 {
+			return c.Info.CacheInfo.ResourcesCount
+		})
+	default:
+		return nil, fmt.Errorf("unknown sharding algorithm '%s', must be one of: %s", algorithm, strings.Join(ShardingAlgorithms, ", "))
+	}
+	return func(c *v1alpha1.Cluster) int {
 		//  cluster might be nil if app is using invalid cluster URL, assume shard 0 in this case.
-		if c != nil {
-			if c.Shard != nil {
-				clusterShard = int(*c.Shard)
-			} else {
-				clusterShard = GetShardByID(c.ID, replicas)
+		if c == nil {
+			return 0
+		}
+		if c.Shard != nil {
+			return int(*c.Shard)
+		}
+		if shard, ok := shards[c.Server]; ok {
+			return shard
+		}
+		return GetShardByID(c.ID, replicas)
+	}, nil
+}
+
+// unassignedClusters returns the clusters which have no explicitly configured shard, ordered by their ID
+func unassignedClusters(clusters []v1alpha1.Cluster) []*v1alpha1.Cluster {
+	var res []*v1alpha1.Cluster
+	for i := range clusters {
+		if clusters[i].Shard == nil {
+			res = append(res, &clusters[i])
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].ID != res[j].ID {
+			return res[i].ID < res[j].ID
+		}
+		return res[i].Server < res[j].Server
+	})
+	return res
+}
+
+// getRoundRobinShards assigns the given clusters to the shards in turn, and returns the shards by cluster server URL
+func getRoundRobinShards(clusters []v1alpha1.Cluster, replicas int) map[string]int {
+	shards := map[string]int{}
+	for i, c := range unassignedClusters(clusters) {
+		shards[c.Server] = i % replicas
+	}
+	return shards
+}
+
+// getWeightedShards assigns the heaviest remaining cluster to the least loaded shard until all of the given clusters
+// are assigned, and returns the shards by cluster server URL. Clusters with an explicitly configured shard count towards
+// the load of their shard.
+func getWeightedShards(clusters []v1alpha1.Cluster, replicas int, weight func(c *v1alpha1.Cluster) int64) map[string]int {
+	loads := make([]int64, replicas)
+	counts := make([]int, replicas)
+	for i := range clusters {
+		if shard := clusters[i].Shard; shard != nil && *shard >= 0 && *shard < int64(replicas) {
+			loads[*shard] += weight(&clusters[i])
+			counts[*shard]++
+		}
+	}
+	unassigned := unassignedClusters(clusters)
+	sort.SliceStable(unassigned, func(i, j int) bool {
+		return weight(unassigned[i]) > weight(unassigned[j])
+	})
+	shards := map[string]int{}
+	for _, c := range unassigned {
+		shard := 0
+		for i := 1; i < replicas; i++ {
+			if loads[i] < loads[shard] || loads[i] == loads[shard] && counts[i] < counts[shard] {
+				shard = i
 			}
 		}
-		return clusterShard == shard
+		shards[c.Server] = shard
+		loads[shard] += weight(c)
+		counts[shard]++
+	}
+	return shards
+}
+
+func GetClusterFilter(replicas int, shard int) func(c *v1alpha1.Cluster) bool {
+	distributionFunction, _ := GetDistributionFunction(LegacyShardingAlgorithm, nil, replicas)
+	return func(c *v1alpha1.Cluster) bool {
+		return distributionFunction(c) == shard
 	}
 }
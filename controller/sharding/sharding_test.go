@@ -1,11 +1,21 @@
 package sharding
 
 import (
+	"context"
 	"testing"
-
-	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
 )
 
 func TestGetShardByID_NotEmptyID(t *testing.T) {
@@ -27,3 +37,188 @@ func TestGetClusterFilter(t *testing.T) {
 	assert.False(t, filter(&v1alpha1.Cluster{ID: "3"}))
 	assert.True(t, filter(&v1alpha1.Cluster{ID: "4"}))
 }
+
+func TestGetClusterFilter_ExplicitShard(t *testing.T) {
+	filter := GetClusterFilter(2, 1)
+	assert.True(t, filter(&v1alpha1.Cluster{ID: "1", Shard: pointer.Int64Ptr(1)}))
+	assert.False(t, filter(&v1alpha1.Cluster{ID: "2", Shard: pointer.Int64Ptr(0)}))
+	assert.False(t, filter(nil))
+}
+
+func TestGetDistributionFunction_UnknownAlgorithm(t *testing.T) {
+	_, err := GetDistributionFunction("random", nil, 2)
+	assert.EqualError(t, err, "unknown sharding algorithm 'random', must be one of: legacy, round-robin, weighted-apps, weighted-resources")
+}
+
+func TestGetDistributionFunction_RoundRobin(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{ID: "4", Server: "https://d"},
+		{ID: "1", Server: "https://a"},
+		{ID: "3", Server: "https://c", Shard: pointer.Int64Ptr(1)},
+		{ID: "2", Server: "https://b"},
+	}
+	distributionFunction, err := GetDistributionFunction(RoundRobinShardingAlgorithm, clusters, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, distributionFunction(&clusters[1]))
+	assert.Equal(t, 1, distributionFunction(&clusters[3]))
+	assert.Equal(t, 0, distributionFunction(&clusters[0]))
+	assert.Equal(t, 1, distributionFunction(&clusters[2]))
+	// unknown clusters fall back to the legacy algorithm
+	assert.Equal(t, GetShardByID("5", 2), distributionFunction(&v1alpha1.Cluster{ID: "5", Server: "https://e"}))
+}
+
+func TestGetDistributionFunction_Weighted(t *testing.T) {
+	newCluster := func(id string, apps int64, resources int64) v1alpha1.Cluster {
+		return v1alpha1.Cluster{ID: id, Server: "https://" + id, Info: v1alpha1.ClusterInfo{
+			ApplicationsCount: apps,
+			CacheInfo:         v1alpha1.ClusterCacheInfo{ResourcesCount: resources},
+		}}
+	}
+	clusters := []v1alpha1.Cluster{
+		newCluster("a", 100, 10),
+		newCluster("b", 60, 40),
+		newCluster("c", 50, 30),
+		newCluster("d", 10, 20),
+	}
+
+	distributionFunction, err := GetDistributionFunction(WeightedAppsShardingAlgorithm, clusters, 2)
+	require.NoError(t, err)
+	shards := make([]int, len(clusters))
+	for i := range clusters {
+		shards[i] = distributionFunction(&clusters[i])
+	}
+	assert.Equal(t, []int{0, 1, 1, 0}, shards)
+
+	distributionFunction, err = GetDistributionFunction(WeightedResourcesShardingAlgorithm, clusters, 2)
+	require.NoError(t, err)
+	for i := range clusters {
+		shards[i] = distributionFunction(&clusters[i])
+	}
+	assert.Equal(t, []int{0, 0, 1, 1}, shards)
+
+	t.Run("explicit shard counts towards shard load", func(t *testing.T) {
+		clusters := []v1alpha1.Cluster{newCluster("a", 100, 0), newCluster("b", 10, 0), newCluster("c", 10, 0)}
+		clusters[0].Shard = pointer.Int64Ptr(0)
+		distributionFunction, err := GetDistributionFunction(WeightedAppsShardingAlgorithm, clusters, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 1, distributionFunction(&clusters[1]))
+		assert.Equal(t, 1, distributionFunction(&clusters[2]))
+	})
+
+	t.Run("clusters without weight are spread evenly", func(t *testing.T) {
+		clusters := []v1alpha1.Cluster{newCluster("a", 0, 0), newCluster("b", 0, 0), newCluster("c", 0, 0), newCluster("d", 0, 0)}
+		distributionFunction, err := GetDistributionFunction(WeightedAppsShardingAlgorithm, clusters, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 0, distributionFunction(&clusters[0]))
+		assert.Equal(t, 1, distributionFunction(&clusters[1]))
+		assert.Equal(t, 0, distributionFunction(&clusters[2]))
+		assert.Equal(t, 1, distributionFunction(&clusters[3]))
+	})
+}
+
+func TestSortReplicas(t *testing.T) {
+	replicas := []string{"argocd-application-controller-10", "other", "argocd-application-controller-2", "argocd-application-controller-0"}
+	sortReplicas(replicas)
+	assert.Equal(t, []string{"argocd-application-controller-0", "argocd-application-controller-2", "argocd-application-controller-10", "other"}, replicas)
+}
+
+func newFakeClusterSharding(t *testing.T, algorithm string, kubeClient *fake.Clientset, clusters ...v1alpha1.Cluster) *ClusterSharding {
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("ListClusters", mock.Anything).Return(&v1alpha1.ClusterList{Items: clusters}, nil)
+	clusterSharding, err := NewClusterSharding(argoDB, nil, kubeClient, "argocd", algorithm, 0, 0, true, time.Minute)
+	require.NoError(t, err)
+	clusterSharding.hostname = "argocd-application-controller-1"
+	return clusterSharding
+}
+
+func TestClusterSharding_DynamicDistribution(t *testing.T) {
+	now := time.Now()
+	kubeClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDAppControllerShardConfigMapName, Namespace: "argocd"},
+		Data: map[string]string{
+			"argocd-application-controller-0": now.Add(-time.Minute).UTC().Format(time.RFC3339),
+			"argocd-application-controller-2": now.Add(-10 * time.Minute).UTC().Format(time.RFC3339),
+		},
+	})
+	clusters := []v1alpha1.Cluster{{ID: "1", Server: "https://a"}, {ID: "2", Server: "https://b"}}
+	clusterSharding := newFakeClusterSharding(t, RoundRobinShardingAlgorithm, kubeClient, clusters...)
+	require.NoError(t, clusterSharding.Init(context.Background()))
+
+	// the heartbeat of the stale replica is dropped
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, cm.Data, 2)
+	assert.Contains(t, cm.Data, "argocd-application-controller-1")
+	assert.False(t, clusterSharding.IsManagedCluster(&clusters[0]))
+	assert.True(t, clusterSharding.IsManagedCluster(&clusters[1]))
+
+	// the other replica is gone, so this replica takes over all clusters once the change remained for the hold time
+	clusterSharding.now = func() time.Time {
+		return now.Add(5 * time.Minute)
+	}
+	changed, err := clusterSharding.rebalance(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.False(t, clusterSharding.IsManagedCluster(&clusters[0]))
+
+	clusterSharding.now = func() time.Time {
+		return now.Add(8 * time.Minute)
+	}
+	changed, err = clusterSharding.rebalance(context.Background())
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, clusterSharding.IsManagedCluster(&clusters[0]))
+	assert.True(t, clusterSharding.IsManagedCluster(&clusters[1]))
+
+	changed, err = clusterSharding.rebalance(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestClusterSharding_HoldsShortLivedChanges(t *testing.T) {
+	now := time.Now()
+	kubeClient := fake.NewSimpleClientset()
+	clusters := []v1alpha1.Cluster{{ID: "1", Server: "https://a"}, {ID: "2", Server: "https://b"}}
+	clusterSharding := newFakeClusterSharding(t, RoundRobinShardingAlgorithm, kubeClient, clusters...)
+	clusterSharding.now = func() time.Time { return now }
+	require.NoError(t, clusterSharding.Init(context.Background()))
+	assert.True(t, clusterSharding.IsManagedCluster(&clusters[0]))
+
+	setHeartbeat := func(hostname string, at time.Time) {
+		cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		cm.Data[hostname] = at.UTC().Format(time.RFC3339)
+		_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	// another replica appears, but is gone again before the hold time elapsed
+	setHeartbeat("argocd-application-controller-0", now)
+	clusterSharding.now = func() time.Time { return now.Add(time.Minute) }
+	changed, err := clusterSharding.rebalance(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+	clusterSharding.now = func() time.Time { return now.Add(5 * time.Minute) }
+	changed, err = clusterSharding.rebalance(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+	clusterSharding.now = func() time.Time { return now.Add(9 * time.Minute) }
+	changed, err = clusterSharding.rebalance(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.True(t, clusterSharding.IsManagedCluster(&clusters[0]))
+	assert.True(t, clusterSharding.IsManagedCluster(&clusters[1]))
+}
+
+func TestClusterSharding_CreatesHeartbeatConfigMap(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	clusterSharding := newFakeClusterSharding(t, LegacyShardingAlgorithm, kubeClient, v1alpha1.Cluster{ID: "1", Server: "https://a"})
+	assert.False(t, clusterSharding.IsManagedCluster(&v1alpha1.Cluster{ID: "1", Server: "https://a"}))
+	require.NoError(t, clusterSharding.Init(context.Background()))
+	assert.True(t, clusterSharding.IsManagedCluster(&v1alpha1.Cluster{ID: "1", Server: "https://a"}))
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, cm.Data, 1)
+	assert.Contains(t, cm.Data, "argocd-application-controller-1")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/diff"
@@ -33,6 +34,7 @@ import (
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
+	"github.com/argoproj/argo-cd/v2/util/helm"
 	"github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	"github.com/argoproj/argo-cd/v2/util/stats"
@@ -60,7 +62,7 @@ type managedResource struct {
 
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
-	CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string) *comparisonResult
+	CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string, hasMultipleSources bool) *comparisonResult
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
 }
 
@@ -76,6 +78,8 @@ type comparisonResult struct {
 	// timings maps phases of comparison to the duration it took to complete (for statistical purposes)
 	timings        map[string]time.Duration
 	diffResultList *diff.DiffResultList
+	// hydrationStatus holds the result of committing the rendered manifests, if the application is hydrated
+	hydrationStatus *v1alpha1.HydrationStatus
 }
 
 func (res *comparisonResult) GetSyncStatus() *v1alpha1.SyncStatus {
@@ -102,7 +106,10 @@ type appStateManager struct {
 	resourceTracking     argo.ResourceTracking
 }
 
-func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey, revision string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+// getRepoObjs generates the manifests of all given sources and returns the merged target objects together with the
+// manifest response of each source. The revisions are used in place of the target revisions of the sources with the
+// same index, unless they are empty.
+func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature, verifyChartSignature, hasMultipleSources bool, proj *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
 	ts := stats.NewTimingStats()
 	helmRepos, err := m.db.ListHelmRepositories(context.Background())
 	if err != nil {
@@ -113,9 +120,12 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("helm_ms")
-	repo, err := m.db.GetRepository(context.Background(), source.RepoURL)
-	if err != nil {
-		return nil, nil, err
+	repos := make([]*appv1.Repository, len(sources))
+	for i, source := range sources {
+		repos[i], err = m.db.GetRepository(context.Background(), source.RepoURL)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	ts.AddCheckpoint("repo_ms")
 	helmRepositoryCredentials, err := m.db.GetAllHelmRepositoryCredentials(context.Background())
@@ -132,10 +142,6 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 	}
 	defer io.Close(conn)
 
-	if revision == "" {
-		revision = source.TargetRevision
-	}
-
 	plugins, err := m.settingsMgr.GetConfigManagementPlugins()
 	if err != nil {
 		return nil, nil, err
@@ -155,11 +161,6 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 
-	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	helmOptions, err := m.settingsMgr.GetHelmSettings()
 	if err != nil {
 		return nil, nil, err
@@ -170,33 +171,63 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("version_ms")
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
-		Repo:               repo,
-		Repos:              permittedHelmRepos,
-		Revision:           revision,
-		NoCache:            noCache,
-		NoRevisionCache:    noRevisionCache,
-		AppLabelKey:        appLabelKey,
-		AppName:            app.Name,
-		Namespace:          app.Spec.Destination.Namespace,
-		ApplicationSource:  &source,
-		Plugins:            tools,
-		KustomizeOptions:   kustomizeOptions,
-		KubeVersion:        serverVersion,
-		ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
-		VerifySignature:    verifySignature,
-		HelmRepoCreds:      permittedHelmCredentials,
-		TrackingMethod:     string(argo.GetTrackingMethod(m.settingsMgr)),
-		EnabledSourceTypes: enabledSourceTypes,
-		HelmOptions:        helmOptions,
-	})
-	if err != nil {
-		return nil, nil, err
+
+	sourceRevisions := make([]string, len(sources))
+	refSources := map[string]*appv1.RefTarget{}
+	for i, source := range sources {
+		sourceRevisions[i] = source.TargetRevision
+		if i < len(revisions) && revisions[i] != "" {
+			sourceRevisions[i] = revisions[i]
+		}
+		if hasMultipleSources && source.Ref != "" {
+			refSources["$"+source.Ref] = &appv1.RefTarget{Repo: *repos[i], TargetRevision: sourceRevisions[i], Chart: source.Chart}
+		}
 	}
-	targetObjs, err := unmarshalManifests(manifestInfo.Manifests)
 
-	if err != nil {
-		return nil, nil, err
+	var targetObjs []*unstructured.Unstructured
+	manifestInfos := make([]*apiclient.ManifestResponse, len(sources))
+	for i := range sources {
+		source := sources[i]
+		kustomizeOptions, err := kustomizeSettings.GetOptions(source)
+		if err != nil {
+			return nil, nil, err
+		}
+		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:               repos[i],
+			Repos:              permittedHelmRepos,
+			Revision:           sourceRevisions[i],
+			NoCache:            noCache,
+			NoRevisionCache:    noRevisionCache,
+			AppLabelKey:        appLabelKey,
+			AppName:            app.InstanceName(m.namespace),
+			Namespace:          app.Spec.Destination.Namespace,
+			ApplicationSource:  &source,
+			Plugins:            tools,
+			KustomizeOptions:   kustomizeOptions,
+			KubeVersion:        serverVersion,
+			ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+			VerifySignature:    verifySignature && producesGitManifests(source, hasMultipleSources),
+			HelmRepoCreds:      permittedHelmCredentials,
+			TrackingMethod:     string(argo.GetTrackingMethod(m.settingsMgr)),
+			EnabledSourceTypes: enabledSourceTypes,
+			HelmOptions:        helmOptions,
+			RefSources:         refSources,
+			HasMultipleSources: hasMultipleSources,
+			// chart signatures can only be verified for charts from Helm repositories and OCI registries
+			VerifyChartSignature: verifyChartSignature && source.IsHelm(),
+		})
+		if err != nil {
+			if hasMultipleSources {
+				return nil, nil, fmt.Errorf("failed to generate manifests for source %d of %d: %v", i+1, len(sources), err)
+			}
+			return nil, nil, err
+		}
+		objs, err := unmarshalManifests(manifestInfo.Manifests)
+		if err != nil {
+			return nil, nil, err
+		}
+		targetObjs = append(targetObjs, objs...)
+		manifestInfos[i] = manifestInfo
 	}
 
 	ts.AddCheckpoint("unmarshal_ms")
@@ -206,7 +237,7 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 	}
 	logCtx = logCtx.WithField("time_ms", time.Since(ts.StartTime).Milliseconds())
 	logCtx.Info("getRepoObjs stats")
-	return targetObjs, manifestInfo, nil
+	return targetObjs, manifestInfos, nil
 }
 
 func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, error) {
@@ -319,10 +350,68 @@ func verifyGnuPGSignature(revision string, project *appv1.AppProject, manifestIn
 	return conditions
 }
 
+// verifyHelmChartSignature verifies the result of the signature verification of the Helm chart of the given source against
+// the chart signature policy of the project. Once the project configures any signature keys, every chart has to be
+// signed with one of the allowed keys of its signature type.
+func verifyHelmChartSignature(source v1alpha1.ApplicationSource, project *appv1.AppProject, manifestInfo *apiclient.ManifestResponse) []appv1.ApplicationCondition {
+	now := metav1.Now()
+	conditions := make([]appv1.ApplicationCondition, 0)
+	newCondition := func(msg string) []appv1.ApplicationCondition {
+		return append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	}
+	policy := project.Spec.HelmSignatureVerification
+	if !source.IsHelm() || !project.RequiresChartSignature() {
+		return conditions
+	}
+	sig := manifestInfo.ChartSignature
+	if sig == nil {
+		return newCondition(fmt.Sprintf("Signature of chart %s version %s has not been verified, but a signature is required", source.Chart, manifestInfo.Revision))
+	}
+	if !sig.Signed {
+		return newCondition(fmt.Sprintf("Chart %s version %s is not signed, but a signature is required", source.Chart, manifestInfo.Revision))
+	}
+	if sig.Message != "" {
+		return newCondition(fmt.Sprintf("Could not verify signature of chart %s version %s: %s", source.Chart, manifestInfo.Revision, sig.Message))
+	}
+
+	switch sig.Type {
+	case helm.SignatureTypeProvenance:
+		if !gpg.IsGPGEnabled() {
+			return newCondition(fmt.Sprintf("Cannot verify provenance of chart %s version %s, because GnuPG is disabled", source.Chart, manifestInfo.Revision))
+		}
+		verifyResult := gpg.ParseGitCommitVerification(sig.VerifyResult)
+		switch verifyResult.Result {
+		case gpg.VerifyResultGood:
+			for _, k := range policy.ProvenanceKeys {
+				if gpg.KeyID(k.KeyID) == gpg.KeyID(verifyResult.KeyID) && gpg.KeyID(k.KeyID) != "" {
+					return conditions
+				}
+			}
+			return newCondition(fmt.Sprintf("Found good provenance signature of chart %s made with %s key %s, but this key is not allowed in AppProject",
+				source.Chart, verifyResult.Cipher, verifyResult.KeyID))
+		case gpg.VerifyResultInvalid:
+			return newCondition(fmt.Sprintf("Found provenance signature of chart %s made with %s key %s, but verification result was invalid: '%s'",
+				source.Chart, verifyResult.Cipher, verifyResult.KeyID, verifyResult.Message))
+		default:
+			return newCondition(fmt.Sprintf("Could not verify provenance of chart %s version %s, check logs for more information.", source.Chart, manifestInfo.Revision))
+		}
+	case helm.SignatureTypeCosign:
+		for _, k := range policy.CosignKeys {
+			for _, keyID := range sig.KeyIDs {
+				if k.Name == keyID && k.Name != "" {
+					return conditions
+				}
+			}
+		}
+		return newCondition(fmt.Sprintf("Found valid cosign signature of chart %s made with key %s, but this key is not allowed in AppProject", source.Chart, strings.Join(sig.KeyIDs, ", ")))
+	}
+	return newCondition(fmt.Sprintf("Unknown signature type '%s' of chart %s", sig.Type, source.Chart))
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
-// revision and supplied source. If revision or overrides are empty, then compares against
-// revision and overrides in the app spec.
-func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string) *comparisonResult {
+// revisions and supplied sources. If revisions or overrides are empty, then compares against
+// revisions and overrides in the app spec.
+func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string, hasMultipleSources bool) *comparisonResult {
 	ts := stats.NewTimingStats()
 	appLabelKey, resourceOverrides, resFilter, err := m.getComparisonSettings()
 
@@ -332,7 +421,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	if err != nil {
 		return &comparisonResult{
 			syncStatus: &v1alpha1.SyncStatus{
-				ComparedTo: appv1.ComparedTo{Source: source, Destination: app.Spec.Destination},
+				ComparedTo: comparedTo(sources, app.Spec.Destination, hasMultipleSources),
 				Status:     appv1.SyncStatusCodeUnknown,
 			},
 			healthStatus: &appv1.HealthStatus{Status: health.HealthStatusUnknown},
@@ -344,6 +433,8 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	if project.Spec.SignatureKeys != nil && len(project.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled() {
 		verifySignature = true
 	}
+	// When chart signature keys are defined in the project spec, we need to verify the signatures of Helm charts
+	verifyChartSignature := project.RequiresChartSignature()
 
 	// do best effort loading live and target state to present as much information about app state as possible
 	failedToLoadObjs := false
@@ -353,20 +444,29 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	logCtx.Infof("Comparing app state (cluster: %s, namespace: %s)", app.Spec.Destination.Server, app.Spec.Destination.Namespace)
 
 	var targetObjs []*unstructured.Unstructured
-	var manifestInfo *apiclient.ManifestResponse
+	var manifestInfos []*apiclient.ManifestResponse
+	var hydrationStatus *v1alpha1.HydrationStatus
 	now := metav1.Now()
 
 	if len(localManifests) == 0 {
-		targetObjs, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, revision, noCache, noRevisionCache, verifySignature, project)
+		targetObjs, manifestInfos, err = m.getRepoObjs(app, sources, appLabelKey, revisions, noCache, noRevisionCache, verifySignature, verifyChartSignature, hasMultipleSources, project)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 			failedToLoadObjs = true
+		} else if app.Spec.Hydration != nil {
+			// Hydrated applications are synced from the commit of the rendered manifests rather than from the source
+			targetObjs, hydrationStatus, err = m.hydrateManifests(app, project, sources[0], manifestInfos[0], appLabelKey, noCache, noRevisionCache)
+			if err != nil {
+				targetObjs = make([]*unstructured.Unstructured, 0)
+				conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+				failedToLoadObjs = true
+			}
 		}
 	} else {
 		// Prevent applying local manifests for now when signature verification is enabled
 		// This is also enforced on API level, but as a last resort, we also enforce it here
-		if gpg.IsGPGEnabled() && verifySignature {
+		if (gpg.IsGPGEnabled() && verifySignature) || verifyChartSignature {
 			msg := "Cannot use local manifests when signature verification is required"
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
@@ -379,7 +479,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 				failedToLoadObjs = true
 			}
 		}
-		manifestInfo = nil
+		manifestInfos = nil
 	}
 	ts.AddCheckpoint("git_ms")
 
@@ -427,10 +527,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
 			appInstanceName := m.resourceTracking.GetAppName(liveObj, appLabelKey, trackingMethod)
-			if appInstanceName != "" && appInstanceName != app.Name {
+			if appInstanceName != "" && appInstanceName != app.InstanceName(m.namespace) {
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:               v1alpha1.ApplicationConditionSharedResourceWarning,
-					Message:            fmt.Sprintf("%s/%s is part of applications %s and %s", liveObj.GetKind(), liveObj.GetName(), app.Name, appInstanceName),
+					Message:            fmt.Sprintf("%s/%s is part of applications %s and %s", liveObj.GetKind(), liveObj.GetName(), app.QualifiedName(), appInstanceName),
 					LastTransitionTime: &now,
 				})
 			}
@@ -447,8 +547,13 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	}
 
 	// restore comparison using cached diff result if previous comparison was performed for the same revision
-	revisionChanged := manifestInfo == nil || app.Status.Sync.Revision != manifestInfo.Revision
-	specChanged := !reflect.DeepEqual(app.Status.Sync.ComparedTo, appv1.ComparedTo{Source: app.Spec.Source, Destination: app.Spec.Destination})
+	var revisionChanged bool
+	if hasMultipleSources {
+		revisionChanged = manifestInfos == nil || !reflect.DeepEqual(app.Status.Sync.Revisions, manifestRevisions(manifestInfos))
+	} else {
+		revisionChanged = manifestInfos == nil || app.Status.Sync.Revision != manifestInfos[0].Revision
+	}
+	specChanged := !reflect.DeepEqual(app.Status.Sync.ComparedTo, comparedTo(app.Spec.GetSources(), app.Spec.Destination, app.Spec.HasMultipleSources()))
 	_, refreshRequested := app.IsRefreshRequested()
 	noCache = noCache || refreshRequested || app.Status.Expired(m.statusRefreshTimeout) || specChanged || revisionChanged
 
@@ -459,7 +564,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	if noCache {
 		diffConfigBuilder.WithNoCache()
 	} else {
-		diffConfigBuilder.WithCache(m.cache, app.GetName())
+		diffConfigBuilder.WithCache(m.cache, app.InstanceName(m.namespace))
 	}
 
 	gvkParser, err := m.getGVKParser(app.Spec.Destination.Server)
@@ -569,14 +674,15 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		syncCode = v1alpha1.SyncStatusCodeUnknown
 	}
 	syncStatus := v1alpha1.SyncStatus{
-		ComparedTo: appv1.ComparedTo{
-			Source:      source,
-			Destination: app.Spec.Destination,
-		},
-		Status: syncCode,
+		ComparedTo: comparedTo(sources, app.Spec.Destination, hasMultipleSources),
+		Status:     syncCode,
 	}
-	if manifestInfo != nil {
-		syncStatus.Revision = manifestInfo.Revision
+	if manifestInfos != nil {
+		if hasMultipleSources {
+			syncStatus.Revisions = manifestRevisions(manifestInfos)
+		} else {
+			syncStatus.Revision = manifestInfos[0].Revision
+		}
 	}
 	ts.AddCheckpoint("sync_ms")
 
@@ -588,8 +694,22 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	// Git has already performed the signature verification via its GPG interface, and the result is available
 	// in the manifest info received from the repository server. We now need to form our opinion about the result
 	// and stop processing if we do not agree about the outcome.
-	if gpg.IsGPGEnabled() && verifySignature && manifestInfo != nil {
-		conditions = append(conditions, verifyGnuPGSignature(revision, project, manifestInfo)...)
+	if gpg.IsGPGEnabled() && verifySignature && manifestInfos != nil {
+		for i, manifestInfo := range manifestInfos {
+			if !producesGitManifests(sources[i], hasMultipleSources) {
+				continue
+			}
+			revision := sources[i].TargetRevision
+			if i < len(revisions) && revisions[i] != "" {
+				revision = revisions[i]
+			}
+			conditions = append(conditions, verifyGnuPGSignature(revision, project, manifestInfo)...)
+		}
+	}
+	if verifyChartSignature && manifestInfos != nil {
+		for i, manifestInfo := range manifestInfos {
+			conditions = append(conditions, verifyHelmChartSignature(sources[i], project, manifestInfo)...)
+		}
 	}
 
 	compRes := comparisonResult{
@@ -600,9 +720,14 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		reconciliationResult: reconciliation,
 		diffConfig:           diffConfig,
 		diffResultList:       diffResults,
+		hydrationStatus:      hydrationStatus,
 	}
-	if manifestInfo != nil {
-		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+	for _, manifestInfo := range manifestInfos {
+		// the type of the first source that contains manifests is reported as the type of the application
+		if manifestInfo.SourceType != "" {
+			compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+			break
+		}
 	}
 	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{
 		appv1.ApplicationConditionComparisonError:         true,
@@ -615,18 +740,52 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	return &compRes
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, startedAt metav1.Time) error {
+// producesGitManifests returns true if the manifests of the given source are generated from a Git repository, i.e. the
+// source is neither a Helm chart nor an OCI artifact, and not only referenced by the other sources of the application.
+// Only the commit signatures of such sources are verified.
+func producesGitManifests(source v1alpha1.ApplicationSource, hasMultipleSources bool) bool {
+	if source.IsHelm() || source.IsOCI() {
+		return false
+	}
+	return !hasMultipleSources || !source.IsRef()
+}
+
+// comparedTo returns the sources and destination an application is compared to. Applications with multiple
+// sources record them in the sources field, all others in the source field.
+func comparedTo(sources []v1alpha1.ApplicationSource, destination v1alpha1.ApplicationDestination, hasMultipleSources bool) v1alpha1.ComparedTo {
+	if hasMultipleSources {
+		return v1alpha1.ComparedTo{Sources: sources, Destination: destination}
+	}
+	return v1alpha1.ComparedTo{Source: sources[0], Destination: destination}
+}
+
+// manifestRevisions returns the resolved revisions of the given manifest responses
+func manifestRevisions(manifestInfos []*apiclient.ManifestResponse) []string {
+	revisions := make([]string, len(manifestInfos))
+	for i, manifestInfo := range manifestInfos {
+		revisions[i] = manifestInfo.Revision
+	}
+	return revisions
+}
+
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource, hasMultipleSources bool, startedAt metav1.Time) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History.LastRevisionHistory().ID + 1
 	}
-	app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-		Revision:        revision,
+	history := v1alpha1.RevisionHistory{
 		DeployedAt:      metav1.NewTime(time.Now().UTC()),
 		DeployStartedAt: &startedAt,
 		ID:              nextID,
-		Source:          source,
-	})
+	}
+	if hasMultipleSources {
+		history.Revisions = revisions
+		history.Sources = sources
+	} else {
+		history.Revision = revisions[0]
+		history.Source = sources[0]
+	}
+	app.Status.History = append(app.Status.History, history)
 
 	app.Status.History = app.Status.History.Trunc(app.Spec.GetRevisionHistoryLimit())
 
@@ -638,7 +797,7 @@ func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revi
 	if err != nil {
 		return err
 	}
-	_, err = m.appclientset.ArgoprojV1alpha1().Applications(m.namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	_, err = m.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
 	return err
 }
 
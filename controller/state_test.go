@@ -23,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/helm"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -38,7 +39,7 @@ func TestCompareAppStateEmpty(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.NotNil(t, compRes.syncStatus)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -47,6 +48,33 @@ func TestCompareAppStateEmpty(t *testing.T) {
 	assert.Len(t, app.Status.Conditions, 0)
 }
 
+// TestCompareAppStateMultipleSources tests comparing an application with multiple sources
+func TestCompareAppStateMultipleSources(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Sources = []argoappv1.ApplicationSource{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+	}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, true)
+	assert.NotNil(t, compRes)
+	assert.NotNil(t, compRes.syncStatus)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, []string{"abc123", "abc123"}, compRes.syncStatus.Revisions)
+	assert.Empty(t, compRes.syncStatus.Revision)
+	assert.Equal(t, app.Spec.Sources, compRes.syncStatus.ComparedTo.Sources)
+	assert.Len(t, app.Status.Conditions, 0)
+}
+
 // TestCompareAppStateMissing tests when there is a manifest defined in the repo which doesn't exist in live
 func TestCompareAppStateMissing(t *testing.T) {
 	app := newFakeApp()
@@ -61,7 +89,7 @@ func TestCompareAppStateMissing(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.NotNil(t, compRes.syncStatus)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
@@ -88,7 +116,7 @@ func TestCompareAppStateExtra(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
 	assert.Equal(t, 1, len(compRes.resources))
@@ -114,7 +142,7 @@ func TestCompareAppStateHook(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
 	assert.Equal(t, 0, len(compRes.resources))
@@ -141,7 +169,7 @@ func TestCompareAppStateSkipHook(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
 	assert.Equal(t, 1, len(compRes.resources))
@@ -167,7 +195,7 @@ func TestCompareAppStateCompareOptionIgnoreExtraneous(t *testing.T) {
 	}
 	ctrl := newFakeController(&data)
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -195,7 +223,7 @@ func TestCompareAppStateExtraHook(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -238,7 +266,7 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 
 	assert.NotNil(t, compRes)
 	assert.Equal(t, 1, len(app.Status.Conditions))
@@ -289,7 +317,7 @@ func TestSetHealth(t *testing.T) {
 		},
 	})
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 
 	assert.Equal(t, health.HealthStatusHealthy, compRes.healthStatus.Status)
 }
@@ -321,7 +349,7 @@ func TestSetHealthSelfReferencedApp(t *testing.T) {
 		},
 	})
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 
 	assert.Equal(t, health.HealthStatusHealthy, compRes.healthStatus.Status)
 }
@@ -391,7 +419,7 @@ func TestReturnUnknownComparisonStateOnSettingLoadError(t *testing.T) {
 		},
 	})
 
-	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 
 	assert.Equal(t, health.HealthStatusUnknown, compRes.healthStatus.Status)
 	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
@@ -400,6 +428,7 @@ func TestReturnUnknownComparisonStateOnSettingLoadError(t *testing.T) {
 func TestSetManagedResourcesKnownOrphanedResourceExceptions(t *testing.T) {
 	proj := defaultProj.DeepCopy()
 	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+	proj.Spec.SourceNamespaces = []string{"default"}
 
 	app := newFakeApp()
 	app.Namespace = "default"
@@ -437,7 +466,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1.Time{})
+		err := manager.persistRevisionHistory(app, []string{"my-revision"}, []argoappv1.ApplicationSource{{}}, false, metav1.Time{})
 		assert.NoError(t, err)
 	}
 	addHistory()
@@ -473,7 +502,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1NowTime)
+	err := manager.persistRevisionHistory(app, []string{"my-revision"}, []argoappv1.ApplicationSource{{}}, false, metav1NowTime)
 	assert.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
 }
@@ -527,7 +556,7 @@ func TestSignedResponseNoSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -549,7 +578,7 @@ func TestSignedResponseNoSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, nil, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -578,7 +607,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, nil, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -600,7 +629,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -622,7 +651,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -644,7 +673,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -669,7 +698,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		ctrl := newFakeController(&data)
 		testProj := signedProj
 		testProj.Spec.SignatureKeys[0].KeyID = "4AEE18F83AFDEB24"
-		compRes := ctrl.appStateManager.CompareAppState(app, &testProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &testProj, []string{"abc123"}, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -694,7 +723,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		// it doesn't matter for our test whether local manifests are valid
 		localManifests := []string{"foobar"}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, localManifests)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, app.Spec.GetSources(), false, false, localManifests, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
@@ -719,7 +748,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 		}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, nil)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, app.Spec.GetSources(), false, false, nil, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -744,7 +773,7 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		// it doesn't matter for our test whether local manifests are valid
 		localManifests := []string{""}
 		ctrl := newFakeController(&data)
-		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, "abc123", app.Spec.Source, false, false, localManifests)
+		compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, []string{"abc123"}, app.Spec.GetSources(), false, false, localManifests, false)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -755,6 +784,122 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 
 }
 
+// TestSignedResponseSignatureRequiredMultipleSources tests that only the commit signatures of Git sources producing
+// manifests are verified
+func TestSignedResponseSignatureRequiredMultipleSources(t *testing.T) {
+	t.Setenv("ARGOCD_GPG_ENABLED", "true")
+
+	app := newFakeApp()
+	app.Spec.Sources = []argoappv1.ApplicationSource{
+		{RepoURL: "https://charts.example.com", Chart: "chart", TargetRevision: "1.0.0"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+	}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &signedProj, nil, app.Spec.GetSources(), false, false, nil, true)
+	assert.NotNil(t, compRes)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Len(t, app.Status.Conditions, 0)
+
+	// the unsigned Git source producing manifests is not permitted
+	app.Spec.Sources = append(app.Spec.Sources, argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"})
+	compRes = ctrl.appStateManager.CompareAppState(app, &signedProj, nil, app.Spec.GetSources(), false, false, nil, true)
+	assert.NotNil(t, compRes)
+	if assert.Len(t, app.Status.Conditions, 1) {
+		assert.Contains(t, app.Status.Conditions[0].Message, "is not signed")
+	}
+}
+
+func TestVerifyHelmChartSignature(t *testing.T) {
+	t.Setenv("ARGOCD_GPG_ENABLED", "true")
+	source := argoappv1.ApplicationSource{RepoURL: "https://argoproj.github.io/argo-helm", Chart: "argo-cd", TargetRevision: "5.*"}
+	proj := argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{HelmSignatureVerification: &argoappv1.HelmSignatureVerification{
+		ProvenanceKeys: []argoappv1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}},
+		CosignKeys:     []argoappv1.CosignKey{{Name: "release"}},
+	}}}
+	verify := func(source argoappv1.ApplicationSource, proj argoappv1.AppProject, sig *apiclient.ChartSignatureVerification) []argoappv1.ApplicationCondition {
+		return verifyHelmChartSignature(source, &proj, &apiclient.ManifestResponse{Revision: "5.1.0", ChartSignature: sig})
+	}
+
+	t.Run("GoodProvenance", func(t *testing.T) {
+		conditions := verify(source, proj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance, Signed: true, VerifyResult: mustReadFile("../util/gpg/testdata/good_signature.txt")})
+		assert.Len(t, conditions, 0)
+	})
+	t.Run("ProvenanceKeyNotAllowed", func(t *testing.T) {
+		testProj := *proj.DeepCopy()
+		testProj.Spec.HelmSignatureVerification.ProvenanceKeys[0].KeyID = "4AEE18F83AFDEB24"
+		conditions := verify(source, testProj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance, Signed: true, VerifyResult: mustReadFile("../util/gpg/testdata/good_signature.txt")})
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "key is not allowed")
+	})
+	t.Run("BadProvenance", func(t *testing.T) {
+		conditions := verify(source, proj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance, Signed: true, VerifyResult: mustReadFile("../util/gpg/testdata/bad_signature_bad.txt")})
+		assert.Len(t, conditions, 1)
+	})
+	t.Run("ProvenanceGPGDisabled", func(t *testing.T) {
+		t.Setenv("ARGOCD_GPG_ENABLED", "false")
+		conditions := verify(source, proj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance, Signed: true, VerifyResult: mustReadFile("../util/gpg/testdata/good_signature.txt")})
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "GnuPG is disabled")
+	})
+	t.Run("Unsigned", func(t *testing.T) {
+		conditions := verify(source, proj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance})
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, "Chart argo-cd version 5.1.0 is not signed, but a signature is required", conditions[0].Message)
+	})
+	t.Run("UnsignedWithoutPolicy", func(t *testing.T) {
+		testProj := *proj.DeepCopy()
+		testProj.Spec.HelmSignatureVerification = nil
+		conditions := verify(source, testProj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance})
+		assert.Len(t, conditions, 0)
+	})
+	t.Run("UnsignedWithKeysOfOtherType", func(t *testing.T) {
+		testProj := *proj.DeepCopy()
+		testProj.Spec.HelmSignatureVerification.ProvenanceKeys = nil
+		conditions := verify(source, testProj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance})
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "is not signed")
+	})
+	t.Run("ProvenanceWithKeysOfOtherType", func(t *testing.T) {
+		testProj := *proj.DeepCopy()
+		testProj.Spec.HelmSignatureVerification.ProvenanceKeys = nil
+		conditions := verify(source, testProj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance, Signed: true, VerifyResult: mustReadFile("../util/gpg/testdata/good_signature.txt")})
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "key is not allowed")
+	})
+	t.Run("NotVerified", func(t *testing.T) {
+		conditions := verify(source, proj, nil)
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "has not been verified")
+	})
+	t.Run("InvalidSignature", func(t *testing.T) {
+		conditions := verify(source, proj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeCosign, Signed: true, Message: "signature could not be verified"})
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "signature could not be verified")
+	})
+	t.Run("GoodCosign", func(t *testing.T) {
+		conditions := verify(source, proj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeCosign, Signed: true, KeyIDs: []string{"other", "release"}})
+		assert.Len(t, conditions, 0)
+	})
+	t.Run("CosignKeyNotAllowed", func(t *testing.T) {
+		conditions := verify(source, proj, &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeCosign, Signed: true, KeyIDs: []string{"other"}})
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "key is not allowed")
+	})
+	t.Run("NoHelmSource", func(t *testing.T) {
+		conditions := verify(argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"}, proj, nil)
+		assert.Len(t, conditions, 0)
+	})
+}
+
 func TestComparisonResult_GetHealthStatus(t *testing.T) {
 	status := &argoappv1.HealthStatus{Status: health.HealthStatusMissing}
 	res := comparisonResult{
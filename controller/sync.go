@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
 
 	cdcommon "github.com/argoproj/argo-cd/v2/common"
@@ -26,6 +27,7 @@ import (
 	listersv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/argo/diff"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/rand"
@@ -62,9 +64,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	// This ensures that when resuming an operation, we sync to the same revision that we initially
 	// started with.
 	var revision string
+	var revisions []string
 	var syncOp v1alpha1.SyncOperation
 	var syncRes *v1alpha1.SyncOperationResult
-	var source v1alpha1.ApplicationSource
+	var sources []v1alpha1.ApplicationSource
 
 	if state.Operation.Sync == nil {
 		state.Phase = common.OperationFailed
@@ -82,23 +85,35 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
-	if syncOp.Source == nil {
-		// normal sync case (where source is taken from app.spec.source)
-		source = app.Spec.Source
-	} else {
+	var hasMultipleSources bool
+	switch {
+	case len(syncOp.Sources) > 0:
+		// rollback case of an application with multiple sources
+		sources = syncOp.Sources
+		hasMultipleSources = true
+	case syncOp.Source != nil:
 		// rollback case
-		source = *state.Operation.Sync.Source
+		sources = []v1alpha1.ApplicationSource{*syncOp.Source}
+	default:
+		// normal sync case (where sources are taken from app.spec.source or app.spec.sources)
+		sources = app.Spec.GetSources()
+		hasMultipleSources = app.Spec.HasMultipleSources()
 	}
 
 	if state.SyncResult != nil {
 		syncRes = state.SyncResult
 		revision = state.SyncResult.Revision
+		revisions = state.SyncResult.Revisions
 	} else {
 		syncRes = &v1alpha1.SyncOperationResult{}
 		// status.operationState.syncResult.source. must be set properly since auto-sync relies
 		// on this information to decide if it should sync (if source is different than the last
 		// sync attempt)
-		syncRes.Source = source
+		if hasMultipleSources {
+			syncRes.Sources = sources
+		} else {
+			syncRes.Source = sources[0]
+		}
 		state.SyncResult = syncRes
 	}
 
@@ -108,6 +123,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		// Take the value in the requested operation. We will resolve this to a SHA later.
 		revision = syncOp.Revision
 	}
+	if len(revisions) == 0 {
+		revisions = syncOp.Revisions
+	}
+	if !hasMultipleSources {
+		revisions = []string{revision}
+	}
 
 	proj, err := argo.GetAppProject(&app.Spec, listersv1alpha1.NewAppProjectLister(m.projInformer.GetIndexer()), m.namespace, m.settingsMgr, m.db, context.TODO())
 	if err != nil {
@@ -116,10 +137,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
-	compareResult := m.CompareAppState(app, proj, revision, source, false, true, syncOp.Manifests)
+	compareResult := m.CompareAppState(app, proj, revisions, sources, false, true, syncOp.Manifests, hasMultipleSources)
 	// We now have a concrete commit SHA. Save this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = compareResult.syncStatus.Revision
+	syncRes.Revisions = compareResult.syncStatus.Revisions
 
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
@@ -141,6 +163,17 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	rawConfig := clst.RawRestConfig()
 	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, clst.RESTConfig())
 
+	impersonatedServiceAccount, err := proj.GetImpersonatedServiceAccount(app.Spec.Destination)
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Failed to determine the service account to impersonate: %v", err)
+		return
+	}
+	if impersonatedServiceAccount != "" {
+		rawConfig.Impersonate = rest.ImpersonationConfig{UserName: impersonatedServiceAccount}
+		restConfig.Impersonate = rest.ImpersonationConfig{UserName: impersonatedServiceAccount}
+	}
+
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		state.Phase = common.OperationError
@@ -158,6 +191,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	syncId := fmt.Sprintf("%05d-%s", syncIdPrefix, randSuffix)
 
 	logEntry := log.WithFields(log.Fields{"application": app.Name, "syncId": syncId})
+	if impersonatedServiceAccount != "" {
+		logEntry = logEntry.WithField("serviceAccount", impersonatedServiceAccount)
+	}
 	initialResourcesRes := make([]common.ResourceSyncResult, 0)
 	for i, res := range syncRes.Resources {
 		key := kube.ResourceKey{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
@@ -205,12 +241,22 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		reconciliationResult.Target = patchedTargets
 	}
 
+	var managedNamespaceMetadata *v1alpha1.ManagedNamespaceMetadata
+	if app.Spec.SyncPolicy != nil {
+		managedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
+	}
+	createNamespace := syncOp.SyncOptions.HasOption("CreateNamespace=true")
+	kubectl := kubeutil.NewImpersonatingKubectl(kubeutil.NewServerSideApplyKubectl(m.kubectl, cdcommon.ArgoCDSSAManager), cdcommon.ArgoCDSSAManager)
+	if createNamespace && managedNamespaceMetadata != nil && !containsNamespace(reconciliationResult.Target, app.Spec.Destination.Namespace) {
+		kubectl = newNamespaceMetadataKubectl(kubectl, app.Spec.Destination.Namespace, managedNamespaceMetadata)
+	}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		kubectl,
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
@@ -230,18 +276,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			return len(syncOp.Resources) == 0 || argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
-		sync.WithNamespaceCreation(syncOp.SyncOptions.HasOption("CreateNamespace=true"), func(un *unstructured.Unstructured) bool {
-			if un != nil && kube.GetAppInstanceLabel(un, cdcommon.LabelKeyAppInstance) != "" {
-				kube.UnsetLabel(un, cdcommon.LabelKeyAppInstance)
-				return true
-			}
-			return false
-		}),
+		sync.WithNamespaceCreation(createNamespace, syncNamespace(managedNamespaceMetadata)),
 		sync.WithSyncWaveHook(delayBetweenSyncWaves),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
 		sync.WithReplace(syncOp.SyncOptions.HasOption(common.SyncOptionReplace)),
+		sync.WithServerSideApply(syncOp.SyncOptions.HasOption(common.SyncOptionServerSideApply)),
 	)
 
 	if err != nil {
@@ -280,7 +321,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, state.StartedAt)
+		revisions := compareResult.syncStatus.Revisions
+		if !hasMultipleSources {
+			revisions = []string{compareResult.syncStatus.Revision}
+		}
+		err := m.persistRevisionHistory(app, revisions, sources, hasMultipleSources, state.StartedAt)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
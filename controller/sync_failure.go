@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"strings"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// syncFailurePatterns are the lower case message fragments which identify the failure categories, in the order in which
+// they are matched. Admission denials are matched before permission errors since the messages of policies denying a
+// request typically contain "is forbidden" as well.
+var syncFailurePatterns = []struct {
+	category appv1.SyncFailureCategory
+	patterns []string
+}{
+	{appv1.SyncFailureCategoryAdmissionDenied, []string{
+		"admission webhook",
+		"denied the request",
+		"violates podsecurity",
+	}},
+	{appv1.SyncFailureCategoryInvalid, []string{
+		"is invalid",
+		"error validating data",
+		"unknown field",
+		"strict decoding error",
+		"cannot unmarshal",
+		"error converting yaml to json",
+	}},
+	{appv1.SyncFailureCategoryForbidden, []string{
+		"forbidden",
+		"unauthorized",
+		"not permitted",
+	}},
+	{appv1.SyncFailureCategoryTransient, []string{
+		"timeout",
+		"timed out",
+		"deadline exceeded",
+		"deadlineexceeded",
+		"connection refused",
+		"connection reset",
+		"unexpected eof",
+		"too many requests",
+		"try again",
+		"the server is currently unable to handle the request",
+		"service unavailable",
+		"code = unavailable",
+		"the object has been modified",
+		"etcdserver: leader changed",
+		"http2: client connection lost",
+	}},
+}
+
+// syncFailureCategoryPriority orders the failure categories by how likely a retry is to fail again. The category of a
+// sync in which several resources failed is the highest priority category of the failures.
+var syncFailureCategoryPriority = []appv1.SyncFailureCategory{
+	appv1.SyncFailureCategoryInvalid,
+	appv1.SyncFailureCategoryAdmissionDenied,
+	appv1.SyncFailureCategoryForbidden,
+	appv1.SyncFailureCategoryUnknown,
+	appv1.SyncFailureCategoryTransient,
+}
+
+// classifySyncFailureMessage returns the failure category of the given error message
+func classifySyncFailureMessage(message string) appv1.SyncFailureCategory {
+	message = strings.ToLower(message)
+	for _, p := range syncFailurePatterns {
+		for _, pattern := range p.patterns {
+			if strings.Contains(message, pattern) {
+				return p.category
+			}
+		}
+	}
+	return appv1.SyncFailureCategoryUnknown
+}
+
+// classifySyncFailure returns the failure category of the given failed operation. The messages of the failed resources
+// are classified if there are any, otherwise the message of the operation.
+func classifySyncFailure(state *appv1.OperationState) appv1.SyncFailureCategory {
+	categories := map[appv1.SyncFailureCategory]bool{}
+	if state.SyncResult != nil {
+		for _, res := range state.SyncResult.Resources {
+			if res.Status == synccommon.ResultCodeSyncFailed || res.HookPhase == synccommon.OperationFailed || res.HookPhase == synccommon.OperationError {
+				categories[classifySyncFailureMessage(res.Message)] = true
+			}
+		}
+	}
+	if len(categories) == 0 {
+		return classifySyncFailureMessage(state.Message)
+	}
+	for _, category := range syncFailureCategoryPriority {
+		if categories[category] {
+			return category
+		}
+	}
+	return appv1.SyncFailureCategoryUnknown
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestClassifySyncFailureMessage(t *testing.T) {
+	for message, category := range map[string]appv1.SyncFailureCategory{
+		`admission webhook "validate.kyverno.svc-fail" denied the request: resource Deployment/default/guestbook-ui was blocked`: appv1.SyncFailureCategoryAdmissionDenied,
+		`pods "guestbook-ui" is forbidden: violates PodSecurity "restricted:latest": allowPrivilegeEscalation != false`:          appv1.SyncFailureCategoryAdmissionDenied,
+		`Deployment.apps "guestbook-ui" is invalid: spec.template.metadata.labels: Invalid value`:                                appv1.SyncFailureCategoryInvalid,
+		`error validating data: ValidationError(Deployment.spec): unknown field "replica"`:                                       appv1.SyncFailureCategoryInvalid,
+		`deployments.apps "guestbook-ui" is forbidden: User "system:serviceaccount:argocd:argocd" cannot patch resource`:         appv1.SyncFailureCategoryForbidden,
+		`system:serviceaccount:default:deployer is not permitted to create deployments 'guestbook-ui' in namespace 'default'`:    appv1.SyncFailureCategoryForbidden,
+		`Get "https://kubernetes.default.svc/api": dial tcp 10.0.0.1:443: i/o timeout`:                                           appv1.SyncFailureCategoryTransient,
+		`the server is currently unable to handle the request`:                                                                   appv1.SyncFailureCategoryTransient,
+		`Operation cannot be fulfilled on deployments.apps "guestbook-ui": the object has been modified`:                         appv1.SyncFailureCategoryTransient,
+		`Job has reached the specified backoff limit`:                                                                            appv1.SyncFailureCategoryUnknown,
+	} {
+		assert.Equal(t, category, classifySyncFailureMessage(message), message)
+	}
+}
+
+func TestClassifySyncFailure(t *testing.T) {
+	t.Run("operation message", func(t *testing.T) {
+		state := &appv1.OperationState{Message: "rpc error: code = DeadlineExceeded desc = context deadline exceeded"}
+		assert.Equal(t, appv1.SyncFailureCategoryTransient, classifySyncFailure(state))
+	})
+
+	t.Run("failed resources", func(t *testing.T) {
+		state := &appv1.OperationState{
+			Message: "one or more objects failed to apply",
+			SyncResult: &appv1.SyncOperationResult{Resources: appv1.ResourceResults{
+				{Name: "a", Status: synccommon.ResultCodeSynced, Message: "is invalid"},
+				{Name: "b", Status: synccommon.ResultCodeSyncFailed, Message: "context deadline exceeded"},
+			}},
+		}
+		assert.Equal(t, appv1.SyncFailureCategoryTransient, classifySyncFailure(state))
+	})
+
+	t.Run("most permanent failure wins", func(t *testing.T) {
+		state := &appv1.OperationState{
+			SyncResult: &appv1.SyncOperationResult{Resources: appv1.ResourceResults{
+				{Name: "a", Status: synccommon.ResultCodeSyncFailed, Message: "context deadline exceeded"},
+				{Name: "b", Status: synccommon.ResultCodeSyncFailed, Message: `admission webhook "policy" denied the request`},
+				{Name: "c", HookPhase: synccommon.OperationFailed, Message: "Job has reached the specified backoff limit"},
+			}},
+		}
+		assert.Equal(t, appv1.SyncFailureCategoryAdmissionDenied, classifySyncFailure(state))
+	})
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// syncNamespace returns the function which decides whether the already existing destination namespace has to be
+// updated by a sync with the CreateNamespace=true option, and modifies it accordingly.
+func syncNamespace(metadata *v1alpha1.ManagedNamespaceMetadata) func(un *unstructured.Unstructured) bool {
+	return func(un *unstructured.Unstructured) bool {
+		if un == nil {
+			return false
+		}
+		modified := false
+		if kube.GetAppInstanceLabel(un, cdcommon.LabelKeyAppInstance) != "" {
+			kube.UnsetLabel(un, cdcommon.LabelKeyAppInstance)
+			modified = true
+		}
+		if setManagedNamespaceMetadata(un, metadata) {
+			modified = true
+		}
+		if modified {
+			// the live namespace is applied as is, which is not possible with its managed fields set
+			un.SetManagedFields(nil)
+		}
+		return modified
+	}
+}
+
+// setManagedNamespaceMetadata sets the labels and annotations of the given managed namespace metadata on the namespace.
+// Labels and annotations which were set from a previous version of the metadata, but are no longer part of it, are
+// removed. Returns whether the namespace was modified.
+func setManagedNamespaceMetadata(ns *unstructured.Unstructured, metadata *v1alpha1.ManagedNamespaceMetadata) bool {
+	if metadata == nil {
+		metadata = &v1alpha1.ManagedNamespaceMetadata{}
+	}
+	var previous v1alpha1.ManagedNamespaceMetadata
+	if value, ok := ns.GetAnnotations()[cdcommon.AnnotationKeyManagedNamespaceMetadata]; ok {
+		if err := json.Unmarshal([]byte(value), &previous); err != nil {
+			log.Warnf("Failed to parse annotation '%s' of namespace '%s': %v", cdcommon.AnnotationKeyManagedNamespaceMetadata, ns.GetName(), err)
+		}
+	}
+
+	labels := mergeManagedMetadata(ns.GetLabels(), previous.Labels, metadata.Labels)
+	annotations := mergeManagedMetadata(ns.GetAnnotations(), previous.Annotations, metadata.Annotations)
+	delete(annotations, cdcommon.AnnotationKeyManagedNamespaceMetadata)
+	if len(metadata.Labels) > 0 || len(metadata.Annotations) > 0 {
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			log.Warnf("Failed to marshal managed metadata of namespace '%s': %v", ns.GetName(), err)
+		} else {
+			annotations[cdcommon.AnnotationKeyManagedNamespaceMetadata] = string(data)
+		}
+	}
+
+	modified := !reflect.DeepEqual(nilIfEmpty(ns.GetLabels()), nilIfEmpty(labels)) ||
+		!reflect.DeepEqual(nilIfEmpty(ns.GetAnnotations()), nilIfEmpty(annotations))
+	if modified {
+		ns.SetLabels(nilIfEmpty(labels))
+		ns.SetAnnotations(nilIfEmpty(annotations))
+	}
+	return modified
+}
+
+// mergeManagedMetadata returns the current entries without the previously managed ones which are no longer desired,
+// updated with the desired entries.
+func mergeManagedMetadata(current, previous, desired map[string]string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range current {
+		_, wasManaged := previous[k]
+		_, isDesired := desired[k]
+		if wasManaged && !isDesired {
+			continue
+		}
+		result[k] = v
+	}
+	for k, v := range desired {
+		result[k] = v
+	}
+	return result
+}
+
+func nilIfEmpty(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// newNamespaceMetadataKubectl wraps the given kubectl so that the managed namespace metadata is set on the destination
+// namespace whenever it is applied. The sync engine creates a missing namespace without consulting the namespace
+// modifier, so without this the metadata would only be set by the next sync.
+func newNamespaceMetadataKubectl(kubectl kube.Kubectl, namespace string, metadata *v1alpha1.ManagedNamespaceMetadata) kube.Kubectl {
+	return &namespaceMetadataKubectl{Kubectl: kubectl, namespace: namespace, metadata: metadata}
+}
+
+type namespaceMetadataKubectl struct {
+	kube.Kubectl
+	namespace string
+	metadata  *v1alpha1.ManagedNamespaceMetadata
+}
+
+func (k *namespaceMetadataKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	resourceOps, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &namespaceMetadataResourceOperations{ResourceOperations: resourceOps, namespace: k.namespace, metadata: k.metadata}, cleanup, nil
+}
+
+type namespaceMetadataResourceOperations struct {
+	kube.ResourceOperations
+	namespace string
+	metadata  *v1alpha1.ManagedNamespaceMetadata
+}
+
+func (o *namespaceMetadataResourceOperations) withMetadata(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if obj == nil || obj.GroupVersionKind().Group != "" || obj.GetKind() != kube.NamespaceKind || obj.GetName() != o.namespace {
+		return obj
+	}
+	obj = obj.DeepCopy()
+	setManagedNamespaceMetadata(obj, o.metadata)
+	return obj
+}
+
+func (o *namespaceMetadataResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool) (string, error) {
+	return o.ResourceOperations.ApplyResource(ctx, o.withMetadata(obj), dryRunStrategy, force, validate, serverSideApply)
+}
+
+func (o *namespaceMetadataResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool) (string, error) {
+	return o.ResourceOperations.ReplaceResource(ctx, o.withMetadata(obj), dryRunStrategy, force)
+}
+
+func (o *namespaceMetadataResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, validate bool) (string, error) {
+	return o.ResourceOperations.CreateResource(ctx, o.withMetadata(obj), dryRunStrategy, validate)
+}
+
+// containsNamespace returns whether the given target resources contain the namespace with the given name
+func containsNamespace(targets []*unstructured.Unstructured, namespace string) bool {
+	for _, target := range targets {
+		if target != nil && target.GroupVersionKind().Group == "" && target.GetKind() == kube.NamespaceKind && target.GetName() == namespace {
+			return true
+		}
+	}
+	return false
+}
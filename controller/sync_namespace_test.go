@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func createFakeNamespace(labels map[string]string, annotations map[string]string) *unstructured.Unstructured {
+	return kube.MustToUnstructured(&v1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: kube.NamespaceKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "some-namespace", Labels: labels, Annotations: annotations},
+	})
+}
+
+func TestSyncNamespace(t *testing.T) {
+	t.Run("nil namespace is not synced", func(t *testing.T) {
+		assert.False(t, syncNamespace(nil)(nil))
+	})
+
+	t.Run("unmodified namespace is not synced", func(t *testing.T) {
+		ns := createFakeNamespace(map[string]string{"foo": "bar"}, nil)
+		assert.False(t, syncNamespace(nil)(ns))
+		assert.Equal(t, map[string]string{"foo": "bar"}, ns.GetLabels())
+	})
+
+	t.Run("app instance label is removed", func(t *testing.T) {
+		ns := createFakeNamespace(map[string]string{cdcommon.LabelKeyAppInstance: "some-app"}, nil)
+		assert.True(t, syncNamespace(nil)(ns))
+		assert.Empty(t, ns.GetLabels())
+	})
+
+	t.Run("managed metadata is added", func(t *testing.T) {
+		ns := createFakeNamespace(map[string]string{"foo": "bar"}, nil)
+		metadata := &v1alpha1.ManagedNamespaceMetadata{
+			Labels:      map[string]string{"pod-security.kubernetes.io/enforce": "restricted"},
+			Annotations: map[string]string{"istio-injection": "enabled"},
+		}
+		assert.True(t, syncNamespace(metadata)(ns))
+		assert.Equal(t, map[string]string{"foo": "bar", "pod-security.kubernetes.io/enforce": "restricted"}, ns.GetLabels())
+		assert.Equal(t, "enabled", ns.GetAnnotations()["istio-injection"])
+		assert.JSONEq(t, `{"labels":{"pod-security.kubernetes.io/enforce":"restricted"},"annotations":{"istio-injection":"enabled"}}`, ns.GetAnnotations()[cdcommon.AnnotationKeyManagedNamespaceMetadata])
+
+		// syncing again with the same metadata does not modify the namespace
+		assert.False(t, syncNamespace(metadata)(ns))
+	})
+
+	t.Run("managed metadata is updated", func(t *testing.T) {
+		ns := createFakeNamespace(map[string]string{"foo": "bar", "team": "a", "cost-centre": "1"}, map[string]string{
+			cdcommon.AnnotationKeyManagedNamespaceMetadata: `{"labels":{"team":"a","cost-centre":"1"}}`,
+		})
+		metadata := &v1alpha1.ManagedNamespaceMetadata{Labels: map[string]string{"team": "b"}}
+		assert.True(t, syncNamespace(metadata)(ns))
+		assert.Equal(t, map[string]string{"foo": "bar", "team": "b"}, ns.GetLabels())
+		assert.JSONEq(t, `{"labels":{"team":"b"}}`, ns.GetAnnotations()[cdcommon.AnnotationKeyManagedNamespaceMetadata])
+	})
+
+	t.Run("managed metadata is removed", func(t *testing.T) {
+		ns := createFakeNamespace(map[string]string{"foo": "bar", "team": "a"}, map[string]string{
+			"istio-injection": "enabled",
+			cdcommon.AnnotationKeyManagedNamespaceMetadata: `{"labels":{"team":"a"},"annotations":{"istio-injection":"enabled"}}`,
+		})
+		assert.True(t, syncNamespace(nil)(ns))
+		assert.Equal(t, map[string]string{"foo": "bar"}, ns.GetLabels())
+		assert.Empty(t, ns.GetAnnotations())
+	})
+}
+
+func TestContainsNamespace(t *testing.T) {
+	ns := createFakeNamespace(nil, nil)
+	assert.True(t, containsNamespace([]*unstructured.Unstructured{nil, ns}, "some-namespace"))
+	assert.False(t, containsNamespace([]*unstructured.Unstructured{ns}, "other-namespace"))
+	assert.False(t, containsNamespace([]*unstructured.Unstructured{test.NewDeployment()}, "some-namespace"))
+}
@@ -59,6 +59,49 @@ func TestPersistRevisionHistory(t *testing.T) {
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
 }
 
+func TestPersistRevisionHistoryMultipleSources(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Sources = []v1alpha1.ApplicationSource{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+	}
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+	// Ensure we record spec.sources into sync result
+	assert.Equal(t, app.Spec.Sources, opState.SyncResult.Sources)
+	assert.Equal(t, []string{"abc123", "abc123"}, opState.SyncResult.Revisions)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(updatedApp.Status.History))
+	assert.Equal(t, app.Spec.Sources, updatedApp.Status.History[0].Sources)
+	assert.Equal(t, []string{"abc123", "abc123"}, updatedApp.Status.History[0].Revisions)
+	assert.Empty(t, updatedApp.Status.History[0].Revision)
+}
+
 func TestPersistRevisionHistoryRollback(t *testing.T) {
 	app := newFakeApp()
 	app.Status.OperationState = nil
@@ -348,3 +391,42 @@ func TestNormalizeTargetResources(t *testing.T) {
 		assert.Equal(t, 2, len(containers))
 	})
 }
+
+func TestSyncImpersonationWithoutMatchingServiceAccount(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	project := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{{
+				Server:                test.FakeClusterURL,
+				Namespace:             "other-namespace",
+				DefaultServiceAccount: "deployer",
+			}},
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	assert.Equal(t, common.OperationError, opState.Phase)
+	assert.Contains(t, opState.Message, "Failed to determine the service account to impersonate: no service account is configured")
+}
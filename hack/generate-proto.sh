@@ -25,6 +25,7 @@ export GO111MODULE=off
 # '-' so that go-to-protobuf will not generate .proto files for it.
 PACKAGES=(
     github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1
+    github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1
 )
 APIMACHINERY_PKGS=(
     +k8s.io/apimachinery/pkg/util/intstr
@@ -121,7 +122,7 @@ clean_swagger() {
 }
 
 echo "If additional types are added, the number of expected collisions may need to be increased"
-EXPECTED_COLLISION_COUNT=62
+EXPECTED_COLLISION_COUNT=106
 collect_swagger server ${EXPECTED_COLLISION_COUNT}
 clean_swagger server
 clean_swagger reposerver
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	apputil "github.com/argoproj/argo-cd/v2/util/app"
 	"github.com/argoproj/argo-cd/v2/util/notification/k8s"
 
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
@@ -56,15 +57,23 @@ func NewController(
 	registry *controller.MetricsRegistry,
 	secretName string,
 	configMapName string,
+	applicationNamespaces []string,
 ) *notificationController {
 	appClient := client.Resource(applications)
-	appInformer := newInformer(appClient.Namespace(namespace), appLabelSelector)
+	var appInformer cache.SharedIndexInformer
+	if len(applicationNamespaces) > 0 {
+		// applications in other namespaces than the control plane's one need to be watched cluster-wide
+		appInformer = newInformer(appClient, appLabelSelector)
+	} else {
+		appInformer = newInformer(appClient.Namespace(namespace), appLabelSelector)
+	}
 	appProjInformer := newInformer(newAppProjClient(client, namespace), "")
 	secretInformer := k8s.NewSecretInformer(k8sClient, namespace, secretName)
 	configMapInformer := k8s.NewConfigMapInformer(k8sClient, namespace, configMapName)
 	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName), namespace, secretInformer, configMapInformer)
 
 	res := &notificationController{
+		namespace:         namespace,
 		secretInformer:    secretInformer,
 		configMapInformer: configMapInformer,
 		appInformer:       appInformer,
@@ -76,6 +85,9 @@ func NewController(
 			if !ok {
 				return false, ""
 			}
+			if !apputil.IsAppNamespaceEnabled(app.GetNamespace(), namespace, applicationNamespaces) {
+				return true, "app is not in one of the application-namespaces"
+			}
 			return !isAppSyncStatusRefreshed(app, log.WithField("app", obj.GetName())), "sync status out of date"
 		}),
 		controller.WithMetricsRegistry(registry),
@@ -89,7 +101,7 @@ func (c *notificationController) alterDestinations(obj v1.Object, destinations s
 		return destinations
 	}
 
-	if proj := getAppProj(app, c.appProjInformer); proj != nil {
+	if proj := getAppProj(app, c.namespace, c.appProjInformer); proj != nil {
 		destinations.Merge(subscriptions.NewAnnotations(proj.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 		destinations.Merge(settings.GetLegacyDestinations(proj.GetAnnotations(), cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 	}
@@ -116,6 +128,7 @@ func newInformer(resClient dynamic.ResourceInterface, selector string) cache.Sha
 }
 
 type notificationController struct {
+	namespace         string
 	apiFactory        api.Factory
 	ctrl              controller.NotificationController
 	appInformer       cache.SharedIndexInformer
@@ -140,12 +153,14 @@ func (c *notificationController) Run(ctx context.Context, processors int) {
 	c.ctrl.Run(processors, ctx.Done())
 }
 
-func getAppProj(app *unstructured.Unstructured, appProjInformer cache.SharedIndexInformer) *unstructured.Unstructured {
+// getAppProj returns the project of the given application. Projects always live in the control plane's namespace,
+// regardless of the namespace of the application.
+func getAppProj(app *unstructured.Unstructured, namespace string, appProjInformer cache.SharedIndexInformer) *unstructured.Unstructured {
 	projName, ok, err := unstructured.NestedString(app.Object, "spec", "project")
 	if !ok || err != nil {
 		return nil
 	}
-	projObj, ok, err := appProjInformer.GetIndexer().GetByKey(fmt.Sprintf("%s/%s", app.GetNamespace(), projName))
+	projObj, ok, err := appProjInformer.GetIndexer().GetByKey(fmt.Sprintf("%s/%s", namespace, projName))
 	if !ok || err != nil {
 		return nil
 	}
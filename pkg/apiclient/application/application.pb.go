@@ -49,7 +49,9 @@ type ApplicationQuery struct {
 	// the selector to restrict returned list to applications only with matched labels
 	Selector *string `protobuf:"bytes,5,opt,name=selector" json:"selector,omitempty"`
 	// the repoURL to restrict returned list applications
-	Repo                 *string  `protobuf:"bytes,6,opt,name=repo" json:"repo,omitempty"`
+	Repo *string `protobuf:"bytes,6,opt,name=repo" json:"repo,omitempty"`
+	// the application's namespace
+	AppNamespace         *string  `protobuf:"bytes,7,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -130,6 +132,13 @@ func (m *ApplicationQuery) GetRepo() string {
 	return ""
 }
 
+func (m *ApplicationQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type NodeQuery struct {
 	// the application's name
 	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -182,7 +191,10 @@ type RevisionMetadataQuery struct {
 	// the application's name
 	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	// the revision of the app
-	Revision             *string  `protobuf:"bytes,2,req,name=revision" json:"revision,omitempty"`
+	Revision *string `protobuf:"bytes,2,req,name=revision" json:"revision,omitempty"`
+	// the index of the source the revision belongs to, for applications with multiple sources
+	SourceIndex          *int32   `protobuf:"varint,3,opt,name=sourceIndex" json:"sourceIndex,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -235,12 +247,27 @@ func (m *RevisionMetadataQuery) GetRevision() string {
 	return ""
 }
 
+func (m *RevisionMetadataQuery) GetSourceIndex() int32 {
+	if m != nil && m.SourceIndex != nil {
+		return *m.SourceIndex
+	}
+	return 0
+}
+
+func (m *RevisionMetadataQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 // ApplicationEventsQuery is a query for application resource events
 type ApplicationResourceEventsQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	ResourceNamespace    *string  `protobuf:"bytes,2,opt,name=resourceNamespace" json:"resourceNamespace,omitempty"`
 	ResourceName         *string  `protobuf:"bytes,3,opt,name=resourceName" json:"resourceName,omitempty"`
 	ResourceUID          *string  `protobuf:"bytes,4,opt,name=resourceUID" json:"resourceUID,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,5,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -307,10 +334,18 @@ func (m *ApplicationResourceEventsQuery) GetResourceUID() string {
 	return ""
 }
 
+func (m *ApplicationResourceEventsQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 // ManifestQuery is a query for manifest resources
 type ApplicationManifestQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Revision             *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -363,6 +398,13 @@ func (m *ApplicationManifestQuery) GetRevision() string {
 	return ""
 }
 
+func (m *ApplicationManifestQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ApplicationResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -524,6 +566,7 @@ type ApplicationDeleteRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Cascade              *bool    `protobuf:"varint,2,opt,name=cascade" json:"cascade,omitempty"`
 	PropagationPolicy    *string  `protobuf:"bytes,3,opt,name=propagationPolicy" json:"propagationPolicy,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -583,6 +626,13 @@ func (m *ApplicationDeleteRequest) GetPropagationPolicy() string {
 	return ""
 }
 
+func (m *ApplicationDeleteRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type SyncOptions struct {
 	Items                []string `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -642,6 +692,7 @@ type ApplicationSyncRequest struct {
 	Infos                []*v1alpha1.Info                  `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
 	RetryStrategy        *v1alpha1.RetryStrategy           `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
 	SyncOptions          *SyncOptions                      `protobuf:"bytes,11,opt,name=syncOptions" json:"syncOptions,omitempty"`
+	AppNamespace         *string                           `protobuf:"bytes,12,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
 	XXX_sizecache        int32                             `json:"-"`
@@ -750,11 +801,19 @@ func (m *ApplicationSyncRequest) GetSyncOptions() *SyncOptions {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Spec                 *v1alpha1.ApplicationSpec `protobuf:"bytes,2,req,name=spec" json:"spec,omitempty"`
 	Validate             *bool                     `protobuf:"varint,3,opt,name=validate" json:"validate,omitempty"`
+	AppNamespace         *string                   `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -814,11 +873,19 @@ func (m *ApplicationUpdateSpecRequest) GetValidate() bool {
 	return false
 }
 
+func (m *ApplicationUpdateSpecRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 // ApplicationPatchRequest is a request to patch an application
 type ApplicationPatchRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Patch                *string  `protobuf:"bytes,2,req,name=patch" json:"patch,omitempty"`
 	PatchType            *string  `protobuf:"bytes,3,req,name=patchType" json:"patchType,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -878,11 +945,19 @@ func (m *ApplicationPatchRequest) GetPatchType() string {
 	return ""
 }
 
+func (m *ApplicationPatchRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ApplicationRollbackRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Id                   *int64   `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
 	DryRun               *bool    `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
 	Prune                *bool    `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,5,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -949,6 +1024,13 @@ func (m *ApplicationRollbackRequest) GetPrune() bool {
 	return false
 }
 
+func (m *ApplicationRollbackRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ApplicationResourceRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -956,6 +1038,7 @@ type ApplicationResourceRequest struct {
 	Version              *string  `protobuf:"bytes,4,req,name=version" json:"version,omitempty"`
 	Group                *string  `protobuf:"bytes,5,opt,name=group" json:"group,omitempty"`
 	Kind                 *string  `protobuf:"bytes,6,req,name=kind" json:"kind,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,7,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1036,6 +1119,13 @@ func (m *ApplicationResourceRequest) GetKind() string {
 	return ""
 }
 
+func (m *ApplicationResourceRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ApplicationResourcePatchRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -1045,6 +1135,7 @@ type ApplicationResourcePatchRequest struct {
 	Kind                 *string  `protobuf:"bytes,6,req,name=kind" json:"kind,omitempty"`
 	Patch                *string  `protobuf:"bytes,7,req,name=patch" json:"patch,omitempty"`
 	PatchType            *string  `protobuf:"bytes,8,req,name=patchType" json:"patchType,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,9,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1139,6 +1230,13 @@ func (m *ApplicationResourcePatchRequest) GetPatchType() string {
 	return ""
 }
 
+func (m *ApplicationResourcePatchRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ApplicationResourceDeleteRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -1148,6 +1246,7 @@ type ApplicationResourceDeleteRequest struct {
 	Kind                 *string  `protobuf:"bytes,6,req,name=kind" json:"kind,omitempty"`
 	Force                *bool    `protobuf:"varint,7,opt,name=force" json:"force,omitempty"`
 	Orphan               *bool    `protobuf:"varint,8,opt,name=orphan" json:"orphan,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,9,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1242,6 +1341,13 @@ func (m *ApplicationResourceDeleteRequest) GetOrphan() bool {
 	return false
 }
 
+func (m *ApplicationResourceDeleteRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ResourceActionRunRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -1250,6 +1356,7 @@ type ResourceActionRunRequest struct {
 	Group                *string  `protobuf:"bytes,5,opt,name=group" json:"group,omitempty"`
 	Kind                 *string  `protobuf:"bytes,6,req,name=kind" json:"kind,omitempty"`
 	Action               *string  `protobuf:"bytes,7,req,name=action" json:"action,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,8,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1337,6 +1444,13 @@ func (m *ResourceActionRunRequest) GetAction() string {
 	return ""
 }
 
+func (m *ResourceActionRunRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ResourceActionsListResponse struct {
 	Actions              []*v1alpha1.ResourceAction `protobuf:"bytes,1,rep,name=actions" json:"actions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
@@ -1446,6 +1560,7 @@ type ApplicationPodLogsQuery struct {
 	Group                *string  `protobuf:"bytes,12,opt,name=group" json:"group,omitempty"`
 	ResourceName         *string  `protobuf:"bytes,13,opt,name=resourceName" json:"resourceName,omitempty"`
 	Previous             *bool    `protobuf:"varint,14,opt,name=previous" json:"previous,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,15,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1582,6 +1697,13 @@ func (m *ApplicationPodLogsQuery) GetPrevious() bool {
 	return false
 }
 
+func (m *ApplicationPodLogsQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type LogEntry struct {
 	Content *string `protobuf:"bytes,1,req,name=content" json:"content,omitempty"`
 	// deprecated in favor of timeStampStr since meta.v1.Time don't support nano time
@@ -1664,6 +1786,7 @@ func (m *LogEntry) GetPodName() string {
 
 type OperationTerminateRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1709,8 +1832,16 @@ func (m *OperationTerminateRequest) GetName() string {
 	return ""
 }
 
+func (m *OperationTerminateRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ApplicationSyncWindowsQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1756,6 +1887,13 @@ func (m *ApplicationSyncWindowsQuery) GetName() string {
 	return ""
 }
 
+func (m *ApplicationSyncWindowsQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ApplicationSyncWindowsResponse struct {
 	ActiveWindows        []*ApplicationSyncWindow `protobuf:"bytes,1,rep,name=activeWindows" json:"activeWindows,omitempty"`
 	AssignedWindows      []*ApplicationSyncWindow `protobuf:"bytes,2,rep,name=assignedWindows" json:"assignedWindows,omitempty"`
@@ -1936,6 +2074,7 @@ type ResourcesQuery struct {
 	Version              *string  `protobuf:"bytes,4,opt,name=version" json:"version,omitempty"`
 	Group                *string  `protobuf:"bytes,5,opt,name=group" json:"group,omitempty"`
 	Kind                 *string  `protobuf:"bytes,6,opt,name=kind" json:"kind,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,7,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2016,6 +2155,13 @@ func (m *ResourcesQuery) GetKind() string {
 	return ""
 }
 
+func (m *ResourcesQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
 type ManagedResourcesResponse struct {
 	Items                []*v1alpha1.ResourceDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -2100,147 +2246,152 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x8f, 0x1b, 0x49,
-	0x15, 0x57, 0xcd, 0xa7, 0xfd, 0x9c, 0xcf, 0xda, 0x4d, 0xe8, 0xed, 0x4c, 0x66, 0x47, 0x95, 0xaf,
-	0xc9, 0x24, 0x63, 0x27, 0x26, 0x42, 0xd9, 0x59, 0x10, 0x64, 0x77, 0xc3, 0x6c, 0x96, 0x99, 0xd9,
-	0xd0, 0x93, 0x10, 0xb4, 0x1c, 0xa0, 0xb6, 0xbb, 0xec, 0x69, 0xc6, 0xee, 0xea, 0x74, 0xb7, 0x1d,
-	0x59, 0x21, 0x97, 0x45, 0xdc, 0x10, 0x48, 0xb0, 0x07, 0x84, 0x10, 0x42, 0xac, 0x56, 0xe2, 0x06,
-	0x5c, 0x56, 0x48, 0x5c, 0xe0, 0xc2, 0x87, 0xc4, 0x01, 0xc1, 0x3f, 0x00, 0x11, 0x27, 0x2e, 0x5c,
-	0x39, 0xa2, 0xaa, 0xae, 0x6a, 0x57, 0x7b, 0xec, 0xb6, 0xc3, 0x78, 0xb5, 0xb9, 0xf5, 0x2b, 0x57,
-	0xbd, 0xf7, 0xab, 0x57, 0xbf, 0x7a, 0xaf, 0xde, 0x93, 0xe1, 0x7c, 0xcc, 0xa2, 0x2e, 0x8b, 0x6a,
-	0x34, 0x0c, 0x5b, 0xbe, 0x4b, 0x13, 0x9f, 0x07, 0xe6, 0x77, 0x35, 0x8c, 0x78, 0xc2, 0x71, 0xc5,
-	0x18, 0xb2, 0x97, 0x9a, 0x9c, 0x37, 0x5b, 0xac, 0x46, 0x43, 0xbf, 0x46, 0x83, 0x80, 0x27, 0x72,
-	0x38, 0x4e, 0xa7, 0xda, 0x64, 0xff, 0x66, 0x5c, 0xf5, 0xb9, 0xfc, 0xd5, 0xe5, 0x11, 0xab, 0x75,
-	0xaf, 0xd7, 0x9a, 0x2c, 0x60, 0x11, 0x4d, 0x98, 0xa7, 0xe6, 0xdc, 0xe8, 0xcf, 0x69, 0x53, 0x77,
-	0xcf, 0x0f, 0x58, 0xd4, 0xab, 0x85, 0xfb, 0x4d, 0x31, 0x10, 0xd7, 0xda, 0x2c, 0xa1, 0xc3, 0x56,
-	0x6d, 0x35, 0xfd, 0x64, 0xaf, 0xf3, 0x6e, 0xd5, 0xe5, 0xed, 0x1a, 0x8d, 0x9a, 0x3c, 0x8c, 0xf8,
-	0x37, 0xe5, 0xc7, 0xba, 0xeb, 0xd5, 0xba, 0xf5, 0xbe, 0x02, 0x73, 0x2f, 0xdd, 0xeb, 0xb4, 0x15,
-	0xee, 0xd1, 0x83, 0xda, 0x6e, 0x8f, 0xd1, 0x16, 0xb1, 0x90, 0x2b, 0xdf, 0xc8, 0x4f, 0x3f, 0xe1,
-	0x51, 0xcf, 0xf8, 0x4c, 0xd5, 0x90, 0x8f, 0x10, 0x9c, 0xb8, 0xd5, 0xb7, 0xf7, 0xe5, 0x0e, 0x8b,
-	0x7a, 0x18, 0xc3, 0x5c, 0x40, 0xdb, 0xcc, 0x42, 0x2b, 0x68, 0xb5, 0xec, 0xc8, 0x6f, 0x6c, 0xc1,
-	0x62, 0xc4, 0x1a, 0x11, 0x8b, 0xf7, 0xac, 0x19, 0x39, 0xac, 0x45, 0x6c, 0x43, 0x49, 0x18, 0x67,
-	0x6e, 0x12, 0x5b, 0xb3, 0x2b, 0xb3, 0xab, 0x65, 0x27, 0x93, 0xf1, 0x2a, 0x1c, 0x8f, 0x58, 0xcc,
-	0x3b, 0x91, 0xcb, 0xbe, 0xc2, 0xa2, 0xd8, 0xe7, 0x81, 0x35, 0x27, 0x57, 0x0f, 0x0e, 0x0b, 0x2d,
-	0x31, 0x6b, 0x31, 0x37, 0xe1, 0x91, 0x35, 0x2f, 0xa7, 0x64, 0xb2, 0xc0, 0x23, 0x80, 0x5b, 0x0b,
-	0x29, 0x1e, 0xf1, 0x4d, 0x5e, 0x86, 0xf2, 0x0e, 0xf7, 0xd8, 0x48, 0xc0, 0x64, 0x13, 0x4e, 0x39,
-	0xac, 0xeb, 0x0b, 0xe5, 0xdb, 0x2c, 0xa1, 0x1e, 0x4d, 0xe8, 0xe0, 0xe4, 0x99, 0x6c, 0x77, 0x36,
-	0x94, 0x22, 0x35, 0xd9, 0x9a, 0x91, 0xe3, 0x99, 0x4c, 0x7e, 0x81, 0x60, 0xd9, 0x70, 0x91, 0xa3,
-	0x80, 0xdf, 0xee, 0xb2, 0x20, 0x89, 0x47, 0xab, 0xbc, 0x0a, 0x27, 0xf5, 0x1e, 0x77, 0x68, 0x9b,
-	0xc5, 0x21, 0x75, 0x99, 0x72, 0xdd, 0xc1, 0x1f, 0x30, 0x81, 0x23, 0xe6, 0xa0, 0x35, 0x2b, 0x27,
-	0xe6, 0xc6, 0xf0, 0x0a, 0x54, 0xb4, 0x7c, 0xff, 0xce, 0x1b, 0xca, 0x91, 0xe6, 0x10, 0x79, 0x0b,
-	0x2c, 0x03, 0xe9, 0x36, 0x0d, 0xfc, 0x06, 0x8b, 0x93, 0x49, 0xb7, 0x8d, 0x72, 0xdb, 0x3e, 0x05,
-	0x2f, 0xe4, 0x77, 0x1d, 0xf2, 0x20, 0x66, 0xe4, 0xb7, 0x28, 0x67, 0xe3, 0xf5, 0x88, 0xd1, 0x84,
-	0x39, 0xec, 0x61, 0x87, 0xc5, 0x09, 0xde, 0x07, 0xf3, 0xa6, 0x49, 0x53, 0x95, 0xfa, 0x9d, 0x6a,
-	0x9f, 0xaa, 0x55, 0x4d, 0x55, 0xf9, 0xf1, 0x75, 0xd7, 0xab, 0x76, 0xeb, 0xd5, 0x70, 0xbf, 0x59,
-	0x15, 0xc4, 0xaf, 0x9a, 0x17, 0x57, 0x13, 0xbf, 0x6a, 0x82, 0x30, 0xb5, 0xe3, 0xd3, 0xb0, 0xd0,
-	0x09, 0x63, 0x16, 0x25, 0x12, 0x7a, 0xc9, 0x51, 0x92, 0xd8, 0x54, 0x97, 0xb6, 0x7c, 0x8f, 0x26,
-	0xa9, 0x1b, 0x4b, 0x4e, 0x26, 0x93, 0x0f, 0xf2, 0xe8, 0xef, 0x87, 0xde, 0x27, 0x85, 0xde, 0x44,
-	0x39, 0x33, 0x80, 0xb2, 0x9b, 0x03, 0xf9, 0x06, 0x6b, 0xb1, 0x3e, 0xc8, 0x61, 0xc7, 0x68, 0xc1,
-	0xa2, 0x4b, 0x63, 0x97, 0x7a, 0x5a, 0x95, 0x16, 0x05, 0x09, 0xc3, 0x88, 0x87, 0xb4, 0x29, 0x35,
-	0xdd, 0xe5, 0x2d, 0xdf, 0xed, 0x29, 0x6e, 0x1d, 0xfc, 0x81, 0x9c, 0x83, 0xca, 0x6e, 0x2f, 0x70,
-	0xdf, 0x0e, 0x65, 0x40, 0xc4, 0x2f, 0xc2, 0xbc, 0x9f, 0xb0, 0x76, 0x6c, 0x21, 0x79, 0xab, 0x53,
-	0x81, 0xfc, 0x77, 0x0e, 0x4e, 0x1b, 0xe8, 0xc4, 0x82, 0x22, 0x6c, 0x05, 0x14, 0x13, 0x27, 0xe8,
-	0x45, 0x3d, 0xa7, 0x13, 0xa8, 0x73, 0x52, 0x92, 0x30, 0x1c, 0x46, 0x9d, 0x80, 0x49, 0x8a, 0x97,
-	0x9c, 0x54, 0xc0, 0x0d, 0x28, 0xc5, 0x89, 0x08, 0x81, 0xcd, 0x9e, 0x8c, 0x10, 0x95, 0xfa, 0x5b,
-	0x87, 0x3b, 0x1b, 0x01, 0x7d, 0x57, 0x69, 0x74, 0x32, 0xdd, 0xf8, 0x21, 0x94, 0xf5, 0x9d, 0x8a,
-	0xad, 0xc5, 0x95, 0xd9, 0xd5, 0x4a, 0x7d, 0xf7, 0xf0, 0x86, 0xde, 0x0e, 0x45, 0xf8, 0x36, 0xe2,
-	0x87, 0xd3, 0xb7, 0x82, 0x97, 0xa0, 0xdc, 0x56, 0x97, 0x35, 0xb6, 0x4a, 0xd2, 0xdb, 0xfd, 0x01,
-	0xfc, 0x55, 0x98, 0xf7, 0x83, 0x06, 0x8f, 0xad, 0xb2, 0x04, 0xf3, 0xda, 0xe1, 0xc0, 0xdc, 0x09,
-	0x1a, 0xdc, 0x49, 0x15, 0xe2, 0x87, 0x70, 0x34, 0x62, 0x49, 0xd4, 0xd3, 0x5e, 0xb0, 0x40, 0xfa,
-	0xf5, 0x4b, 0x87, 0xb3, 0xe0, 0x98, 0x2a, 0x9d, 0xbc, 0x05, 0xbc, 0x01, 0x95, 0xb8, 0xcf, 0x31,
-	0xab, 0x22, 0x0d, 0x5a, 0x39, 0x45, 0x06, 0x07, 0x1d, 0x73, 0x32, 0xf9, 0x35, 0x82, 0xa5, 0x03,
-	0xb7, 0x77, 0x37, 0x64, 0x85, 0x04, 0xa4, 0x30, 0x17, 0x87, 0xcc, 0x95, 0x61, 0xbd, 0x52, 0xdf,
-	0x9e, 0xda, 0x75, 0x96, 0x76, 0xa5, 0xea, 0xc2, 0x88, 0x43, 0xe1, 0x53, 0xc6, 0xa2, 0xbb, 0x34,
-	0x71, 0xf7, 0x8a, 0xd0, 0x0a, 0xea, 0x8b, 0x39, 0x2a, 0x0b, 0xa5, 0x82, 0xe0, 0x87, 0xfc, 0xb8,
-	0xd7, 0x0b, 0x85, 0x05, 0xf1, 0x4b, 0x7f, 0x80, 0x04, 0x60, 0x9b, 0x61, 0x86, 0xb7, 0x5a, 0xef,
-	0x52, 0x77, 0xbf, 0xc8, 0xca, 0x31, 0x98, 0xf1, 0x3d, 0x69, 0x62, 0xd6, 0x99, 0xf1, 0xbd, 0x67,
-	0xbb, 0x88, 0xe2, 0xcd, 0x60, 0x0f, 0x49, 0x88, 0x45, 0x06, 0x97, 0xa0, 0x1c, 0x0c, 0x24, 0xc1,
-	0xfe, 0xc0, 0x90, 0xe4, 0x37, 0x73, 0x20, 0xf9, 0x59, 0xb0, 0xd8, 0xcd, 0x5e, 0x10, 0xe2, 0x67,
-	0x2d, 0x0a, 0x90, 0xcd, 0x88, 0x77, 0x42, 0xf5, 0x6c, 0x48, 0x05, 0x81, 0x62, 0xdf, 0x0f, 0x3c,
-	0x6b, 0x21, 0x45, 0x21, 0xbe, 0xc9, 0x7f, 0x10, 0xbc, 0x3c, 0x04, 0xf8, 0xd8, 0x43, 0x79, 0x2e,
-	0xd0, 0xf7, 0xa9, 0xb1, 0x38, 0x92, 0x1a, 0xa5, 0x41, 0x6a, 0xfc, 0x1b, 0xc1, 0xca, 0x90, 0x1d,
-	0x8f, 0x4f, 0x29, 0xcf, 0xcd, 0x96, 0x1b, 0x3c, 0x72, 0x99, 0xb5, 0x98, 0xf2, 0x4f, 0x0a, 0x82,
-	0xad, 0x3c, 0x0a, 0xf7, 0x68, 0x60, 0x95, 0x52, 0xb6, 0xa6, 0x12, 0xf9, 0x0b, 0x02, 0x4b, 0xef,
-	0xf0, 0x96, 0x2b, 0xf7, 0xdb, 0x09, 0x9e, 0xff, 0x4d, 0x9e, 0x86, 0x05, 0x2a, 0xd1, 0xaa, 0x83,
-	0x55, 0x12, 0xf9, 0x0e, 0x82, 0x33, 0xf9, 0xed, 0xc4, 0x5b, 0x7e, 0x9c, 0xe8, 0x97, 0x18, 0x6e,
-	0xc0, 0x62, 0x3a, 0x33, 0x4d, 0xd0, 0x95, 0xfa, 0xd6, 0x61, 0xc3, 0x76, 0xce, 0x75, 0x5a, 0x39,
-	0x79, 0x05, 0xce, 0x0c, 0xbd, 0xed, 0x0a, 0x86, 0x0d, 0x25, 0x9d, 0xaa, 0x94, 0x73, 0x33, 0x99,
-	0xfc, 0x71, 0x36, 0x1f, 0xfd, 0xb8, 0xb7, 0xc5, 0x9b, 0x05, 0x6f, 0xe6, 0xe2, 0x03, 0xb1, 0x60,
-	0x31, 0xe4, 0x9e, 0xf1, 0x3c, 0xd6, 0xa2, 0x58, 0xe7, 0xf2, 0x20, 0xa1, 0xa2, 0x12, 0x53, 0xef,
-	0xe2, 0xfe, 0x80, 0x38, 0xc8, 0xd8, 0x0f, 0x5c, 0xb6, 0xcb, 0x5c, 0x1e, 0x78, 0xb1, 0x3c, 0x91,
-	0x59, 0x27, 0x37, 0x86, 0xdf, 0x84, 0xb2, 0x94, 0xef, 0xf9, 0x6d, 0x26, 0xeb, 0x8c, 0x4a, 0x7d,
-	0xad, 0x9a, 0x96, 0x79, 0x55, 0xb3, 0xcc, 0xeb, 0xfb, 0x50, 0x94, 0x79, 0xd5, 0xee, 0xf5, 0xaa,
-	0x58, 0xe1, 0xf4, 0x17, 0x0b, 0x2c, 0x09, 0xf5, 0x5b, 0x5b, 0x7e, 0x20, 0x9f, 0x0f, 0xc2, 0x54,
-	0x7f, 0x40, 0x1c, 0x76, 0x83, 0xb7, 0x5a, 0xfc, 0x91, 0xe6, 0x6e, 0x2a, 0x89, 0x55, 0x9d, 0x20,
-	0xf1, 0x5b, 0xd2, 0x7e, 0x39, 0xdd, 0x41, 0x36, 0x20, 0x57, 0xf9, 0xad, 0x84, 0x45, 0x32, 0x41,
-	0x97, 0x1d, 0x25, 0x65, 0x74, 0xaa, 0xa4, 0x75, 0x8f, 0xbe, 0x33, 0x29, 0xf1, 0x8e, 0x98, 0xc4,
-	0x1b, 0x24, 0xf3, 0xd1, 0x21, 0xf5, 0x85, 0x2c, 0xe4, 0x58, 0xd7, 0xe7, 0x9d, 0xd8, 0x3a, 0x96,
-	0xa6, 0x31, 0x2d, 0x93, 0xdf, 0x21, 0x28, 0x6d, 0xf1, 0xe6, 0xed, 0x20, 0x89, 0x7a, 0xf2, 0xbd,
-	0xc9, 0x83, 0x84, 0x05, 0xfa, 0xc4, 0xb5, 0x28, 0xdc, 0x98, 0xf8, 0x6d, 0xb6, 0x9b, 0xd0, 0x76,
-	0xa8, 0x32, 0xee, 0x33, 0xb9, 0x31, 0x5b, 0x2c, 0xb6, 0xd6, 0xa2, 0x71, 0x22, 0x6f, 0x5d, 0xc9,
-	0x91, 0xdf, 0x62, 0x13, 0xd9, 0x84, 0xdd, 0x24, 0x52, 0x57, 0x2e, 0x37, 0x66, 0x92, 0x64, 0x3e,
-	0xc5, 0xa6, 0x44, 0x52, 0x83, 0x97, 0xb2, 0x47, 0xd8, 0x3d, 0x16, 0xb5, 0xfd, 0x80, 0x16, 0xc6,
-	0x40, 0x72, 0x3d, 0x47, 0x7c, 0xf1, 0x2a, 0x79, 0xe0, 0x07, 0x1e, 0x7f, 0x34, 0x9a, 0xc0, 0xe4,
-	0x6f, 0xf9, 0x5a, 0xd1, 0x58, 0x93, 0xdd, 0x97, 0x37, 0xe1, 0xa8, 0xb8, 0x59, 0x5d, 0xa6, 0x7e,
-	0x50, 0x97, 0x97, 0xe4, 0x2e, 0xe5, 0x50, 0x1d, 0x4e, 0x7e, 0x21, 0xde, 0x82, 0xe3, 0x34, 0x8e,
-	0xfd, 0x66, 0xc0, 0x3c, 0xad, 0x6b, 0x66, 0x62, 0x5d, 0x83, 0x4b, 0xd3, 0x22, 0x42, 0xce, 0x50,
-	0x3e, 0xd7, 0x22, 0xf9, 0x36, 0x82, 0x53, 0x43, 0x95, 0x64, 0xfc, 0x43, 0x46, 0x38, 0x13, 0x85,
-	0xbc, 0xbb, 0xc7, 0xbc, 0x4e, 0x8b, 0xe9, 0x52, 0x5a, 0xcb, 0xe2, 0x37, 0xaf, 0x93, 0x9e, 0x80,
-	0x0a, 0xa7, 0x99, 0x8c, 0x97, 0x01, 0xda, 0x34, 0xe8, 0xd0, 0x96, 0x84, 0x30, 0x27, 0x21, 0x18,
-	0x23, 0x64, 0x09, 0xec, 0x61, 0xc7, 0xa7, 0xca, 0xd2, 0x5f, 0x21, 0x38, 0xa6, 0x43, 0x93, 0x3a,
-	0x9f, 0x55, 0x38, 0x6e, 0xb8, 0x61, 0xa7, 0x7f, 0x54, 0x83, 0xc3, 0x63, 0xc2, 0x8e, 0x3e, 0xe7,
-	0xd9, 0x7c, 0x37, 0xa4, 0x9b, 0xeb, 0x67, 0x4c, 0x1c, 0xf7, 0xb3, 0x8b, 0x4a, 0xbe, 0x05, 0xd6,
-	0x36, 0x0d, 0x68, 0x93, 0x79, 0x19, 0xf0, 0x8c, 0x24, 0xdf, 0x30, 0x4b, 0xaf, 0x43, 0x17, 0x3a,
-	0x59, 0xda, 0xf7, 0x1b, 0x0d, 0x55, 0xc6, 0xd5, 0xff, 0xb9, 0x0c, 0xd8, 0x3c, 0x54, 0x16, 0x75,
-	0x7d, 0x97, 0xe1, 0x1f, 0x20, 0x98, 0x13, 0x59, 0x06, 0x9f, 0x1d, 0xc5, 0x21, 0xe9, 0x5c, 0x7b,
-	0x7a, 0xef, 0x68, 0x61, 0x8d, 0x2c, 0xbd, 0xf7, 0xf7, 0x7f, 0xfd, 0x70, 0xe6, 0x34, 0x7e, 0x51,
-	0xf6, 0xdd, 0xba, 0xd7, 0xcd, 0x1e, 0x58, 0x8c, 0xbf, 0x8b, 0x00, 0xab, 0xd4, 0x67, 0xb4, 0x5e,
-	0xf0, 0x95, 0x51, 0x10, 0x87, 0xb4, 0x68, 0xec, 0xb3, 0x46, 0x18, 0xaa, 0xba, 0x3c, 0x62, 0x22,
-	0xe8, 0xc8, 0x09, 0x12, 0xc0, 0x9a, 0x04, 0x70, 0x1e, 0x93, 0x61, 0x00, 0x6a, 0x8f, 0xc5, 0xa1,
-	0x3f, 0xa9, 0xb1, 0xd4, 0xee, 0xcf, 0x11, 0xcc, 0x3f, 0x90, 0x8f, 0xaf, 0x31, 0x4e, 0xda, 0x9d,
-	0x9a, 0x93, 0xa4, 0x39, 0x89, 0x96, 0x9c, 0x93, 0x48, 0xcf, 0xe2, 0x33, 0x1a, 0x69, 0x9c, 0x44,
-	0x8c, 0xb6, 0x73, 0x80, 0xaf, 0x21, 0xfc, 0x21, 0x82, 0x85, 0xb4, 0x39, 0x83, 0x2f, 0x8c, 0x42,
-	0x99, 0x6b, 0xde, 0xd8, 0xd3, 0xeb, 0x74, 0x90, 0xcb, 0x12, 0xe3, 0x39, 0x32, 0xf4, 0x38, 0x37,
-	0x72, 0x7d, 0x90, 0xf7, 0x11, 0xcc, 0x6e, 0xb2, 0xb1, 0x7c, 0x9b, 0x22, 0xb8, 0x03, 0x0e, 0x1c,
-	0x72, 0xd4, 0xf8, 0x03, 0x04, 0x2f, 0x6d, 0xb2, 0x64, 0x78, 0x2c, 0xc7, 0xab, 0xe3, 0x03, 0xac,
-	0xa2, 0xdd, 0x95, 0x09, 0x66, 0x66, 0x41, 0xac, 0x26, 0x91, 0x5d, 0xc6, 0x97, 0x8a, 0x48, 0x28,
-	0x0a, 0xe2, 0x47, 0x0a, 0xc7, 0x9f, 0x11, 0x9c, 0x18, 0x6c, 0x72, 0xe2, 0x7c, 0xf4, 0x1f, 0xda,
-	0x03, 0xb5, 0x77, 0x0e, 0x1b, 0x50, 0xf2, 0x4a, 0xc9, 0x2d, 0x89, 0xfc, 0x55, 0xfc, 0x4a, 0x11,
-	0x72, 0xdd, 0xf7, 0x89, 0x6b, 0x8f, 0xf5, 0xe7, 0x13, 0xd9, 0x2d, 0x97, 0xb0, 0xdf, 0x43, 0x70,
-	0x64, 0x93, 0x25, 0xdb, 0x59, 0xdb, 0x63, 0x24, 0x6d, 0x73, 0x7d, 0x4d, 0x7b, 0xa9, 0x6a, 0x34,
-	0xb5, 0xf5, 0x4f, 0x99, 0x4b, 0xd7, 0x25, 0xb0, 0x4b, 0xf8, 0x42, 0x11, 0xb0, 0x7e, 0xab, 0xe5,
-	0xf7, 0x08, 0x16, 0xd2, 0xb6, 0xc2, 0x68, 0xf3, 0xb9, 0xa6, 0xe1, 0x34, 0x89, 0x79, 0x5b, 0x62,
-	0xfd, 0xbc, 0x7d, 0x6d, 0x38, 0x56, 0x73, 0xbd, 0xf6, 0x5a, 0x55, 0x6e, 0x20, 0x7f, 0xa3, 0x3e,
-	0x42, 0x00, 0xfd, 0xd6, 0x08, 0xbe, 0x5c, 0xbc, 0x0f, 0xa3, 0x7d, 0x62, 0x4f, 0xb7, 0x39, 0x42,
-	0xaa, 0x72, 0x3f, 0xab, 0xf6, 0x4a, 0x21, 0x9d, 0x43, 0xe6, 0x6e, 0xa4, 0x6d, 0x94, 0x9f, 0x21,
-	0x98, 0x97, 0xb5, 0x38, 0x3e, 0x3f, 0x0a, 0xb3, 0x59, 0xaa, 0x4f, 0xd3, 0xf5, 0x17, 0x25, 0xd4,
-	0x95, 0x7a, 0x51, 0x4c, 0xd8, 0x40, 0x6b, 0xb8, 0x0b, 0x0b, 0x69, 0xed, 0x3c, 0x9a, 0x1e, 0xb9,
-	0xda, 0xda, 0x5e, 0x29, 0xc8, 0x51, 0x29, 0x43, 0x55, 0x38, 0x5a, 0x1b, 0x17, 0x8e, 0xe6, 0x44,
-	0xc4, 0xc0, 0xe7, 0x8a, 0xe2, 0xc9, 0xc7, 0xe0, 0x98, 0x2b, 0x12, 0xdd, 0x05, 0xb2, 0x32, 0x2e,
-	0x24, 0x09, 0xef, 0xfc, 0x08, 0xc1, 0x89, 0xc1, 0x27, 0x0d, 0x3e, 0x33, 0x10, 0x8e, 0xcc, 0x37,
-	0x9a, 0x9d, 0xf7, 0xe2, 0xa8, 0xe7, 0x10, 0xf9, 0x82, 0x44, 0xb1, 0x81, 0x6f, 0x8e, 0xbd, 0x19,
-	0x3b, 0xfa, 0x42, 0x0b, 0x45, 0xeb, 0xfd, 0x0e, 0xeb, 0x6f, 0x10, 0x1c, 0xd1, 0x7a, 0xef, 0x45,
-	0x8c, 0x15, 0xc3, 0x9a, 0xde, 0x45, 0x10, 0xb6, 0xc8, 0x67, 0x25, 0xfc, 0xcf, 0xe0, 0x1b, 0x13,
-	0xc2, 0xd7, 0xb0, 0xd7, 0x13, 0x81, 0xf4, 0x0f, 0x08, 0x4e, 0x3e, 0x48, 0x79, 0xff, 0x09, 0xe1,
-	0x7f, 0x5d, 0xe2, 0xff, 0x1c, 0x7e, 0xb5, 0xe0, 0xc9, 0x31, 0x6e, 0x1b, 0xd7, 0x10, 0xfe, 0x25,
-	0x82, 0x92, 0xee, 0x4e, 0xe2, 0x4b, 0x23, 0x2f, 0x46, 0xbe, 0x7f, 0x39, 0x4d, 0x32, 0xab, 0xfc,
-	0x4a, 0xce, 0x17, 0x66, 0x29, 0x65, 0x5f, 0x10, 0xfa, 0x7d, 0x04, 0x38, 0xab, 0x35, 0xb2, 0xea,
-	0x03, 0x5f, 0xcc, 0x99, 0x1a, 0x59, 0x54, 0xda, 0x97, 0xc6, 0xce, 0xcb, 0x67, 0xa9, 0xb5, 0xc2,
-	0x2c, 0xc5, 0x33, 0xfb, 0xdf, 0x43, 0x50, 0xd9, 0x64, 0xd9, 0x73, 0xb8, 0xc0, 0x97, 0xf9, 0xd6,
-	0xac, 0xbd, 0x3a, 0x7e, 0xa2, 0x42, 0x74, 0x55, 0x22, 0xba, 0x88, 0x8b, 0x5d, 0xa5, 0x01, 0xfc,
-	0x04, 0xc1, 0xd1, 0xbb, 0x26, 0x45, 0xf1, 0xd5, 0x71, 0x96, 0x72, 0x91, 0x7c, 0x72, 0x5c, 0x9f,
-	0x96, 0xb8, 0xd6, 0xc9, 0x44, 0xb8, 0x36, 0x54, 0x8f, 0xf4, 0xa7, 0x08, 0x5e, 0x30, 0xeb, 0x07,
-	0xd5, 0x4d, 0xfb, 0x7f, 0xfd, 0x56, 0xd0, 0x94, 0x23, 0x37, 0x24, 0xbe, 0x2a, 0xbe, 0x3a, 0x09,
-	0xbe, 0x9a, 0x6a, 0xb1, 0xe1, 0x1f, 0x23, 0x38, 0x29, 0x7b, 0x95, 0xa6, 0xe2, 0x81, 0x14, 0x33,
-	0xaa, 0xb3, 0x39, 0x41, 0x8a, 0x51, 0xf1, 0x87, 0x3c, 0x13, 0xa8, 0x0d, 0xd5, 0x87, 0xc4, 0xdf,
-	0x47, 0x70, 0x4c, 0x27, 0x35, 0x75, 0xba, 0xeb, 0xe3, 0x1c, 0xf7, 0xac, 0x49, 0x50, 0xd1, 0x6d,
-	0x6d, 0x32, 0xba, 0x7d, 0x88, 0x60, 0x51, 0xf5, 0x12, 0x0b, 0x9e, 0x0a, 0x46, 0xb3, 0xd1, 0x3e,
-	0x95, 0x9b, 0xa5, 0x1b, 0x59, 0xe4, 0x6b, 0xd2, 0xec, 0x7d, 0x5c, 0x2b, 0x32, 0x1b, 0x72, 0x2f,
-	0xae, 0x3d, 0x56, 0x5d, 0xa4, 0x27, 0xb5, 0x16, 0x6f, 0xc6, 0xef, 0x10, 0x5c, 0x98, 0x10, 0xc5,
-	0x9c, 0x6b, 0xe8, 0xb5, 0x2f, 0xfe, 0xe9, 0xe9, 0x32, 0xfa, 0xeb, 0xd3, 0x65, 0xf4, 0x8f, 0xa7,
-	0xcb, 0xe8, 0x9d, 0x9b, 0x93, 0xfd, 0xff, 0xc3, 0x6d, 0xf9, 0x2c, 0x48, 0x4c, 0xb5, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0xa3, 0x87, 0x84, 0x43, 0xe5, 0x22, 0x00, 0x00,
+	// 2319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0x57, 0xcd, 0x7e, 0xcd, 0xbc, 0xf1, 0x67, 0x25, 0x36, 0x9d, 0xf6, 0x7a, 0x33, 0x6a, 0x7f,
+	0xad, 0xd7, 0xde, 0x1e, 0x7b, 0xb0, 0x90, 0xb3, 0x01, 0x81, 0x93, 0x18, 0xc7, 0xb0, 0x76, 0x4c,
+	0xaf, 0x8d, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x66, 0xb6, 0xd9, 0x99, 0xae, 0x76, 0x77, 0xcf, 0x98,
+	0x91, 0xf1, 0x25, 0x88, 0x1b, 0x04, 0x29, 0xc9, 0x01, 0x45, 0x11, 0x42, 0x44, 0x39, 0x73, 0x43,
+	0x48, 0x5c, 0xe0, 0x82, 0x40, 0xe2, 0x80, 0xf8, 0xb8, 0xe4, 0x04, 0x16, 0x37, 0x2e, 0xfc, 0x09,
+	0xa8, 0xaa, 0xab, 0xbb, 0xab, 0x67, 0x7a, 0x7a, 0xc6, 0xec, 0x44, 0xf1, 0xad, 0x5f, 0x4d, 0xd5,
+	0x7b, 0xbf, 0x7a, 0xf5, 0x3e, 0xaa, 0x7e, 0xbb, 0x70, 0x3a, 0xa4, 0xc1, 0x80, 0x06, 0x4d, 0xe2,
+	0xfb, 0x5d, 0xd7, 0x26, 0x91, 0xcb, 0x3c, 0xf5, 0xdb, 0xf4, 0x03, 0x16, 0x31, 0x5c, 0x57, 0x86,
+	0xf4, 0xd5, 0x0e, 0x63, 0x9d, 0x2e, 0x6d, 0x12, 0xdf, 0x6d, 0x12, 0xcf, 0x63, 0x91, 0x18, 0x0e,
+	0xe3, 0xa9, 0xba, 0xb1, 0x77, 0x35, 0x34, 0x5d, 0x26, 0x7e, 0xb5, 0x59, 0x40, 0x9b, 0x83, 0xcb,
+	0xcd, 0x0e, 0xf5, 0x68, 0x40, 0x22, 0xea, 0xc8, 0x39, 0x57, 0xb2, 0x39, 0x3d, 0x62, 0xef, 0xba,
+	0x1e, 0x0d, 0x86, 0x4d, 0x7f, 0xaf, 0xc3, 0x07, 0xc2, 0x66, 0x8f, 0x46, 0xa4, 0x68, 0xd5, 0x76,
+	0xc7, 0x8d, 0x76, 0xfb, 0x6f, 0x99, 0x36, 0xeb, 0x35, 0x49, 0xd0, 0x61, 0x7e, 0xc0, 0xbe, 0x27,
+	0x3e, 0x36, 0x6d, 0xa7, 0x39, 0x68, 0x65, 0x0a, 0xd4, 0xbd, 0x0c, 0x2e, 0x93, 0xae, 0xbf, 0x4b,
+	0xc6, 0xb5, 0x5d, 0x9f, 0xa2, 0x2d, 0xa0, 0x3e, 0x93, 0xbe, 0x11, 0x9f, 0x6e, 0xc4, 0x82, 0xa1,
+	0xf2, 0x19, 0xab, 0x31, 0x3e, 0x41, 0x70, 0xe4, 0x5a, 0x66, 0xef, 0x1b, 0x7d, 0x1a, 0x0c, 0x31,
+	0x86, 0x45, 0x8f, 0xf4, 0xa8, 0x86, 0x1a, 0x68, 0xbd, 0x66, 0x89, 0x6f, 0xac, 0xc1, 0x4a, 0x40,
+	0xdb, 0x01, 0x0d, 0x77, 0xb5, 0x8a, 0x18, 0x4e, 0x44, 0xac, 0x43, 0x95, 0x1b, 0xa7, 0x76, 0x14,
+	0x6a, 0x0b, 0x8d, 0x85, 0xf5, 0x9a, 0x95, 0xca, 0x78, 0x1d, 0x0e, 0x07, 0x34, 0x64, 0xfd, 0xc0,
+	0xa6, 0xdf, 0xa4, 0x41, 0xe8, 0x32, 0x4f, 0x5b, 0x14, 0xab, 0x47, 0x87, 0xb9, 0x96, 0x90, 0x76,
+	0xa9, 0x1d, 0xb1, 0x40, 0x5b, 0x12, 0x53, 0x52, 0x99, 0xe3, 0xe1, 0xc0, 0xb5, 0xe5, 0x18, 0x0f,
+	0xff, 0xc6, 0x06, 0x1c, 0x20, 0xbe, 0x7f, 0x9b, 0xf4, 0x68, 0xe8, 0x13, 0x9b, 0x6a, 0x2b, 0xe2,
+	0xb7, 0xdc, 0x98, 0xf1, 0x22, 0xd4, 0x6e, 0x33, 0x87, 0x4e, 0xdc, 0x94, 0xf1, 0x13, 0x04, 0xc7,
+	0x2c, 0x3a, 0x70, 0x39, 0x82, 0x5b, 0x34, 0x22, 0x0e, 0x89, 0xc8, 0xe8, 0xec, 0x4a, 0xea, 0x02,
+	0x1d, 0xaa, 0x81, 0x9c, 0xac, 0x55, 0xc4, 0x78, 0x2a, 0xe3, 0x06, 0xd4, 0xe3, 0xfd, 0xdc, 0xf4,
+	0x1c, 0xfa, 0x7d, 0x6d, 0xa1, 0x81, 0xd6, 0x97, 0x2c, 0x75, 0x68, 0x0c, 0xf0, 0x62, 0x01, 0xe0,
+	0x3f, 0x23, 0x58, 0x53, 0x4e, 0xc3, 0x92, 0x3e, 0xba, 0x3e, 0xa0, 0x5e, 0x14, 0x4e, 0x06, 0x76,
+	0x11, 0x8e, 0x26, 0xee, 0xcc, 0xf4, 0xc7, 0xa7, 0x34, 0xfe, 0x03, 0x07, 0xa2, 0x0e, 0x0a, 0xac,
+	0x35, 0x2b, 0x37, 0xc6, 0xb7, 0x93, 0xc8, 0xf7, 0x6e, 0xbe, 0x26, 0xb1, 0xaa, 0x43, 0x63, 0xdb,
+	0x59, 0x2a, 0xd8, 0x8e, 0x07, 0x9a, 0xb2, 0x9b, 0x5b, 0xc4, 0x73, 0xdb, 0x34, 0x8c, 0x66, 0x75,
+	0x30, 0xca, 0x39, 0x78, 0xd4, 0xde, 0x42, 0x81, 0xbd, 0x63, 0xf0, 0x5c, 0xde, 0x7b, 0x3e, 0xf3,
+	0x42, 0x6a, 0xfc, 0x16, 0xe5, 0x70, 0xbc, 0x1a, 0x50, 0x12, 0x51, 0x8b, 0x3e, 0xe8, 0xd3, 0x30,
+	0xc2, 0x7b, 0xa0, 0x16, 0x07, 0x01, 0xa7, 0xde, 0xba, 0x69, 0x66, 0xd9, 0x65, 0x26, 0xd9, 0x25,
+	0x3e, 0xbe, 0x63, 0x3b, 0xe6, 0xa0, 0x65, 0xfa, 0x7b, 0x1d, 0x93, 0xe7, 0xaa, 0xa9, 0xac, 0x35,
+	0x93, 0x5c, 0x35, 0x55, 0x10, 0xaa, 0x76, 0x7c, 0x1c, 0x96, 0xfb, 0x7e, 0x48, 0x83, 0x48, 0x6c,
+	0xaf, 0x6a, 0x49, 0x89, 0x6f, 0x7c, 0x40, 0xba, 0xae, 0x43, 0xa2, 0x78, 0x63, 0x55, 0x2b, 0x95,
+	0x8d, 0x8f, 0xf2, 0xe8, 0xef, 0xf9, 0xce, 0x67, 0x85, 0x5e, 0x45, 0x59, 0x19, 0x41, 0xf9, 0x41,
+	0x1e, 0xe5, 0x6b, 0xb4, 0x4b, 0x33, 0x94, 0x45, 0x67, 0xad, 0xc1, 0x8a, 0x4d, 0x42, 0x9b, 0x38,
+	0x89, 0xae, 0x44, 0xe4, 0xd1, 0xec, 0x07, 0xcc, 0x27, 0x1d, 0xa1, 0xe9, 0x0e, 0xeb, 0xba, 0xf6,
+	0x50, 0x1e, 0xf7, 0xf8, 0x0f, 0x33, 0xa5, 0xd5, 0x29, 0xa8, 0xef, 0x0c, 0x3d, 0xfb, 0x0d, 0x9f,
+	0xaf, 0x0b, 0xf1, 0xf3, 0xb0, 0xe4, 0x46, 0xb4, 0x17, 0x6a, 0x48, 0x54, 0xab, 0x58, 0x30, 0x3e,
+	0x5c, 0x82, 0xe3, 0xca, 0x0e, 0xf8, 0x82, 0x32, 0xfc, 0x65, 0xb1, 0x7a, 0x1c, 0x96, 0x9d, 0x60,
+	0x68, 0xf5, 0x3d, 0x79, 0x98, 0x52, 0xe2, 0x86, 0xfd, 0xa0, 0xef, 0xc5, 0x20, 0xab, 0x56, 0x2c,
+	0xe0, 0x36, 0x54, 0xc3, 0x88, 0x97, 0xf6, 0xce, 0x50, 0x64, 0x51, 0xbd, 0xf5, 0xb5, 0xfd, 0x1d,
+	0x20, 0x87, 0xbe, 0x23, 0x35, 0x5a, 0xa9, 0x6e, 0xfc, 0x00, 0x6a, 0x49, 0x02, 0x87, 0xda, 0x4a,
+	0x63, 0x61, 0xbd, 0xde, 0xda, 0xd9, 0xbf, 0xa1, 0x37, 0x7c, 0x1a, 0xc4, 0xb1, 0x22, 0x75, 0x5b,
+	0x99, 0x15, 0xbc, 0x0a, 0xb5, 0x9e, 0xcc, 0xfa, 0x50, 0xab, 0x0a, 0x6f, 0x67, 0x03, 0xf8, 0x5b,
+	0xb0, 0xe4, 0x7a, 0x6d, 0x16, 0x6a, 0x35, 0x01, 0xe6, 0x95, 0xfd, 0x81, 0xb9, 0xe9, 0xb5, 0x99,
+	0x15, 0x2b, 0xc4, 0x0f, 0xe0, 0x60, 0x40, 0xa3, 0x60, 0x98, 0x78, 0x41, 0x03, 0xe1, 0xd7, 0xaf,
+	0xef, 0xcf, 0x82, 0xa5, 0xaa, 0xb4, 0xf2, 0x16, 0xf0, 0x16, 0xd4, 0xc3, 0x2c, 0xc6, 0xb4, 0xba,
+	0x30, 0xa8, 0xe5, 0x14, 0x29, 0x31, 0x68, 0xa9, 0x93, 0xc7, 0x62, 0xf8, 0x40, 0x41, 0x0c, 0xff,
+	0x03, 0xc1, 0xea, 0x58, 0x19, 0xd8, 0xf1, 0x69, 0x69, 0x90, 0x12, 0x58, 0x0c, 0x7d, 0x6a, 0x8b,
+	0x6e, 0x55, 0x6f, 0xdd, 0x9a, 0x5b, 0x5d, 0x10, 0x76, 0x85, 0xea, 0xb2, 0xd2, 0x35, 0x53, 0x6e,
+	0xfe, 0x08, 0xc1, 0xe7, 0x14, 0xcd, 0x77, 0x48, 0x64, 0xef, 0x96, 0x6d, 0x89, 0xe7, 0x10, 0x9f,
+	0x23, 0x3b, 0x70, 0x2c, 0xf0, 0x40, 0x13, 0x1f, 0x77, 0x87, 0x3e, 0x87, 0xc1, 0x7f, 0xc9, 0x06,
+	0x66, 0xc2, 0xf1, 0x2e, 0x02, 0x5d, 0xad, 0x7c, 0xac, 0xdb, 0x7d, 0x8b, 0xd8, 0x7b, 0x65, 0x50,
+	0x0e, 0x41, 0xc5, 0x75, 0x04, 0x8e, 0x05, 0xab, 0xe2, 0x3a, 0x4f, 0x99, 0xf6, 0xb3, 0x34, 0xd0,
+	0x4f, 0x46, 0x40, 0x25, 0x29, 0x56, 0x02, 0x6a, 0x15, 0x6a, 0xde, 0xc8, 0x1d, 0x20, 0x1b, 0x28,
+	0xe8, 0xfd, 0x95, 0xb1, 0xde, 0xaf, 0xc1, 0xca, 0x20, 0xbd, 0xab, 0xf1, 0x9f, 0x13, 0x91, 0x6f,
+	0xa4, 0x13, 0xb0, 0xbe, 0x2f, 0xb1, 0xc6, 0x02, 0x47, 0xb1, 0xe7, 0x7a, 0x8e, 0xb6, 0x1c, 0xa3,
+	0xe0, 0xdf, 0x33, 0xdd, 0xce, 0xde, 0xab, 0xc0, 0x8b, 0x05, 0x9b, 0x9b, 0x1a, 0x01, 0xcf, 0xc6,
+	0x0e, 0xd3, 0x38, 0x5c, 0x99, 0x18, 0x87, 0xd5, 0x69, 0x71, 0x58, 0x2b, 0xf0, 0xca, 0x3b, 0x15,
+	0x68, 0x14, 0x78, 0x65, 0x7a, 0x43, 0x7d, 0x66, 0xdc, 0xd2, 0x66, 0x81, 0x3c, 0xf1, 0xaa, 0x15,
+	0x0b, 0x3c, 0x33, 0x58, 0xe0, 0xef, 0x12, 0x4f, 0xab, 0xc6, 0x99, 0x11, 0x4b, 0x33, 0x39, 0xe4,
+	0xbf, 0x08, 0xb4, 0xc4, 0x0b, 0xd7, 0x6c, 0xe1, 0x93, 0xbe, 0xf7, 0xec, 0x3b, 0xe2, 0x38, 0x2c,
+	0x13, 0x81, 0x56, 0x06, 0x88, 0x94, 0xc6, 0xb6, 0x5c, 0x2d, 0xae, 0x89, 0x27, 0xf2, 0x5b, 0x0e,
+	0xb7, 0xdd, 0x30, 0x4a, 0x2e, 0xb4, 0xb8, 0x0d, 0x2b, 0xb1, 0xb6, 0xf8, 0x0a, 0x53, 0x6f, 0x6d,
+	0xef, 0xb7, 0xb1, 0xe5, 0xdc, 0x9b, 0x28, 0x37, 0x5e, 0x82, 0x13, 0x85, 0xd5, 0x47, 0xc2, 0xd0,
+	0xa1, 0x9a, 0x34, 0x73, 0x79, 0x00, 0xa9, 0x6c, 0xfc, 0x67, 0x21, 0x5f, 0xd6, 0x99, 0xb3, 0xcd,
+	0x3a, 0x25, 0x4f, 0x98, 0xf2, 0x43, 0xd3, 0x60, 0xc5, 0x67, 0x8e, 0xf2, 0x5a, 0x49, 0x44, 0xbe,
+	0xce, 0x66, 0x5e, 0x44, 0x5c, 0x8f, 0x06, 0xb2, 0xae, 0x67, 0x03, 0xdc, 0xd9, 0xa1, 0xeb, 0xd9,
+	0x74, 0x87, 0xda, 0xcc, 0x73, 0x42, 0x71, 0x6a, 0x0b, 0x56, 0x6e, 0x0c, 0xbf, 0x0e, 0x35, 0x21,
+	0xdf, 0x75, 0x7b, 0x54, 0xbc, 0x30, 0xeb, 0xad, 0x0d, 0x33, 0x7e, 0xe0, 0x9b, 0xea, 0x03, 0x3f,
+	0xf3, 0x21, 0x7f, 0xe0, 0x9b, 0x83, 0xcb, 0x26, 0x5f, 0x61, 0x65, 0x8b, 0x39, 0x96, 0x88, 0xb8,
+	0xdd, 0x6d, 0xd7, 0x13, 0x17, 0x2c, 0x6e, 0x2a, 0x1b, 0xe0, 0x01, 0xd1, 0x66, 0xdd, 0x2e, 0x7b,
+	0x98, 0xe4, 0x40, 0x2c, 0xf1, 0x55, 0x7d, 0x2f, 0x72, 0xbb, 0xc2, 0x7e, 0x9c, 0x00, 0xd9, 0x80,
+	0x58, 0xe5, 0x76, 0x23, 0x1a, 0x88, 0x2b, 0x4c, 0xcd, 0x92, 0x52, 0x1a, 0x72, 0x75, 0x31, 0x9a,
+	0xe6, 0x5e, 0x1c, 0x9c, 0x07, 0xd4, 0xe0, 0x1c, 0x0d, 0xf8, 0x83, 0x05, 0xcf, 0x3d, 0xf1, 0x84,
+	0xa7, 0x03, 0x97, 0xf5, 0x43, 0xed, 0x50, 0xdc, 0xc4, 0x13, 0x79, 0x2c, 0x60, 0x0f, 0x17, 0x04,
+	0xec, 0xef, 0x10, 0x54, 0xb7, 0x59, 0xe7, 0xba, 0x17, 0x05, 0x43, 0x71, 0xb3, 0x67, 0x5e, 0x44,
+	0xbd, 0x24, 0x2a, 0x12, 0x91, 0xbb, 0x3a, 0x72, 0x7b, 0x74, 0x27, 0x22, 0x3d, 0x5f, 0xde, 0x49,
+	0x9e, 0xca, 0xd5, 0xe9, 0x62, 0xbe, 0xfd, 0x2e, 0x09, 0x23, 0x91, 0xbd, 0x55, 0x4b, 0x7c, 0x73,
+	0xa0, 0xe9, 0x84, 0x9d, 0x28, 0x90, 0xa9, 0x9b, 0x1b, 0x53, 0x03, 0x69, 0x29, 0xc6, 0x26, 0x45,
+	0x63, 0x07, 0x5e, 0x48, 0xaf, 0xb2, 0x77, 0x69, 0xd0, 0x73, 0x3d, 0x52, 0x5e, 0x6f, 0x47, 0xfd,
+	0x52, 0x29, 0xf0, 0xcb, 0xbd, 0x5c, 0x02, 0xf1, 0xfb, 0xdf, 0x7d, 0xd7, 0x73, 0xd8, 0xc3, 0x92,
+	0x44, 0x98, 0x45, 0xed, 0x5f, 0xf3, 0x34, 0x81, 0xa2, 0x37, 0xcd, 0xcd, 0xd7, 0xe1, 0x20, 0xcf,
+	0xe2, 0x01, 0x95, 0x3f, 0xc8, 0x42, 0x61, 0xe4, 0x0a, 0x40, 0xa1, 0x0e, 0x2b, 0xbf, 0x10, 0x6f,
+	0xc3, 0x61, 0x12, 0x86, 0x6e, 0xc7, 0xa3, 0x4e, 0xa2, 0xab, 0x32, 0xb3, 0xae, 0xd1, 0xa5, 0xf1,
+	0xb3, 0x4f, 0xcc, 0x90, 0x67, 0x97, 0x88, 0xc6, 0x0f, 0x11, 0x1c, 0x2b, 0x54, 0x92, 0xc6, 0x3a,
+	0x52, 0xca, 0x2b, 0xa7, 0x8b, 0xec, 0x5d, 0xea, 0xf4, 0xbb, 0x34, 0xe1, 0x62, 0x12, 0x99, 0xff,
+	0xe6, 0xf4, 0xe3, 0x93, 0x94, 0xe5, 0x3d, 0x95, 0xf1, 0x1a, 0x40, 0x8f, 0x78, 0x7d, 0xd2, 0x15,
+	0x10, 0x16, 0x05, 0x04, 0x65, 0xc4, 0x58, 0x05, 0xbd, 0x28, 0x0c, 0x24, 0x93, 0xf0, 0x77, 0x04,
+	0x87, 0x92, 0x32, 0x28, 0xcf, 0x70, 0x1d, 0x0e, 0x2b, 0x6e, 0xb8, 0x9d, 0x1d, 0xe7, 0xe8, 0xf0,
+	0x94, 0x12, 0x97, 0xc4, 0xc2, 0x42, 0x9e, 0x73, 0x1b, 0xe4, 0x58, 0xb3, 0x99, 0xfb, 0x10, 0x7a,
+	0xaa, 0x9b, 0xd8, 0x0f, 0x40, 0xbb, 0x45, 0x3c, 0xd2, 0xa1, 0x4e, 0xba, 0xb9, 0x34, 0x90, 0xbe,
+	0xab, 0x3e, 0x96, 0xf7, 0xfd, 0x34, 0x4d, 0xaf, 0x33, 0x6e, 0xbb, 0x2d, 0x1f, 0xde, 0xad, 0x7f,
+	0xad, 0x01, 0x56, 0x0f, 0x9e, 0x06, 0x03, 0xd7, 0xa6, 0xf8, 0x5d, 0x04, 0x8b, 0xbc, 0xeb, 0xe1,
+	0x93, 0x93, 0xe2, 0x4c, 0x1c, 0x80, 0x3e, 0xbf, 0x57, 0x0d, 0xb7, 0x66, 0xac, 0xbe, 0xfd, 0xb7,
+	0x7f, 0xbf, 0x57, 0x39, 0x8e, 0x9f, 0x17, 0x0c, 0xf0, 0xe0, 0xb2, 0xca, 0xc6, 0x86, 0xf8, 0xc7,
+	0x08, 0xb0, 0x6c, 0xc5, 0x0a, 0x33, 0x87, 0x2f, 0x4c, 0x82, 0x58, 0xc0, 0xe0, 0xe9, 0x27, 0x95,
+	0x92, 0x67, 0xda, 0x2c, 0xa0, 0xbc, 0xc0, 0x89, 0x09, 0x02, 0xc0, 0x86, 0x00, 0x70, 0x1a, 0x1b,
+	0x45, 0x00, 0x9a, 0x8f, 0x78, 0x60, 0x3c, 0x6e, 0xd2, 0xd8, 0xee, 0x2f, 0x11, 0x2c, 0xdd, 0x17,
+	0x17, 0xcf, 0x29, 0x4e, 0xda, 0x99, 0x9b, 0x93, 0x84, 0x39, 0x81, 0xd6, 0x38, 0x25, 0x90, 0x9e,
+	0xc4, 0x27, 0x12, 0xa4, 0x61, 0x14, 0x50, 0xd2, 0xcb, 0x01, 0xbe, 0x84, 0xf0, 0xc7, 0x08, 0x96,
+	0x63, 0xce, 0x0d, 0x9f, 0x99, 0x84, 0x32, 0xc7, 0xc9, 0xe9, 0xf3, 0x23, 0xb0, 0x8c, 0xf3, 0x02,
+	0xe3, 0x29, 0xa3, 0xf0, 0x38, 0xb7, 0x72, 0xf4, 0xd6, 0xfb, 0x08, 0x16, 0x6e, 0xd0, 0xa9, 0xf1,
+	0x36, 0x47, 0x70, 0x63, 0x0e, 0x2c, 0x38, 0x6a, 0xfc, 0x11, 0x82, 0x17, 0x6e, 0xd0, 0xa8, 0xb8,
+	0xde, 0xe3, 0xf5, 0xe9, 0x45, 0x58, 0x86, 0xdd, 0x85, 0x19, 0x66, 0xa6, 0x85, 0xae, 0x29, 0x90,
+	0x9d, 0xc7, 0xe7, 0xca, 0x82, 0x90, 0x53, 0x18, 0x0f, 0x25, 0x8e, 0x3f, 0x21, 0x38, 0x32, 0xca,
+	0xa4, 0xe3, 0x7c, 0x87, 0x28, 0x24, 0xda, 0xf5, 0xdb, 0xfb, 0x2d, 0x28, 0x79, 0xa5, 0xc6, 0x35,
+	0x81, 0xfc, 0x65, 0xfc, 0x52, 0x19, 0xf2, 0x84, 0xa9, 0x0b, 0x9b, 0x8f, 0x92, 0xcf, 0xc7, 0xcd,
+	0x9e, 0x54, 0x81, 0xdf, 0x46, 0x70, 0xe0, 0x06, 0x8d, 0x6e, 0xa5, 0x44, 0xd5, 0xc4, 0xb0, 0xcd,
+	0x51, 0xda, 0xfa, 0xaa, 0xa9, 0xfc, 0x79, 0x25, 0xf9, 0x29, 0x75, 0xe9, 0xa6, 0x00, 0x76, 0x0e,
+	0x9f, 0x29, 0x03, 0x96, 0x91, 0x63, 0xbf, 0x47, 0xb0, 0x1c, 0x93, 0x3c, 0x93, 0xcd, 0xe7, 0xb8,
+	0xe0, 0x79, 0x06, 0xe6, 0x75, 0x81, 0xf5, 0xcb, 0xfa, 0xa5, 0x62, 0xac, 0xea, 0xfa, 0xc4, 0x6b,
+	0xa6, 0xd8, 0x40, 0x3e, 0xa3, 0x7e, 0x8d, 0x00, 0x32, 0xa2, 0x0a, 0x9f, 0x2f, 0xdf, 0x87, 0x42,
+	0x66, 0xe9, 0xf3, 0xa5, 0xaa, 0x0c, 0x53, 0xec, 0x67, 0x5d, 0x6f, 0x94, 0x86, 0xb3, 0x4f, 0xed,
+	0xad, 0x98, 0xd4, 0xfa, 0x05, 0x82, 0x25, 0xc1, 0x43, 0xe0, 0xd3, 0x93, 0x30, 0xab, 0x34, 0xc5,
+	0x3c, 0x5d, 0x7f, 0x56, 0x40, 0x6d, 0xb4, 0xca, 0x6a, 0xc2, 0x16, 0xda, 0xc0, 0x03, 0x58, 0x8e,
+	0x39, 0x81, 0xc9, 0xe1, 0x91, 0xe3, 0x0c, 0xf4, 0x46, 0x49, 0x8f, 0x8a, 0x23, 0x54, 0x96, 0xa3,
+	0x8d, 0x69, 0xe5, 0x68, 0x91, 0x57, 0x0c, 0x7c, 0xaa, 0xac, 0x9e, 0x7c, 0x0a, 0x8e, 0xb9, 0x20,
+	0xd0, 0x9d, 0x31, 0x1a, 0xd3, 0x4a, 0x12, 0xf7, 0xce, 0xcf, 0x10, 0x1c, 0x19, 0xbd, 0xd2, 0xe0,
+	0x13, 0x23, 0xe5, 0x48, 0xbd, 0xc7, 0xe9, 0x79, 0x2f, 0x4e, 0xba, 0x0e, 0x19, 0x5f, 0x11, 0x28,
+	0xb6, 0xf0, 0xd5, 0xa9, 0x99, 0x71, 0x3b, 0x49, 0x68, 0xae, 0x68, 0x33, 0xe3, 0xc4, 0x7f, 0x83,
+	0xe0, 0x40, 0xa2, 0xf7, 0x6e, 0x40, 0x69, 0x39, 0xac, 0xf9, 0x25, 0x02, 0xb7, 0x65, 0x7c, 0x51,
+	0xc0, 0xff, 0x02, 0xbe, 0x32, 0x23, 0xfc, 0x04, 0xf6, 0x66, 0xc4, 0x91, 0xfe, 0x01, 0xc1, 0xd1,
+	0xfb, 0x71, 0xdc, 0x7f, 0x46, 0xf8, 0x5f, 0x15, 0xf8, 0xbf, 0x84, 0x5f, 0x2e, 0xb9, 0x72, 0x4c,
+	0xdb, 0xc6, 0x25, 0x84, 0x7f, 0x85, 0xa0, 0x9a, 0x30, 0xbc, 0xf8, 0xdc, 0xc4, 0xc4, 0xc8, 0x73,
+	0xc0, 0xf3, 0x0c, 0x66, 0xd9, 0x5f, 0x8d, 0xd3, 0xa5, 0x5d, 0x4a, 0xda, 0xe7, 0x01, 0xfd, 0x3e,
+	0x02, 0x9c, 0xbe, 0x47, 0xd2, 0x17, 0x0a, 0x3e, 0x9b, 0x33, 0x35, 0xf1, 0x01, 0xab, 0x9f, 0x9b,
+	0x3a, 0x2f, 0xdf, 0xa5, 0x36, 0x4a, 0xbb, 0x14, 0x4b, 0xed, 0xbf, 0x83, 0xa0, 0x7e, 0x83, 0xa6,
+	0xd7, 0xe1, 0x12, 0x5f, 0xe6, 0xa9, 0x6b, 0x7d, 0x7d, 0xfa, 0x44, 0x89, 0xe8, 0xa2, 0x40, 0x74,
+	0x16, 0x97, 0xbb, 0x2a, 0x01, 0xf0, 0x21, 0x82, 0x83, 0x77, 0xd4, 0x10, 0xc5, 0x17, 0xa7, 0x59,
+	0xca, 0x55, 0xf2, 0xd9, 0x71, 0x7d, 0x5e, 0xe0, 0xda, 0x34, 0x66, 0xc2, 0xb5, 0x25, 0xf9, 0xe1,
+	0x9f, 0x23, 0x78, 0x4e, 0x7d, 0x3f, 0x48, 0x76, 0xef, 0xff, 0xf5, 0x5b, 0x09, 0x49, 0x68, 0x5c,
+	0x11, 0xf8, 0x4c, 0x7c, 0x71, 0x16, 0x7c, 0x4d, 0x49, 0xf9, 0xe1, 0x0f, 0x10, 0x1c, 0x15, 0xfc,
+	0xaa, 0xaa, 0x78, 0xa4, 0xc5, 0x4c, 0x62, 0x63, 0x67, 0x68, 0x31, 0xb2, 0xfe, 0x18, 0x4f, 0x05,
+	0x6a, 0x2b, 0xe1, 0x4e, 0x7f, 0x8a, 0xe0, 0x50, 0xd2, 0xd4, 0xe4, 0xe9, 0x6e, 0x4e, 0x73, 0xdc,
+	0xd3, 0x36, 0x41, 0x19, 0x6e, 0x1b, 0xb3, 0x85, 0xdb, 0xc7, 0x08, 0x56, 0x24, 0xb7, 0x59, 0x72,
+	0x55, 0x50, 0xc8, 0x4f, 0xfd, 0x58, 0x6e, 0x56, 0x42, 0x9a, 0x19, 0xdf, 0x16, 0x66, 0xef, 0xe1,
+	0x66, 0x99, 0x59, 0x9f, 0x39, 0x61, 0xf3, 0x91, 0x64, 0xac, 0x1e, 0x37, 0xbb, 0xac, 0x13, 0xbe,
+	0x69, 0xe0, 0xd2, 0x86, 0xc8, 0xe7, 0x5c, 0x42, 0xaf, 0x7c, 0xf5, 0x8f, 0x4f, 0xd6, 0xd0, 0x5f,
+	0x9e, 0xac, 0xa1, 0x7f, 0x3e, 0x59, 0x43, 0x6f, 0x5e, 0x9d, 0xed, 0x3f, 0x91, 0xec, 0xae, 0x4b,
+	0xbd, 0x48, 0x55, 0xfb, 0xbf, 0x01, 0x00, 0xc9, 0x98, 0x13, 0xb6, 0x6f, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3305,6 +3456,13 @@ func (m *ApplicationQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Repo != nil {
 		i -= len(*m.Repo)
 		copy(dAtA[i:], *m.Repo)
@@ -3410,6 +3568,18 @@ func (m *RevisionMetadataQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SourceIndex != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.SourceIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Revision == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
 	} else {
@@ -3455,6 +3625,13 @@ func (m *ApplicationResourceEventsQuery) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ResourceUID != nil {
 		i -= len(*m.ResourceUID)
 		copy(dAtA[i:], *m.ResourceUID)
@@ -3512,6 +3689,13 @@ func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Revision != nil {
 		i -= len(*m.Revision)
 		copy(dAtA[i:], *m.Revision)
@@ -3694,6 +3878,13 @@ func (m *ApplicationDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.PropagationPolicy != nil {
 		i -= len(*m.PropagationPolicy)
 		copy(dAtA[i:], *m.PropagationPolicy)
@@ -3783,6 +3974,13 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.SyncOptions != nil {
 		{
 			size, err := m.SyncOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -3919,6 +4117,13 @@ func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Validate != nil {
 		i--
 		if *m.Validate {
@@ -3979,6 +4184,13 @@ func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.PatchType == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
 	} else {
@@ -4033,6 +4245,13 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Prune != nil {
 		i--
 		if *m.Prune {
@@ -4096,6 +4315,13 @@ func (m *ApplicationResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Kind == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
 	} else {
@@ -4173,6 +4399,13 @@ func (m *ApplicationResourcePatchRequest) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.PatchType == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
 	} else {
@@ -4268,6 +4501,13 @@ func (m *ApplicationResourceDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Orphan != nil {
 		i--
 		if *m.Orphan {
@@ -4365,6 +4605,13 @@ func (m *ResourceActionRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Action == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
 	} else {
@@ -4528,6 +4775,13 @@ func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.Previous != nil {
 		i--
 		if *m.Previous {
@@ -4742,6 +4996,13 @@ func (m *OperationTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Name == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	} else {
@@ -4778,6 +5039,13 @@ func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Name == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	} else {
@@ -4974,6 +5242,13 @@ func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Kind != nil {
 		i -= len(*m.Kind)
 		copy(dAtA[i:], *m.Kind)
@@ -5105,6 +5380,10 @@ func (m *ApplicationQuery) Size() (n int) {
 		l = len(*m.Repo)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5141,6 +5420,13 @@ func (m *RevisionMetadataQuery) Size() (n int) {
 		l = len(*m.Revision)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.SourceIndex != nil {
+		n += 1 + sovApplication(uint64(*m.SourceIndex))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5169,6 +5455,10 @@ func (m *ApplicationResourceEventsQuery) Size() (n int) {
 		l = len(*m.ResourceUID)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5189,6 +5479,10 @@ func (m *ApplicationManifestQuery) Size() (n int) {
 		l = len(*m.Revision)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5265,6 +5559,10 @@ func (m *ApplicationDeleteRequest) Size() (n int) {
 		l = len(*m.PropagationPolicy)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5339,6 +5637,10 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 		l = m.SyncOptions.Size()
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5362,6 +5664,10 @@ func (m *ApplicationUpdateSpecRequest) Size() (n int) {
 	if m.Validate != nil {
 		n += 2
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5386,6 +5692,10 @@ func (m *ApplicationPatchRequest) Size() (n int) {
 		l = len(*m.PatchType)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5411,6 +5721,10 @@ func (m *ApplicationRollbackRequest) Size() (n int) {
 	if m.Prune != nil {
 		n += 2
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5447,6 +5761,10 @@ func (m *ApplicationResourceRequest) Size() (n int) {
 		l = len(*m.Kind)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5491,6 +5809,10 @@ func (m *ApplicationResourcePatchRequest) Size() (n int) {
 		l = len(*m.PatchType)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5533,6 +5855,10 @@ func (m *ApplicationResourceDeleteRequest) Size() (n int) {
 	if m.Orphan != nil {
 		n += 2
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5573,6 +5899,10 @@ func (m *ResourceActionRunRequest) Size() (n int) {
 		l = len(*m.Action)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5671,6 +6001,10 @@ func (m *ApplicationPodLogsQuery) Size() (n int) {
 	if m.Previous != nil {
 		n += 2
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5718,6 +6052,10 @@ func (m *OperationTerminateRequest) Size() (n int) {
 		l = len(*m.Name)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5734,6 +6072,10 @@ func (m *ApplicationSyncWindowsQuery) Size() (n int) {
 		l = len(*m.Name)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5836,6 +6178,10 @@ func (m *ResourcesQuery) Size() (n int) {
 		l = len(*m.Kind)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6092,6 +6438,39 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Repo = &s
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6296,6 +6675,59 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 			m.Revision = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceIndex = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6487,23 +6919,56 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.ResourceUID = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 
@@ -6609,6 +7074,39 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Revision = &s
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7050,6 +7548,39 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.PropagationPolicy = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7505,6 +8036,39 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7652,6 +8216,39 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.Validate = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7812,6 +8409,39 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 			m.PatchType = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7970,6 +8600,39 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.Prune = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -8230,6 +8893,39 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 			m.Kind = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000008)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -8564,6 +9260,39 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 			m.PatchType = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000020)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -8878,6 +9607,39 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.Orphan = &b
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -9178,6 +9940,39 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			m.Action = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000010)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -9835,6 +10630,39 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.Previous = &b
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10152,6 +10980,39 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10241,6 +11102,39 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10879,6 +11773,39 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Kind = &s
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // AppProjectList is list of AppProject resources
@@ -219,6 +220,29 @@ func (p *AppProject) ValidateProject() error {
 		}
 	}
 
+	for _, destServiceAccount := range p.Spec.DestinationServiceAccounts {
+		if destServiceAccount.Server == "" {
+			return status.Errorf(codes.InvalidArgument, "server of destination service account '%s' must not be empty", destServiceAccount.DefaultServiceAccount)
+		}
+		namespace, name := splitServiceAccount(destServiceAccount.DefaultServiceAccount)
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid destination service account '%s': %s", destServiceAccount.DefaultServiceAccount, strings.Join(errs, ", "))
+		}
+		if namespace != nil {
+			if errs := validation.IsDNS1123Label(*namespace); len(errs) > 0 {
+				return status.Errorf(codes.InvalidArgument, "invalid namespace of destination service account '%s': %s", destServiceAccount.DefaultServiceAccount, strings.Join(errs, ", "))
+			}
+		}
+	}
+
+	if verification := p.Spec.HelmSignatureVerification; verification != nil {
+		for _, key := range verification.CosignKeys {
+			if key.Name == "" {
+				return status.Errorf(codes.InvalidArgument, "name of cosign key must not be empty")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -370,6 +394,69 @@ func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	return false
 }
 
+// GetImpersonatedServiceAccount returns the user name of the service account which is impersonated when syncing to the
+// given destination. Returns an empty string if the project does not map any destinations to service accounts, and an
+// error if none of the mapped destinations matches the given one.
+func (proj AppProject) GetImpersonatedServiceAccount(dst ApplicationDestination) (string, error) {
+	if len(proj.Spec.DestinationServiceAccounts) == 0 {
+		return "", nil
+	}
+	for _, item := range proj.Spec.DestinationServiceAccounts {
+		if !globMatch(item.Server, dst.Server) || !globMatch(item.Namespace, dst.Namespace) {
+			continue
+		}
+		namespace, name := splitServiceAccount(item.DefaultServiceAccount)
+		if namespace == nil {
+			if dst.Namespace == "" {
+				return "", fmt.Errorf("namespace of service account '%s' is not specified and the destination has no namespace", name)
+			}
+			namespace = &dst.Namespace
+		}
+		return fmt.Sprintf("system:serviceaccount:%s:%s", *namespace, name), nil
+	}
+	return "", fmt.Errorf("no service account is configured for server '%s' and namespace '%s' in project '%s'", dst.Server, dst.Namespace, proj.Name)
+}
+
+// RequiresChartSignature returns whether the Helm charts deployed by applications of this project must be signed
+func (proj AppProject) RequiresChartSignature() bool {
+	verification := proj.Spec.HelmSignatureVerification
+	return verification != nil && (len(verification.ProvenanceKeys) > 0 || len(verification.CosignKeys) > 0)
+}
+
+// IsHydrationTargetBranchPermitted returns whether applications of this project are allowed to commit their rendered
+// manifests to the given branch
+func (proj AppProject) IsHydrationTargetBranchPermitted(branch string) bool {
+	for _, pattern := range proj.Spec.HydrationTargetBranches {
+		if globMatch(pattern, branch, '/') {
+			return true
+		}
+	}
+	return false
+}
+
+// splitServiceAccount splits a service account of the form [<namespace>:]<name> into its namespace and name
+func splitServiceAccount(serviceAccount string) (*string, string) {
+	if parts := strings.SplitN(serviceAccount, ":", 2); len(parts) == 2 {
+		return &parts[0], parts[1]
+	}
+	return nil, serviceAccount
+}
+
+// IsAppNamespacePermitted validates if the given application is allowed to be created in its namespace. Applications in
+// the control plane namespace are always permitted, applications in other namespaces only if the namespace matches one
+// of the project's source namespaces.
+func (proj AppProject) IsAppNamespacePermitted(app *Application, controlPlaneNamespace string) bool {
+	if app.Namespace == "" || app.Namespace == controlPlaneNamespace {
+		return true
+	}
+	for _, namespace := range proj.Spec.SourceNamespaces {
+		if globMatch(namespace, app.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
 // TODO: document this method
 func (proj *AppProject) NormalizeJWTTokens() bool {
 	needNormalize := false
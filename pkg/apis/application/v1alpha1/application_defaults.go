@@ -6,6 +6,8 @@ const (
 	DefaultSyncRetryMaxDuration time.Duration = 180000000000 // 3m0s
 	DefaultSyncRetryDuration    time.Duration = 5000000000   // 5s
 	DefaultSyncRetryFactor                    = int64(2)
+	// DefaultAutoRollbackDegradedTimeout is the default amount of time an application may remain Degraded after an automated sync before it is rolled back
+	DefaultAutoRollbackDegradedTimeout time.Duration = 300000000000 // 5m0s
 	// ResourcesFinalizerName is the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName string = "resources-finalizer.argocd.argoproj.io"
 
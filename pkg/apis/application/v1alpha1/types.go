@@ -34,6 +34,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/util/collections"
 	"github.com/argoproj/argo-cd/v2/util/helm"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 )
 
 // Application is a definition of Application resource.
@@ -55,7 +56,7 @@ type Application struct {
 // ApplicationSpec represents desired application state. Contains link to repository with application definition and additional parameters link definition revision.
 type ApplicationSpec struct {
 	// Source is a reference to the location of the application's manifests or chart
-	Source ApplicationSource `json:"source" protobuf:"bytes,1,opt,name=source"`
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination is a reference to the target Kubernetes server and namespace
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a reference to the project this application belongs to.
@@ -73,6 +74,44 @@ type ApplicationSpec struct {
 	// Increasing will increase the space used to store the history, so we do not recommend increasing it.
 	// Default is 10.
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
+	// Sources is a reference to the location of the application's manifests or chart. Sources takes precedence over
+	// Source when both are set.
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	// Hydration configures committing the rendered manifests of the application to a Git branch, which the
+	// application is then synced from
+	Hydration *ApplicationHydration `json:"hydration,omitempty" protobuf:"bytes,9,opt,name=hydration"`
+}
+
+// ApplicationHydration configures committing the rendered manifests of an application back to its Git repository
+type ApplicationHydration struct {
+	// TargetBranch is the branch of the source repository which the rendered manifests are committed to. The branch
+	// is created if it does not exist yet.
+	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// Path is the directory in the target branch which the rendered manifests are written to. Defaults to the path of
+	// the application source.
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+}
+
+// GetPath returns the directory in the target branch which the rendered manifests of the given source are written to
+func (h *ApplicationHydration) GetPath(source ApplicationSource) string {
+	if h.Path != "" {
+		return h.Path
+	}
+	return source.Path
+}
+
+// HydrationStatus contains information about the last commit of the rendered manifests of an application
+type HydrationStatus struct {
+	// DrySHA is the revision of the application source which the manifests have been rendered from
+	DrySHA string `json:"drySHA,omitempty" protobuf:"bytes,1,opt,name=drySHA"`
+	// HydratedSHA is the revision of the target branch holding the rendered manifests, which the application is synced from
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,2,opt,name=hydratedSHA"`
+	// TargetBranch is the branch which the rendered manifests have been committed to
+	TargetBranch string `json:"targetBranch,omitempty" protobuf:"bytes,3,opt,name=targetBranch"`
+	// Path is the directory in the target branch holding the rendered manifests
+	Path string `json:"path,omitempty" protobuf:"bytes,4,opt,name=path"`
+	// HydratedAt indicates when the rendered manifests have been committed
+	HydratedAt *metav1.Time `json:"hydratedAt,omitempty" protobuf:"bytes,5,opt,name=hydratedAt"`
 }
 
 type TrackingMethod string
@@ -170,6 +209,18 @@ type ApplicationSource struct {
 	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
 	// Chart is a Helm chart name, and must be specified for applications sourced from a Helm repo.
 	Chart string `json:"chart,omitempty" protobuf:"bytes,12,opt,name=chart"`
+	// Ref is reference to another source within sources field. This field will not be used if used with a `source` tag.
+	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
+}
+
+// RefTarget holds the repository and revision of a source referenced by another source of the same application
+type RefTarget struct {
+	// Repo is the repository of the referenced source
+	Repo Repository `json:"repo" protobuf:"bytes,1,opt,name=repo"`
+	// TargetRevision is the revision of the referenced source
+	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,2,opt,name=targetRevision"`
+	// Chart is the Helm chart name of the referenced source
+	Chart string `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
 }
 
 // AllowsConcurrentProcessing returns true if given application source can be processed concurrently
@@ -195,12 +246,24 @@ func (a *ApplicationSource) IsHelmOci() bool {
 	return helm.IsHelmOciRepo(a.RepoURL)
 }
 
+// IsOCI returns true when the application source points to an OCI artifact
+func (a *ApplicationSource) IsOCI() bool {
+	return a.Chart == "" && oci.IsOCIRepo(a.RepoURL)
+}
+
+// IsRef returns true when the application source is only used as a reference by other sources, i.e. it does not
+// point to any manifests itself
+func (a *ApplicationSource) IsRef() bool {
+	return a.Ref != "" && a.Path == "" && a.Chart == ""
+}
+
 // IsZero returns true if the application source is considered empty
 func (a *ApplicationSource) IsZero() bool {
 	return a == nil ||
 		a.RepoURL == "" &&
 			a.Path == "" &&
 			a.TargetRevision == "" &&
+			a.Ref == "" &&
 			a.Helm.IsZero() &&
 			a.Kustomize.IsZero() &&
 			a.Directory.IsZero() &&
@@ -245,6 +308,44 @@ type ApplicationSourceHelm struct {
 	IgnoreMissingValueFiles bool `json:"ignoreMissingValueFiles,omitempty" protobuf:"bytes,8,opt,name=ignoreMissingValueFiles"`
 	// SkipCrds skips custom resource definition installation step (Helm's --skip-crds)
 	SkipCrds bool `json:"skipCrds,omitempty" protobuf:"bytes,9,opt,name=skipCrds"`
+	// PostRenderer patches the manifests rendered by Helm with Kustomize
+	PostRenderer *HelmPostRenderer `json:"postRenderer,omitempty" protobuf:"bytes,10,opt,name=postRenderer"`
+}
+
+// HelmPostRenderer patches the manifests rendered by Helm with a Kustomize overlay and with inline Kustomize patches.
+// The overlay is applied first, the inline patches afterwards.
+type HelmPostRenderer struct {
+	// Path is the path of a Kustomize overlay in the repository of the application, relative to the path of the
+	// application source. The manifests rendered by Helm are written to the file helm-output.yaml in the overlay
+	// directory, which must be listed as a resource of the overlay.
+	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
+	// Patches is a list of Kustomize patches applied to the manifests rendered by Helm
+	Patches []KustomizePatch `json:"patches,omitempty" protobuf:"bytes,2,rep,name=patches"`
+}
+
+// KustomizePatch is an inline Kustomize patch, either a strategic merge patch or a JSON 6902 patch
+type KustomizePatch struct {
+	// Patch is the content of the patch
+	Patch string `json:"patch" protobuf:"bytes,1,name=patch"`
+	// Target selects the resources to patch. Required for JSON 6902 patches, strategic merge patches apply to the
+	// resource matching their own kind and name by default.
+	Target *KustomizeSelector `json:"target,omitempty" protobuf:"bytes,2,opt,name=target"`
+}
+
+// KustomizeSelector selects the resources a Kustomize patch is applied to
+type KustomizeSelector struct {
+	Group              string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version            string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	Kind               string `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	Name               string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	Namespace          string `json:"namespace,omitempty" protobuf:"bytes,5,opt,name=namespace"`
+	LabelSelector      string `json:"labelSelector,omitempty" protobuf:"bytes,6,opt,name=labelSelector"`
+	AnnotationSelector string `json:"annotationSelector,omitempty" protobuf:"bytes,7,opt,name=annotationSelector"`
+}
+
+// IsZero returns true if the post renderer does not change the manifests rendered by Helm
+func (p *HelmPostRenderer) IsZero() bool {
+	return p == nil || p.Path == "" && len(p.Patches) == 0
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -326,7 +427,7 @@ func (in *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds
+	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds && h.PostRenderer.IsZero()
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -465,11 +566,91 @@ func (d *ApplicationSourceDirectory) IsZero() bool {
 type ApplicationSourcePlugin struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	Env  `json:"env,omitempty" protobuf:"bytes,2,opt,name=env"`
+	// Parameters are the typed parameters passed to the plugin
+	Parameters ApplicationSourcePluginParameters `json:"parameters,omitempty" protobuf:"bytes,3,opt,name=parameters"`
 }
 
 // IsZero returns true if the ApplicationSourcePlugin is considered empty
 func (c *ApplicationSourcePlugin) IsZero() bool {
-	return c == nil || c.Name == "" && c.Env.IsZero()
+	return c == nil || c.Name == "" && c.Env.IsZero() && len(c.Parameters) == 0
+}
+
+// ApplicationSourcePluginParameter is a parameter passed to a config management plugin. Exactly one of the values
+// should be set, depending on the type of the parameter announced by the plugin.
+type ApplicationSourcePluginParameter struct {
+	// Name is the name of the parameter
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// String_ is the value of a string type parameter
+	String_ *string `json:"string,omitempty" protobuf:"bytes,2,opt,name=string"`
+	// Array is the value of an array type parameter
+	Array []string `json:"array,omitempty" protobuf:"bytes,3,rep,name=array"`
+	// Map is the value of a map type parameter
+	Map map[string]string `json:"map,omitempty" protobuf:"bytes,4,rep,name=map"`
+}
+
+// ApplicationSourcePluginParameters is a list of parameters passed to a config management plugin
+type ApplicationSourcePluginParameters []ApplicationSourcePluginParameter
+
+var invalidPluginParamCharsRegex = regexp.MustCompile("[^A-Z0-9_]")
+
+// pluginParamEnvName converts the given parameter name, or key of a map parameter, into a valid part of an environment
+// variable name
+func pluginParamEnvName(name string) string {
+	return invalidPluginParamCharsRegex.ReplaceAllString(strings.ToUpper(name), "_")
+}
+
+// Environ returns the environment variables passing the parameters to a plugin. All parameters are passed as JSON in
+// ARGOCD_APP_PARAMETERS. In addition, string parameters are passed as PARAM_<NAME>, the items of array parameters as
+// PARAM_<NAME>_<INDEX> and the entries of map parameters as PARAM_<NAME>_<KEY>.
+func (p ApplicationSourcePluginParameters) Environ() ([]string, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	out, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal plugin parameters: %w", err)
+	}
+	env := []string{fmt.Sprintf("ARGOCD_APP_PARAMETERS=%s", string(out))}
+	for _, param := range p {
+		envBaseName := fmt.Sprintf("PARAM_%s", pluginParamEnvName(param.Name))
+		if param.String_ != nil {
+			env = append(env, fmt.Sprintf("%s=%s", envBaseName, *param.String_))
+		}
+		for i, value := range param.Array {
+			env = append(env, fmt.Sprintf("%s_%d=%s", envBaseName, i, value))
+		}
+		keys := make([]string, 0, len(param.Map))
+		for key := range param.Map {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			env = append(env, fmt.Sprintf("%s_%s=%s", envBaseName, pluginParamEnvName(key), param.Map[key]))
+		}
+	}
+	return env, nil
+}
+
+// SetParameter adds the given parameter, or replaces the parameter with the same name
+func (c *ApplicationSourcePlugin) SetParameter(param ApplicationSourcePluginParameter) {
+	for i := range c.Parameters {
+		if c.Parameters[i].Name == param.Name {
+			c.Parameters[i] = param
+			return
+		}
+	}
+	c.Parameters = append(c.Parameters, param)
+}
+
+// RemoveParameter removes the parameter with the given name if present
+func (c *ApplicationSourcePlugin) RemoveParameter(name string) error {
+	for i := range c.Parameters {
+		if c.Parameters[i].Name == name {
+			c.Parameters = append(c.Parameters[:i], c.Parameters[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("unable to find parameter %q for plugin %q", name, c.Name)
 }
 
 // AddEnvEntry merges an EnvEntry into a list of entries. If an entry with the same name already exists,
@@ -537,6 +718,8 @@ type ApplicationStatus struct {
 	SourceType ApplicationSourceType `json:"sourceType,omitempty" protobuf:"bytes,9,opt,name=sourceType"`
 	// Summary contains a list of URLs and container images used by this application
 	Summary ApplicationSummary `json:"summary,omitempty" protobuf:"bytes,10,opt,name=summary"`
+	// Hydration contains information about the last commit of the rendered manifests of the application
+	Hydration *HydrationStatus `json:"hydration,omitempty" protobuf:"bytes,11,opt,name=hydration"`
 }
 
 // JWTTokens represents a list of JWT tokens
@@ -625,6 +808,12 @@ type SyncOperation struct {
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
 	// SyncOptions provide per-sync sync-options, e.g. Validate=false
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,9,opt,name=syncOptions"`
+	// Sources overrides the source definitions set in the application.
+	// This is typically set in a Rollback operation and is nil during a Sync operation
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,10,opt,name=sources"`
+	// Revisions is the list of revisions (Git) or chart versions (Helm) which to sync each source in sources field for the application to
+	// If omitted, will use the revision specified in app spec.
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -648,6 +837,10 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// FailureCategory is the category of the cause of the last failed attempt of the operation
+	FailureCategory SyncFailureCategory `json:"failureCategory,omitempty" protobuf:"bytes,9,opt,name=failureCategory,casttype=SyncFailureCategory"`
+	// HealthVerificationStartedAt contains the time the verification of the application's health started after the sync completed
+	HealthVerificationStartedAt *metav1.Time `json:"healthVerificationStartedAt,omitempty" protobuf:"bytes,10,opt,name=healthVerificationStartedAt"`
 }
 
 type Info struct {
@@ -697,11 +890,37 @@ type SyncPolicy struct {
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,2,opt,name=syncOptions"`
 	// Retry controls failed sync retry behavior
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
+	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
+	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// HealthVerification keeps verifying the health of the application for a period of time after a sync, and fails the sync if the application turns Degraded
+	HealthVerification *SyncHealthVerification `json:"healthVerification,omitempty" protobuf:"bytes,5,opt,name=healthVerification"`
 }
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.HealthVerification == nil)
+}
+
+// SyncHealthVerification controls the verification of the application's health after a sync
+type SyncHealthVerification struct {
+	// Duration is the amount of time the application's health is verified after a sync. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+}
+
+// GetDuration returns the amount of time the application's health is verified after a sync
+func (v *SyncHealthVerification) GetDuration() (time.Duration, error) {
+	if v == nil || v.Duration == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(v.Duration)
+}
+
+// ManagedNamespaceMetadata contains the labels and annotations which are set on the destination namespace
+type ManagedNamespaceMetadata struct {
+	// Labels are the labels to set on the destination namespace
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
+	// Annotations are the annotations to set on the destination namespace
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -710,6 +929,64 @@ type RetryStrategy struct {
 	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
 	// Backoff controls how to backoff on subsequent retries of failed syncs
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
+	// FailureCategories overrides the limit and backoff for syncs which failed with specific failure categories
+	FailureCategories []RetryFailureCategory `json:"failureCategories,omitempty" protobuf:"bytes,3,rep,name=failureCategories"`
+}
+
+// SyncFailureCategory is the category of the cause of a failed sync
+type SyncFailureCategory string
+
+const (
+	// SyncFailureCategoryTransient is the category of failures which are likely to resolve on their own, such as
+	// timeouts, connection errors, throttling and update conflicts
+	SyncFailureCategoryTransient SyncFailureCategory = "Transient"
+	// SyncFailureCategoryAdmissionDenied is the category of failures caused by an admission webhook or policy denying a request
+	SyncFailureCategoryAdmissionDenied SyncFailureCategory = "AdmissionDenied"
+	// SyncFailureCategoryInvalid is the category of failures caused by resources which failed schema validation
+	SyncFailureCategoryInvalid SyncFailureCategory = "Invalid"
+	// SyncFailureCategoryForbidden is the category of failures caused by missing permissions
+	SyncFailureCategoryForbidden SyncFailureCategory = "Forbidden"
+	// SyncFailureCategoryUnknown is the category of failures which could not be classified
+	SyncFailureCategoryUnknown SyncFailureCategory = "Unknown"
+)
+
+// SyncFailureCategories is the list of all sync failure categories
+var SyncFailureCategories = []SyncFailureCategory{
+	SyncFailureCategoryTransient,
+	SyncFailureCategoryAdmissionDenied,
+	SyncFailureCategoryInvalid,
+	SyncFailureCategoryForbidden,
+	SyncFailureCategoryUnknown,
+}
+
+// RetryFailureCategory overrides the retry strategy for syncs which failed with a specific failure category
+type RetryFailureCategory struct {
+	// Category is the failure category (one of Transient, AdmissionDenied, Invalid, Forbidden or Unknown)
+	Category SyncFailureCategory `json:"category" protobuf:"bytes,1,opt,name=category,casttype=SyncFailureCategory"`
+	// Limit is the maximum number of attempts for retrying a sync which failed with the category. Defaults to the limit
+	// of the retry strategy. If set to 0, syncs failing with the category are not retried.
+	Limit *int64 `json:"limit,omitempty" protobuf:"bytes,2,opt,name=limit"`
+	// Backoff controls how to backoff on subsequent retries of syncs which failed with the category. Defaults to the
+	// backoff of the retry strategy.
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,3,opt,name=backoff"`
+}
+
+// ForFailureCategory returns the retry strategy to apply to syncs which failed with the given failure category
+func (r *RetryStrategy) ForFailureCategory(category SyncFailureCategory) *RetryStrategy {
+	for _, c := range r.FailureCategories {
+		if c.Category != category {
+			continue
+		}
+		strategy := &RetryStrategy{Limit: r.Limit, Backoff: r.Backoff}
+		if c.Limit != nil {
+			strategy.Limit = *c.Limit
+		}
+		if c.Backoff != nil {
+			strategy.Backoff = c.Backoff
+		}
+		return strategy
+	}
+	return r
 }
 
 func parseStringToDuration(durationString string) (time.Duration, error) {
@@ -775,6 +1052,27 @@ type SyncPolicyAutomated struct {
 	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
 	// AllowEmpty allows apps have zero live resources (default: false)
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
+	// MaxPrunedResources is the maximum number of resources an automated sync may prune. Automated syncs which would prune more resources are skipped (default: 0, no limit)
+	MaxPrunedResources int64 `json:"maxPrunedResources,omitempty" protobuf:"bytes,4,opt,name=maxPrunedResources"`
+	// MaxChangedResourcesPercent is the maximum percentage of the application's resources an automated sync may change. Automated syncs which would change more resources are skipped (default: 0, no limit)
+	MaxChangedResourcesPercent int64 `json:"maxChangedResourcesPercent,omitempty" protobuf:"bytes,5,opt,name=maxChangedResourcesPercent"`
+	// Rollback enables the automatic rollback to the last healthy revision after an automated sync failed or left the application Degraded
+	Rollback *AutomatedRollback `json:"rollback,omitempty" protobuf:"bytes,6,opt,name=rollback"`
+}
+
+// AutomatedRollback controls the automatic rollback of an application to the last healthy revision of its history
+type AutomatedRollback struct {
+	// DegradedTimeout is the amount of time an application may remain Degraded after an automated sync before it is rolled back.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Defaults to 5 minutes.
+	DegradedTimeout string `json:"degradedTimeout,omitempty" protobuf:"bytes,1,opt,name=degradedTimeout"`
+}
+
+// GetDegradedTimeout returns the amount of time an application may remain Degraded before it is rolled back
+func (r *AutomatedRollback) GetDegradedTimeout() (time.Duration, error) {
+	if r.DegradedTimeout == "" {
+		return DefaultAutoRollbackDegradedTimeout, nil
+	}
+	return parseStringToDuration(r.DegradedTimeout)
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -840,6 +1138,10 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	// Sources records the application sources information of the sync, used for comparing auto-sync
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,4,opt,name=sources"`
+	// Revisions holds the revision this sync operation was performed for respective indexed source in sources field
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -911,6 +1213,12 @@ type RevisionHistory struct {
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
 	// DeployStartedAt holds the time the sync operation started
 	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,7,opt,name=deployStartedAt"`
+	// Sources is a reference to the application sources used for the sync operation
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+	// Revisions holds the revision of each source in sources field the sync was performed against
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
+	// Healthy is true if the application became Healthy after the sync
+	Healthy bool `json:"healthy,omitempty" protobuf:"bytes,10,opt,name=healthy"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -978,6 +1286,8 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionAutoRollbackWarning indicates that controller rolled back the application after a failed automated sync
+	ApplicationConditionAutoRollbackWarning = "AutoRollbackWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usally an error or warning
@@ -993,9 +1303,11 @@ type ApplicationCondition struct {
 // ComparedTo contains application source and target which was used for resources comparison
 type ComparedTo struct {
 	// Source is a reference to the application's source used for comparison
-	Source ApplicationSource `json:"source" protobuf:"bytes,1,opt,name=source"`
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination is a reference to the application's destination used for comparison
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,opt,name=destination"`
+	// Sources is a reference to the application's multiple sources used for comparison
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
 }
 
 // SyncStatus contains information about the currently observed live and desired states of an application
@@ -1006,6 +1318,8 @@ type SyncStatus struct {
 	ComparedTo ComparedTo `json:"comparedTo,omitempty" protobuf:"bytes,2,opt,name=comparedTo"`
 	// Revision contains information about the revision the comparison has been performed to
 	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// Revisions contains information about the revisions of multiple sources the comparison has been performed to
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,4,opt,name=revisions"`
 }
 
 // HealthStatus contains information about the currently observed health state of an application or resource
@@ -1014,6 +1328,8 @@ type HealthStatus struct {
 	Status health.HealthStatusCode `json:"status,omitempty" protobuf:"bytes,1,opt,name=status"`
 	// Message is a human-readable informational message describing the health status
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// LastTransitionTime is the time the health status of the application last changed
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
 // InfoItem contains arbitrary, human readable information about an application
@@ -1645,6 +1961,21 @@ type SignatureKey struct {
 	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
 }
 
+// HelmSignatureVerification specifies the keys which the signatures of Helm charts must be made with in order to be
+// allowed for sync
+type HelmSignatureVerification struct {
+	// ProvenanceKeys contains a list of PGP key IDs that the provenance files of charts from Helm repositories must be signed with
+	ProvenanceKeys []SignatureKey `json:"provenanceKeys,omitempty" protobuf:"bytes,1,rep,name=provenanceKeys"`
+	// CosignKeys contains a list of cosign public keys that charts from OCI registries must be signed with
+	CosignKeys []CosignKey `json:"cosignKeys,omitempty" protobuf:"bytes,2,rep,name=cosignKeys"`
+}
+
+// CosignKey is the specification of a cosign public key required to verify chart signatures with
+type CosignKey struct {
+	// The name of the key in the argocd-cosign-keys-cm ConfigMap
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+}
+
 // AppProjectSpec is the specification of an AppProject
 type AppProjectSpec struct {
 	// SourceRepos contains list of repository URLs which can be used for deployment
@@ -1669,6 +2000,29 @@ type AppProjectSpec struct {
 	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,10,opt,name=signatureKeys"`
 	// ClusterResourceBlacklist contains list of blacklisted cluster level resources
 	ClusterResourceBlacklist []metav1.GroupKind `json:"clusterResourceBlacklist,omitempty" protobuf:"bytes,11,opt,name=clusterResourceBlacklist"`
+	// SourceNamespaces defines the namespaces application resources are allowed to be created in
+	SourceNamespaces []string `json:"sourceNamespaces,omitempty" protobuf:"bytes,12,opt,name=sourceNamespaces"`
+	// DestinationServiceAccounts maps destinations to the service accounts which are impersonated when syncing
+	// applications of this project to them
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,13,rep,name=destinationServiceAccounts"`
+	// HelmSignatureVerification specifies the keys which the signatures of Helm charts must be made with in order to be
+	// allowed for sync
+	HelmSignatureVerification *HelmSignatureVerification `json:"helmSignatureVerification,omitempty" protobuf:"bytes,14,opt,name=helmSignatureVerification"`
+	// HydrationTargetBranches contains the branches which applications of this project are allowed to commit their
+	// rendered manifests to. Supports glob patterns. Hydration is not permitted if the list is empty.
+	HydrationTargetBranches []string `json:"hydrationTargetBranches,omitempty" protobuf:"bytes,15,opt,name=hydrationTargetBranches"`
+}
+
+// ApplicationDestinationServiceAccount holds the service account which is impersonated when syncing applications to a
+// destination
+type ApplicationDestinationServiceAccount struct {
+	// Server specifies the URL of the target cluster's Kubernetes control plane API. Supports glob patterns.
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Namespace specifies the target namespace of the application's resources. Supports glob patterns.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// DefaultServiceAccount is the name of the service account to impersonate. The service account is looked up in the
+	// destination namespace, unless it is given in the form <namespace>:<name>.
+	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2090,6 +2444,24 @@ type KustomizeOptions struct {
 	BinaryPath string `protobuf:"bytes,2,opt,name=binaryPath"`
 }
 
+// QualifiedName returns the name of the application qualified with the namespace it is created in, i.e. <namespace>/<name>
+func (app *Application) QualifiedName() string {
+	if app.Namespace == "" {
+		return app.Name
+	}
+	return app.Namespace + "/" + app.Name
+}
+
+// InstanceName returns the name the resources of the application are tracked with. Applications in the control plane
+// namespace are tracked with their name for backwards compatibility, applications in other namespaces with their name
+// prefixed by their namespace, i.e. <namespace>_<name>
+func (app *Application) InstanceName(controlPlaneNamespace string) string {
+	if app.Namespace == "" || app.Namespace == controlPlaneNamespace {
+		return app.Name
+	}
+	return app.Namespace + "_" + app.Name
+}
+
 // CascadedDeletion indicates if the deletion finalizer is set and controller should delete the application and it's cascaded resources
 func (app *Application) CascadedDeletion() bool {
 	for _, finalizer := range app.ObjectMeta.Finalizers {
@@ -2290,6 +2662,29 @@ func (spec ApplicationSpec) GetProject() string {
 	return spec.Project
 }
 
+// HasMultipleSources returns true if the application uses the sources field rather than the source field
+func (spec ApplicationSpec) HasMultipleSources() bool {
+	return len(spec.Sources) > 0
+}
+
+// GetSources returns the sources of the application. For applications using the source field, a single element list
+// is returned
+func (spec ApplicationSpec) GetSources() []ApplicationSource {
+	if spec.HasMultipleSources() {
+		return spec.Sources
+	}
+	return []ApplicationSource{spec.Source}
+}
+
+// GetSource returns the source of the application. For applications using the sources field, the first source is
+// returned
+func (spec ApplicationSpec) GetSource() ApplicationSource {
+	if spec.HasMultipleSources() {
+		return spec.Sources[0]
+	}
+	return spec.Source
+}
+
 // GetRevisionHistoryLimit returns the currently set revision history limit for an application
 func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
 	if spec.RevisionHistoryLimit != nil {
@@ -858,6 +858,7 @@ func TestApplicationSource_IsZero(t *testing.T) {
 		{"Kustomize", &ApplicationSource{Kustomize: &ApplicationSourceKustomize{Images: KustomizeImages{""}}}, false},
 		{"Directory", &ApplicationSource{Directory: &ApplicationSourceDirectory{Recurse: true}}, false},
 		{"Plugin", &ApplicationSource{Plugin: &ApplicationSourcePlugin{Name: "foo"}}, false},
+		{"Ref", &ApplicationSource{Ref: "foo"}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -866,6 +867,107 @@ func TestApplicationSource_IsZero(t *testing.T) {
 	}
 }
 
+func TestApplicationSource_IsRef(t *testing.T) {
+	assert.True(t, (&ApplicationSource{RepoURL: "foo", Ref: "values"}).IsRef())
+	assert.False(t, (&ApplicationSource{RepoURL: "foo", Ref: "values", Path: "foo"}).IsRef())
+	assert.False(t, (&ApplicationSource{RepoURL: "foo", Path: "foo"}).IsRef())
+}
+
+func TestApplicationSpec_GetSources(t *testing.T) {
+	single := ApplicationSpec{Source: ApplicationSource{RepoURL: "foo"}}
+	assert.False(t, single.HasMultipleSources())
+	assert.Equal(t, []ApplicationSource{{RepoURL: "foo"}}, single.GetSources())
+	assert.Equal(t, ApplicationSource{RepoURL: "foo"}, single.GetSource())
+
+	multiple := ApplicationSpec{Sources: []ApplicationSource{{RepoURL: "foo"}, {RepoURL: "bar"}}}
+	assert.True(t, multiple.HasMultipleSources())
+	assert.Equal(t, []ApplicationSource{{RepoURL: "foo"}, {RepoURL: "bar"}}, multiple.GetSources())
+	assert.Equal(t, ApplicationSource{RepoURL: "foo"}, multiple.GetSource())
+}
+
+func TestApplication_QualifiedName(t *testing.T) {
+	app := Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "team-a"}}
+	assert.Equal(t, "team-a/guestbook", app.QualifiedName())
+	assert.Equal(t, "team-a_guestbook", app.InstanceName("argocd"))
+	assert.Equal(t, "guestbook", app.InstanceName("team-a"))
+}
+
+func TestAppProject_IsAppNamespacePermitted(t *testing.T) {
+	app := func(namespace string) *Application {
+		return &Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: namespace}}
+	}
+	proj := AppProject{Spec: AppProjectSpec{SourceNamespaces: []string{"team-*", "apps"}}}
+
+	assert.True(t, proj.IsAppNamespacePermitted(app("argocd"), "argocd"))
+	assert.True(t, proj.IsAppNamespacePermitted(app("team-a"), "argocd"))
+	assert.True(t, proj.IsAppNamespacePermitted(app("apps"), "argocd"))
+	assert.False(t, proj.IsAppNamespacePermitted(app("other"), "argocd"))
+	assert.False(t, AppProject{}.IsAppNamespacePermitted(app("team-a"), "argocd"))
+}
+
+func TestAppProject_IsHydrationTargetBranchPermitted(t *testing.T) {
+	proj := AppProject{Spec: AppProjectSpec{HydrationTargetBranches: []string{"hydrated/*", "env-prod"}}}
+
+	assert.True(t, proj.IsHydrationTargetBranchPermitted("hydrated/guestbook"))
+	assert.True(t, proj.IsHydrationTargetBranchPermitted("env-prod"))
+	assert.False(t, proj.IsHydrationTargetBranchPermitted("hydrated/team/guestbook"))
+	assert.False(t, proj.IsHydrationTargetBranchPermitted("main"))
+	assert.False(t, AppProject{}.IsHydrationTargetBranchPermitted("hydrated/guestbook"))
+}
+
+func TestAppProject_GetImpersonatedServiceAccount(t *testing.T) {
+	proj := AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: AppProjectSpec{DestinationServiceAccounts: []ApplicationDestinationServiceAccount{
+			{Server: "https://kubernetes.default.svc", Namespace: "team-a-*", DefaultServiceAccount: "deployer"},
+			{Server: "https://kubernetes.default.svc", Namespace: "", DefaultServiceAccount: "team-a:cluster-deployer"},
+			{Server: "*", Namespace: "*", DefaultServiceAccount: "argocd:restricted"},
+		}},
+	}
+
+	serviceAccount, err := proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a-dev"})
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:team-a-dev:deployer", serviceAccount)
+
+	serviceAccount, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:team-a:cluster-deployer", serviceAccount)
+
+	serviceAccount, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://remote", Namespace: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:restricted", serviceAccount)
+
+	proj.Spec.DestinationServiceAccounts = proj.Spec.DestinationServiceAccounts[:1]
+	_, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://remote", Namespace: "default"})
+	assert.EqualError(t, err, "no service account is configured for server 'https://remote' and namespace 'default' in project 'team-a'")
+
+	proj.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", DefaultServiceAccount: "deployer"}}
+	_, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://remote"})
+	assert.EqualError(t, err, "namespace of service account 'deployer' is not specified and the destination has no namespace")
+
+	serviceAccount, err = AppProject{}.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://remote", Namespace: "default"})
+	assert.NoError(t, err)
+	assert.Empty(t, serviceAccount)
+}
+
+func TestAppProject_ValidateDestinationServiceAccounts(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "argocd:deployer"},
+	}
+	assert.NoError(t, proj.ValidateProject())
+
+	proj.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Namespace: "*", DefaultServiceAccount: "deployer"}}
+	assert.Error(t, proj.ValidateProject())
+
+	proj.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", DefaultServiceAccount: ""}}
+	assert.Error(t, proj.ValidateProject())
+
+	proj.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", DefaultServiceAccount: "Invalid_Namespace:deployer"}}
+	assert.Error(t, proj.ValidateProject())
+}
+
 func TestApplicationSourceHelm_AddParameter(t *testing.T) {
 	src := ApplicationSourceHelm{}
 	t.Run("Add", func(t *testing.T) {
@@ -997,6 +1099,7 @@ func TestApplicationSourcePlugin_IsZero(t *testing.T) {
 		{"Empty", &ApplicationSourcePlugin{}, true},
 		{"Name", &ApplicationSourcePlugin{Name: "foo"}, false},
 		{"Env", &ApplicationSourcePlugin{Env: Env{{}}}, false},
+		{"Parameters", &ApplicationSourcePlugin{Parameters: ApplicationSourcePluginParameters{{Name: "foo"}}}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1005,6 +1108,45 @@ func TestApplicationSourcePlugin_IsZero(t *testing.T) {
 	}
 }
 
+func TestApplicationSourcePluginParameters_Environ(t *testing.T) {
+	value := "bar"
+	params := ApplicationSourcePluginParameters{
+		{Name: "foo-string", String_: &value},
+		{Name: "foo.array", Array: []string{"a", "b"}},
+		{Name: "foo_map", Map: map[string]string{"b-key": "b", "a.key": "a"}},
+	}
+	env, err := params.Environ()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`ARGOCD_APP_PARAMETERS=[{"name":"foo-string","string":"bar"},{"name":"foo.array","array":["a","b"]},{"name":"foo_map","map":{"a.key":"a","b-key":"b"}}]`,
+		"PARAM_FOO_STRING=bar",
+		"PARAM_FOO_ARRAY_0=a",
+		"PARAM_FOO_ARRAY_1=b",
+		"PARAM_FOO_MAP_A_KEY=a",
+		"PARAM_FOO_MAP_B_KEY=b",
+	}, env)
+
+	env, err = ApplicationSourcePluginParameters{}.Environ()
+	assert.NoError(t, err)
+	assert.Empty(t, env)
+}
+
+func TestApplicationSourcePlugin_Parameters(t *testing.T) {
+	value := "bar"
+	plugin := &ApplicationSourcePlugin{}
+	plugin.SetParameter(ApplicationSourcePluginParameter{Name: "foo", Array: []string{"a"}})
+	plugin.SetParameter(ApplicationSourcePluginParameter{Name: "bar", String_: &value})
+	plugin.SetParameter(ApplicationSourcePluginParameter{Name: "foo", Array: []string{"b"}})
+	assert.Equal(t, ApplicationSourcePluginParameters{
+		{Name: "foo", Array: []string{"b"}},
+		{Name: "bar", String_: &value},
+	}, plugin.Parameters)
+
+	assert.NoError(t, plugin.RemoveParameter("foo"))
+	assert.Equal(t, ApplicationSourcePluginParameters{{Name: "bar", String_: &value}}, plugin.Parameters)
+	assert.Error(t, plugin.RemoveParameter("foo"))
+}
+
 func TestEnvEntry_IsZero(t *testing.T) {
 	tests := []struct {
 		name string
@@ -2473,6 +2615,23 @@ func TestRetryStrategy_NextRetryAtCustomBackoff(t *testing.T) {
 	}
 }
 
+func TestRetryStrategy_ForFailureCategory(t *testing.T) {
+	backoff := &Backoff{Duration: "1m"}
+	retry := &RetryStrategy{
+		Limit: 5,
+		FailureCategories: []RetryFailureCategory{
+			{Category: SyncFailureCategoryInvalid, Limit: pointer.Int64Ptr(0)},
+			{Category: SyncFailureCategoryTransient, Limit: pointer.Int64Ptr(10), Backoff: backoff},
+			{Category: SyncFailureCategoryForbidden, Backoff: backoff},
+		},
+	}
+
+	assert.Same(t, retry, retry.ForFailureCategory(SyncFailureCategoryUnknown))
+	assert.Equal(t, &RetryStrategy{Limit: 0}, retry.ForFailureCategory(SyncFailureCategoryInvalid))
+	assert.Equal(t, &RetryStrategy{Limit: 10, Backoff: backoff}, retry.ForFailureCategory(SyncFailureCategoryTransient))
+	assert.Equal(t, &RetryStrategy{Limit: 5, Backoff: backoff}, retry.ForFailureCategory(SyncFailureCategoryForbidden))
+}
+
 func TestSourceAllowsConcurrentProcessing_KustomizeParams(t *testing.T) {
 	src := ApplicationSource{Path: ".", Kustomize: &ApplicationSourceKustomize{
 		NameSuffix: "test",
@@ -21,18 +21,22 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+
 	"github.com/argoproj/argo-cd/v2/common"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 // Utility struct for a reference to a secret key.
 type SecretRef struct {
-	SecretName string `json:"secretName"`
-	Key        string `json:"key"`
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
+	Key        string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
 // ApplicationSet is a set of Application resources
@@ -41,69 +45,152 @@ type SecretRef struct {
 // +kubebuilder:subresource:status
 type ApplicationSet struct {
 	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   ApplicationSetSpec   `json:"spec"`
-	Status ApplicationSetStatus `json:"status,omitempty"`
+	Spec   ApplicationSetSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status ApplicationSetStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // ApplicationSetSpec represents a class of application set state.
 type ApplicationSetSpec struct {
-	Generators []ApplicationSetGenerator `json:"generators"`
-	Template   ApplicationSetTemplate    `json:"template"`
-	SyncPolicy *ApplicationSetSyncPolicy `json:"syncPolicy,omitempty"`
+	// GoTemplate enables rendering of the template with Go text/template (with the Sprig function library) instead
+	// of flat {{param}} substitution. When enabled, generators produce structured (nested) parameters.
+	GoTemplate bool                      `json:"goTemplate,omitempty" protobuf:"bytes,1,opt,name=goTemplate"`
+	Generators []ApplicationSetGenerator `json:"generators" protobuf:"bytes,2,opt,name=generators"`
+	Template   ApplicationSetTemplate    `json:"template" protobuf:"bytes,3,opt,name=template"`
+	SyncPolicy *ApplicationSetSyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,opt,name=syncPolicy"`
+	Strategy   *ApplicationSetStrategy   `json:"strategy,omitempty" protobuf:"bytes,5,opt,name=strategy"`
+	// IgnoreApplicationDifferences lists fields of the generated Applications which are not overwritten by the
+	// controller when the Applications are updated, so that changes made to them outside of the ApplicationSet are
+	// preserved.
+	IgnoreApplicationDifferences []ApplicationSetResourceIgnoreDifferences `json:"ignoreApplicationDifferences,omitempty" protobuf:"bytes,6,opt,name=ignoreApplicationDifferences"`
+}
+
+// ApplicationSetResourceIgnoreDifferences configures fields of the generated Applications which are preserved when the
+// controller updates them.
+type ApplicationSetResourceIgnoreDifferences struct {
+	// Name is the name of the Application the rule applies to. If empty, the rule applies to all Applications.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// JSONPointers is a list of JSON pointers to the preserved fields, e.g. /spec/source/targetRevision
+	JSONPointers []string `json:"jsonPointers,omitempty" protobuf:"bytes,2,opt,name=jsonPointers"`
+	// JQPathExpressions is a list of jq path expressions to the preserved fields, e.g. .spec.source.helm.parameters
+	JQPathExpressions []string `json:"jqPathExpressions,omitempty" protobuf:"bytes,3,opt,name=jqPathExpressions"`
+}
+
+// ToApplicationResourceIgnoreDifferences converts the rule to the equivalent Application ignore differences rule
+func (d ApplicationSetResourceIgnoreDifferences) ToApplicationResourceIgnoreDifferences() v1alpha1.ResourceIgnoreDifferences {
+	return v1alpha1.ResourceIgnoreDifferences{
+		Group:             v1alpha1.ApplicationSchemaGroupVersionKind.Group,
+		Kind:              v1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+		Name:              d.Name,
+		JSONPointers:      d.JSONPointers,
+		JQPathExpressions: d.JQPathExpressions,
+	}
+}
+
+// ApplicationSetStrategy configures how generated Applications are updated in sequence.
+type ApplicationSetStrategy struct {
+	// Type of the strategy. Only "RollingSync" is supported.
+	Type        string                         `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+	RollingSync *ApplicationSetRolloutStrategy `json:"rollingSync,omitempty" protobuf:"bytes,2,opt,name=rollingSync"`
+}
+
+// ApplicationSetRolloutStrategy is an ordered list of steps. The Applications selected by a step are only synced
+// once all Applications selected by the previous steps are Healthy.
+type ApplicationSetRolloutStrategy struct {
+	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
+}
+
+// ApplicationSetRolloutStep selects the Applications which are synced together by the label match expressions.
+// Applications are assigned to the first step which selects them.
+type ApplicationSetRolloutStep struct {
+	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
+	// MaxUpdate is the maximum number (or percentage) of the Applications of the step which may be syncing at the
+	// same time. Defaults to all the Applications of the step.
+	MaxUpdate *intstr.IntOrString `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+}
+
+// ApplicationMatchExpression is a label selector requirement on the labels of the generated Applications.
+type ApplicationMatchExpression struct {
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
+	// Operator is one of In, NotIn, Exists and DoesNotExist.
+	Operator string   `json:"operator,omitempty" protobuf:"bytes,2,opt,name=operator"`
+	Values   []string `json:"values,omitempty" protobuf:"bytes,3,opt,name=values"`
 }
 
+const (
+	// ApplicationSetStrategyTypeRollingSync syncs the generated Applications step by step.
+	ApplicationSetStrategyTypeRollingSync = "RollingSync"
+)
+
 // ApplicationSetSyncPolicy configures how generated Applications will relate to their
 // ApplicationSet.
 type ApplicationSetSyncPolicy struct {
 	// PreserveResourcesOnDeletion will preserve resources on deletion. If PreserveResourcesOnDeletion is set to true, these Applications will not be deleted.
-	PreserveResourcesOnDeletion bool `json:"preserveResourcesOnDeletion,omitempty"`
+	PreserveResourcesOnDeletion bool `json:"preserveResourcesOnDeletion,omitempty" protobuf:"bytes,1,opt,name=preserveResourcesOnDeletion"`
+	// ApplicationsSync restricts the changes made by the controller to the generated Applications. It can only
+	// restrict the policy set on the controller with the --policy flag further, not relax it.
+	ApplicationsSync *ApplicationsSyncPolicy `json:"applicationsSync,omitempty" protobuf:"bytes,2,opt,name=applicationsSync,casttype=ApplicationsSyncPolicy"`
 }
 
+// ApplicationsSyncPolicy defines which changes the controller makes to the generated Applications.
+// +kubebuilder:validation:Enum=create-only;create-update;sync
+type ApplicationsSyncPolicy string
+
+const (
+	// ApplicationsSyncPolicyCreateOnly only creates Applications, and never updates nor deletes them
+	ApplicationsSyncPolicyCreateOnly ApplicationsSyncPolicy = "create-only"
+	// ApplicationsSyncPolicyCreateUpdate creates and updates Applications, but never deletes them
+	ApplicationsSyncPolicyCreateUpdate ApplicationsSyncPolicy = "create-update"
+	// ApplicationsSyncPolicySync creates, updates and deletes Applications
+	ApplicationsSyncPolicySync ApplicationsSyncPolicy = "sync"
+)
+
 // ApplicationSetTemplate represents argocd ApplicationSpec
 type ApplicationSetTemplate struct {
-	ApplicationSetTemplateMeta `json:"metadata"`
-	Spec                       v1alpha1.ApplicationSpec `json:"spec"`
+	ApplicationSetTemplateMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec                       v1alpha1.ApplicationSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
 }
 
 // ApplicationSetTemplateMeta represents the Argo CD application fields that may
 // be used for Applications generated from the ApplicationSet (based on metav1.ObjectMeta)
 type ApplicationSetTemplateMeta struct {
-	Name        string            `json:"name,omitempty"`
-	Namespace   string            `json:"namespace,omitempty"`
-	Labels      map[string]string `json:"labels,omitempty"`
-	Annotations map[string]string `json:"annotations,omitempty"`
-	Finalizers  []string          `json:"finalizers,omitempty"`
+	Name        string            `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	Namespace   string            `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	Labels      map[string]string `json:"labels,omitempty" protobuf:"bytes,3,opt,name=labels"`
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,4,opt,name=annotations"`
+	Finalizers  []string          `json:"finalizers,omitempty" protobuf:"bytes,5,opt,name=finalizers"`
 }
 
 // ApplicationSetGenerator represents a generator at the top level of an ApplicationSet.
 type ApplicationSetGenerator struct {
-	List                    *ListGenerator        `json:"list,omitempty"`
-	Clusters                *ClusterGenerator     `json:"clusters,omitempty"`
-	Git                     *GitGenerator         `json:"git,omitempty"`
-	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty"`
-	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty"`
-	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty"`
-	Matrix                  *MatrixGenerator      `json:"matrix,omitempty"`
-	Merge                   *MergeGenerator       `json:"merge,omitempty"`
+	List                    *ListGenerator        `json:"list,omitempty" protobuf:"bytes,1,opt,name=list"`
+	Clusters                *ClusterGenerator     `json:"clusters,omitempty" protobuf:"bytes,2,opt,name=clusters"`
+	Git                     *GitGenerator         `json:"git,omitempty" protobuf:"bytes,3,opt,name=git"`
+	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty" protobuf:"bytes,4,opt,name=scmProvider"`
+	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty" protobuf:"bytes,5,opt,name=clusterDecisionResource"`
+	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty" protobuf:"bytes,6,opt,name=pullRequest"`
+	Plugin                  *PluginGenerator      `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
+	Matrix                  *MatrixGenerator      `json:"matrix,omitempty" protobuf:"bytes,7,opt,name=matrix"`
+	Merge                   *MergeGenerator       `json:"merge,omitempty" protobuf:"bytes,8,opt,name=merge"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
 // MergeGenerator).
 type ApplicationSetNestedGenerator struct {
-	List                    *ListGenerator        `json:"list,omitempty"`
-	Clusters                *ClusterGenerator     `json:"clusters,omitempty"`
-	Git                     *GitGenerator         `json:"git,omitempty"`
-	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty"`
-	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty"`
-	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty"`
+	List                    *ListGenerator        `json:"list,omitempty" protobuf:"bytes,1,opt,name=list"`
+	Clusters                *ClusterGenerator     `json:"clusters,omitempty" protobuf:"bytes,2,opt,name=clusters"`
+	Git                     *GitGenerator         `json:"git,omitempty" protobuf:"bytes,3,opt,name=git"`
+	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty" protobuf:"bytes,4,opt,name=scmProvider"`
+	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty" protobuf:"bytes,5,opt,name=clusterDecisionResource"`
+	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty" protobuf:"bytes,6,opt,name=pullRequest"`
+	Plugin                  *PluginGenerator      `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
 
 	// Matrix should have the form of NestedMatrixGenerator
-	Matrix *apiextensionsv1.JSON `json:"matrix,omitempty"`
+	Matrix *apiextensionsv1.JSON `json:"matrix,omitempty" protobuf:"bytes,7,opt,name=matrix"`
 
 	// Merge should have the form of NestedMergeGenerator
-	Merge *apiextensionsv1.JSON `json:"merge,omitempty"`
+	Merge *apiextensionsv1.JSON `json:"merge,omitempty" protobuf:"bytes,8,opt,name=merge"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -113,12 +200,13 @@ type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
 // MergeGenerator). ApplicationSet enforces this nesting depth limit because CRDs do not support recursive types.
 // https://github.com/kubernetes-sigs/controller-tools/issues/477
 type ApplicationSetTerminalGenerator struct {
-	List                    *ListGenerator        `json:"list,omitempty"`
-	Clusters                *ClusterGenerator     `json:"clusters,omitempty"`
-	Git                     *GitGenerator         `json:"git,omitempty"`
-	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty"`
-	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty"`
-	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty"`
+	List                    *ListGenerator        `json:"list,omitempty" protobuf:"bytes,1,opt,name=list"`
+	Clusters                *ClusterGenerator     `json:"clusters,omitempty" protobuf:"bytes,2,opt,name=clusters"`
+	Git                     *GitGenerator         `json:"git,omitempty" protobuf:"bytes,3,opt,name=git"`
+	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty" protobuf:"bytes,4,opt,name=scmProvider"`
+	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty" protobuf:"bytes,5,opt,name=clusterDecisionResource"`
+	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty" protobuf:"bytes,6,opt,name=pullRequest"`
+	Plugin                  *PluginGenerator      `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -136,6 +224,7 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			SCMProvider:             terminalGenerator.SCMProvider,
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
+			Plugin:                  terminalGenerator.Plugin,
 		}
 	}
 	return nestedGenerators
@@ -143,15 +232,15 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 
 // ListGenerator include items info
 type ListGenerator struct {
-	Elements []apiextensionsv1.JSON `json:"elements"`
-	Template ApplicationSetTemplate `json:"template,omitempty"`
+	Elements []apiextensionsv1.JSON `json:"elements" protobuf:"bytes,1,opt,name=elements"`
+	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
 }
 
 // MatrixGenerator generates the cartesian product of two sets of parameters. The parameters are defined by two nested
 // generators.
 type MatrixGenerator struct {
-	Generators []ApplicationSetNestedGenerator `json:"generators"`
-	Template   ApplicationSetTemplate          `json:"template,omitempty"`
+	Generators []ApplicationSetNestedGenerator `json:"generators" protobuf:"bytes,1,opt,name=generators"`
+	Template   ApplicationSetTemplate          `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
 }
 
 // NestedMatrixGenerator is a MatrixGenerator nested under another combination-type generator (MatrixGenerator or
@@ -162,7 +251,7 @@ type MatrixGenerator struct {
 // as a generic 'apiextensionsv1.JSON' object, and then marshalled into a NestedMatrixGenerator
 // when processed.
 type NestedMatrixGenerator struct {
-	Generators ApplicationSetTerminalGenerators `json:"generators"`
+	Generators ApplicationSetTerminalGenerators `json:"generators" protobuf:"bytes,1,opt,name=generators"`
 }
 
 // ToNestedMatrixGenerator converts a JSON struct (from the K8s resource) to corresponding
@@ -201,9 +290,9 @@ func (g NestedMatrixGenerator) ToMatrixGenerator() *MatrixGenerator {
 // MergeGenerator supports template overriding. If a MergeGenerator is one of multiple top-level generators, its
 // template will be merged with the top-level generator before the parameters are applied.
 type MergeGenerator struct {
-	Generators []ApplicationSetNestedGenerator `json:"generators"`
-	MergeKeys  []string                        `json:"mergeKeys"`
-	Template   ApplicationSetTemplate          `json:"template,omitempty"`
+	Generators []ApplicationSetNestedGenerator `json:"generators" protobuf:"bytes,1,opt,name=generators"`
+	MergeKeys  []string                        `json:"mergeKeys" protobuf:"bytes,2,opt,name=mergeKeys"`
+	Template   ApplicationSetTemplate          `json:"template,omitempty" protobuf:"bytes,3,opt,name=template"`
 }
 
 // NestedMergeGenerator is a MergeGenerator nested under another combination-type generator (MatrixGenerator or
@@ -214,8 +303,8 @@ type MergeGenerator struct {
 // as a generic 'apiextensionsv1.JSON' object, and then marshalled into a NestedMergeGenerator
 // when processed.
 type NestedMergeGenerator struct {
-	Generators ApplicationSetTerminalGenerators `json:"generators"`
-	MergeKeys  []string                         `json:"mergeKeys"`
+	Generators ApplicationSetTerminalGenerators `json:"generators" protobuf:"bytes,1,opt,name=generators"`
+	MergeKeys  []string                         `json:"mergeKeys" protobuf:"bytes,2,opt,name=mergeKeys"`
 }
 
 // ToNestedMergeGenerator converts a JSON struct (from the K8s resource) to corresponding
@@ -249,11 +338,11 @@ type ClusterGenerator struct {
 	// Selector defines a label selector to match against all clusters registered with ArgoCD.
 	// Clusters today are stored as Kubernetes Secrets, thus the Secret labels will be used
 	// for matching the selector.
-	Selector metav1.LabelSelector   `json:"selector,omitempty"`
-	Template ApplicationSetTemplate `json:"template,omitempty"`
+	Selector metav1.LabelSelector   `json:"selector,omitempty" protobuf:"bytes,1,opt,name=selector"`
+	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
 
 	// Values contains key/value pairs which are passed directly as parameters to the template
-	Values map[string]string `json:"values,omitempty"`
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,3,opt,name=values"`
 }
 
 // DuckType defines a generator to match against clusters registered with ArgoCD.
@@ -262,129 +351,129 @@ type DuckTypeGenerator struct {
 	//              this includes apiVersion(group/version), kind, matchKey and validation settings
 	// Name is the resource name of the kind, group and version, defined in the ConfigMapRef
 	// RequeueAfterSeconds is how long before the duckType will be rechecked for a change
-	ConfigMapRef        string               `json:"configMapRef"`
-	Name                string               `json:"name,omitempty"`
-	RequeueAfterSeconds *int64               `json:"requeueAfterSeconds,omitempty"`
-	LabelSelector       metav1.LabelSelector `json:"labelSelector,omitempty"`
+	ConfigMapRef        string               `json:"configMapRef" protobuf:"bytes,1,opt,name=configMapRef"`
+	Name                string               `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	RequeueAfterSeconds *int64               `json:"requeueAfterSeconds,omitempty" protobuf:"bytes,3,opt,name=requeueAfterSeconds"`
+	LabelSelector       metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,4,opt,name=labelSelector"`
 
-	Template ApplicationSetTemplate `json:"template,omitempty"`
+	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,5,opt,name=template"`
 	// Values contains key/value pairs which are passed directly as parameters to the template
-	Values map[string]string `json:"values,omitempty"`
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,opt,name=values"`
 }
 
 type GitGenerator struct {
-	RepoURL             string                      `json:"repoURL"`
-	Directories         []GitDirectoryGeneratorItem `json:"directories,omitempty"`
-	Files               []GitFileGeneratorItem      `json:"files,omitempty"`
-	Revision            string                      `json:"revision"`
-	RequeueAfterSeconds *int64                      `json:"requeueAfterSeconds,omitempty"`
-	Template            ApplicationSetTemplate      `json:"template,omitempty"`
+	RepoURL             string                      `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	Directories         []GitDirectoryGeneratorItem `json:"directories,omitempty" protobuf:"bytes,2,opt,name=directories"`
+	Files               []GitFileGeneratorItem      `json:"files,omitempty" protobuf:"bytes,3,opt,name=files"`
+	Revision            string                      `json:"revision" protobuf:"bytes,4,opt,name=revision"`
+	RequeueAfterSeconds *int64                      `json:"requeueAfterSeconds,omitempty" protobuf:"bytes,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate      `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
 }
 
 type GitDirectoryGeneratorItem struct {
-	Path    string `json:"path"`
-	Exclude bool   `json:"exclude,omitempty"`
+	Path    string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	Exclude bool   `json:"exclude,omitempty" protobuf:"bytes,2,opt,name=exclude"`
 }
 
 type GitFileGeneratorItem struct {
-	Path string `json:"path"`
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
 }
 
 // SCMProviderGenerator defines a generator that scrapes a SCMaaS API to find candidate repos.
 type SCMProviderGenerator struct {
 	// Which provider to use and config for it.
-	Github          *SCMProviderGeneratorGithub          `json:"github,omitempty"`
-	Gitlab          *SCMProviderGeneratorGitlab          `json:"gitlab,omitempty"`
-	Bitbucket       *SCMProviderGeneratorBitbucket       `json:"bitbucket,omitempty"`
-	BitbucketServer *SCMProviderGeneratorBitbucketServer `json:"bitbucketServer,omitempty"`
-	Gitea           *SCMProviderGeneratorGitea           `json:"gitea,omitempty"`
-	AzureDevOps     *SCMProviderGeneratorAzureDevOps     `json:"azureDevOps,omitempty"`
+	Github          *SCMProviderGeneratorGithub          `json:"github,omitempty" protobuf:"bytes,1,opt,name=github"`
+	Gitlab          *SCMProviderGeneratorGitlab          `json:"gitlab,omitempty" protobuf:"bytes,2,opt,name=gitlab"`
+	Bitbucket       *SCMProviderGeneratorBitbucket       `json:"bitbucket,omitempty" protobuf:"bytes,3,opt,name=bitbucket"`
+	BitbucketServer *SCMProviderGeneratorBitbucketServer `json:"bitbucketServer,omitempty" protobuf:"bytes,4,opt,name=bitbucketServer"`
+	Gitea           *SCMProviderGeneratorGitea           `json:"gitea,omitempty" protobuf:"bytes,5,opt,name=gitea"`
+	AzureDevOps     *SCMProviderGeneratorAzureDevOps     `json:"azureDevOps,omitempty" protobuf:"bytes,6,opt,name=azureDevOps"`
 	// Filters for which repos should be considered.
-	Filters []SCMProviderGeneratorFilter `json:"filters,omitempty"`
+	Filters []SCMProviderGeneratorFilter `json:"filters,omitempty" protobuf:"bytes,7,opt,name=filters"`
 	// Which protocol to use for the SCM URL. Default is provider-specific but ssh if possible. Not all providers
 	// necessarily support all protocols.
-	CloneProtocol string `json:"cloneProtocol,omitempty"`
+	CloneProtocol string `json:"cloneProtocol,omitempty" protobuf:"bytes,8,opt,name=cloneProtocol"`
 	// Standard parameters.
-	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty"`
-	Template            ApplicationSetTemplate `json:"template,omitempty"`
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"bytes,9,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,10,opt,name=template"`
 }
 
 // SCMProviderGeneratorGitea defines a connection info specific to Gitea.
 type SCMProviderGeneratorGitea struct {
 	// Gitea organization or user to scan. Required.
-	Owner string `json:"owner"`
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
 	// The Gitea URL to talk to. For example https://gitea.mydomain.com/.
-	API string `json:"api"`
+	API string `json:"api" protobuf:"bytes,2,opt,name=api"`
 	// Authentication token reference.
-	TokenRef *SecretRef `json:"tokenRef,omitempty"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,3,opt,name=tokenRef"`
 	// Scan all branches instead of just the default branch.
-	AllBranches bool `json:"allBranches,omitempty"`
+	AllBranches bool `json:"allBranches,omitempty" protobuf:"bytes,4,opt,name=allBranches"`
 	// Allow self-signed TLS / Certificates; default: false
-	Insecure bool `json:"insecure,omitempty"`
+	Insecure bool `json:"insecure,omitempty" protobuf:"bytes,5,opt,name=insecure"`
 }
 
 // SCMProviderGeneratorGithub defines connection info specific to GitHub.
 type SCMProviderGeneratorGithub struct {
 	// GitHub org to scan. Required.
-	Organization string `json:"organization"`
+	Organization string `json:"organization" protobuf:"bytes,1,opt,name=organization"`
 	// The GitHub API URL to talk to. If blank, use https://api.github.com/.
-	API string `json:"api,omitempty"`
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
 	// Authentication token reference.
-	TokenRef *SecretRef `json:"tokenRef,omitempty"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,3,opt,name=tokenRef"`
 	// Scan all branches instead of just the default branch.
-	AllBranches bool `json:"allBranches,omitempty"`
+	AllBranches bool `json:"allBranches,omitempty" protobuf:"bytes,4,opt,name=allBranches"`
 }
 
 // SCMProviderGeneratorGitlab defines connection info specific to Gitlab.
 type SCMProviderGeneratorGitlab struct {
 	// Gitlab group to scan. Required.  You can use either the project id (recommended) or the full namespaced path.
-	Group string `json:"group"`
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
 	// Recurse through subgroups (true) or scan only the base group (false).  Defaults to "false"
-	IncludeSubgroups bool `json:"includeSubgroups,omitempty"`
+	IncludeSubgroups bool `json:"includeSubgroups,omitempty" protobuf:"bytes,2,opt,name=includeSubgroups"`
 	// The Gitlab API URL to talk to.
-	API string `json:"api,omitempty"`
+	API string `json:"api,omitempty" protobuf:"bytes,3,opt,name=api"`
 	// Authentication token reference.
-	TokenRef *SecretRef `json:"tokenRef,omitempty"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,4,opt,name=tokenRef"`
 	// Scan all branches instead of just the default branch.
-	AllBranches bool `json:"allBranches,omitempty"`
+	AllBranches bool `json:"allBranches,omitempty" protobuf:"bytes,5,opt,name=allBranches"`
 }
 
 // SCMProviderGeneratorBitbucket defines connection info specific to Bitbucket Cloud (API version 2).
 type SCMProviderGeneratorBitbucket struct {
 	// Bitbucket workspace to scan. Required.
-	Owner string `json:"owner"`
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
 	// Bitbucket user to use when authenticating.  Should have a "member" role to be able to read all repositories and branches.  Required
-	User string `json:"user"`
+	User string `json:"user" protobuf:"bytes,2,opt,name=user"`
 	// The app password to use for the user.  Required. See: https://support.atlassian.com/bitbucket-cloud/docs/app-passwords/
-	AppPasswordRef *SecretRef `json:"appPasswordRef"`
+	AppPasswordRef *SecretRef `json:"appPasswordRef" protobuf:"bytes,3,opt,name=appPasswordRef"`
 	// Scan all branches instead of just the main branch.
-	AllBranches bool `json:"allBranches,omitempty"`
+	AllBranches bool `json:"allBranches,omitempty" protobuf:"bytes,4,opt,name=allBranches"`
 }
 
 // SCMProviderGeneratorBitbucketServer defines connection info specific to Bitbucket Server.
 type SCMProviderGeneratorBitbucketServer struct {
 	// Project to scan. Required.
-	Project string `json:"project"`
+	Project string `json:"project" protobuf:"bytes,1,opt,name=project"`
 	// The Bitbucket Server REST API URL to talk to. Required.
-	API string `json:"api"`
+	API string `json:"api" protobuf:"bytes,2,opt,name=api"`
 	// Credentials for Basic auth
-	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty"`
+	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty" protobuf:"bytes,3,opt,name=basicAuth"`
 	// Scan all branches instead of just the default branch.
-	AllBranches bool `json:"allBranches,omitempty"`
+	AllBranches bool `json:"allBranches,omitempty" protobuf:"bytes,4,opt,name=allBranches"`
 }
 
 // SCMProviderGeneratorAzureDevOps defines connection info specific to Azure DevOps.
 type SCMProviderGeneratorAzureDevOps struct {
 	// Azure Devops organization. Required. E.g. "my-organization".
-	Organization string `json:"organization"`
+	Organization string `json:"organization" protobuf:"bytes,1,opt,name=organization"`
 	// The URL to Azure DevOps. If blank, use https://dev.azure.com.
-	API string `json:"api,omitempty"`
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
 	// Azure Devops team project. Required. E.g. "my-team".
-	TeamProject string `json:"teamProject"`
+	TeamProject string `json:"teamProject" protobuf:"bytes,3,opt,name=teamProject"`
 	// The Personal Access Token (PAT) to use when connecting. Required.
-	AccessTokenRef *SecretRef `json:"accessTokenRef"`
+	AccessTokenRef *SecretRef `json:"accessTokenRef" protobuf:"bytes,4,opt,name=accessTokenRef"`
 	// Scan all branches instead of just the default branch.
-	AllBranches bool `json:"allBranches,omitempty"`
+	AllBranches bool `json:"allBranches,omitempty" protobuf:"bytes,5,opt,name=allBranches"`
 }
 
 // SCMProviderGeneratorFilter is a single repository filter.
@@ -392,107 +481,252 @@ type SCMProviderGeneratorAzureDevOps struct {
 // pass for a repo to be included.
 type SCMProviderGeneratorFilter struct {
 	// A regex for repo names.
-	RepositoryMatch *string `json:"repositoryMatch,omitempty"`
+	RepositoryMatch *string `json:"repositoryMatch,omitempty" protobuf:"bytes,1,opt,name=repositoryMatch"`
 	// An array of paths, all of which must exist.
-	PathsExist []string `json:"pathsExist,omitempty"`
+	PathsExist []string `json:"pathsExist,omitempty" protobuf:"bytes,2,opt,name=pathsExist"`
 	// An array of paths, all of which must not exist.
-	PathsDoNotExist []string `json:"pathsDoNotExist,omitempty"`
+	PathsDoNotExist []string `json:"pathsDoNotExist,omitempty" protobuf:"bytes,3,opt,name=pathsDoNotExist"`
 	// A regex which must match at least one label.
-	LabelMatch *string `json:"labelMatch,omitempty"`
+	LabelMatch *string `json:"labelMatch,omitempty" protobuf:"bytes,4,opt,name=labelMatch"`
 	// A regex which must match the branch name.
-	BranchMatch *string `json:"branchMatch,omitempty"`
+	BranchMatch *string `json:"branchMatch,omitempty" protobuf:"bytes,5,opt,name=branchMatch"`
 }
 
 // PullRequestGenerator defines a generator that scrapes a PullRequest API to find candidate pull requests.
 type PullRequestGenerator struct {
 	// Which provider to use and config for it.
-	Github          *PullRequestGeneratorGithub          `json:"github,omitempty"`
-	GitLab          *PullRequestGeneratorGitLab          `json:"gitlab,omitempty"`
-	Gitea           *PullRequestGeneratorGitea           `json:"gitea,omitempty"`
-	BitbucketServer *PullRequestGeneratorBitbucketServer `json:"bitbucketServer,omitempty"`
+	Github          *PullRequestGeneratorGithub          `json:"github,omitempty" protobuf:"bytes,1,opt,name=github"`
+	GitLab          *PullRequestGeneratorGitLab          `json:"gitlab,omitempty" protobuf:"bytes,2,opt,name=gitlab"`
+	Gitea           *PullRequestGeneratorGitea           `json:"gitea,omitempty" protobuf:"bytes,3,opt,name=gitea"`
+	BitbucketServer *PullRequestGeneratorBitbucketServer `json:"bitbucketServer,omitempty" protobuf:"bytes,4,opt,name=bitbucketServer"`
+	BitbucketCloud  *PullRequestGeneratorBitbucketCloud  `json:"bitbucket,omitempty" protobuf:"bytes,8,opt,name=bitbucket"`
+	AzureDevOps     *PullRequestGeneratorAzureDevOps     `json:"azuredevops,omitempty" protobuf:"bytes,9,opt,name=azuredevops"`
+	AWSCodeCommit   *PullRequestGeneratorAWSCodeCommit   `json:"awsCodeCommit,omitempty" protobuf:"bytes,10,opt,name=awsCodeCommit"`
 	// Filters for which pull requests should be considered.
-	Filters []PullRequestGeneratorFilter `json:"filters,omitempty"`
+	Filters []PullRequestGeneratorFilter `json:"filters,omitempty" protobuf:"bytes,5,opt,name=filters"`
 
 	// Standard parameters.
-	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty"`
-	Template            ApplicationSetTemplate `json:"template,omitempty"`
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"bytes,6,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,7,opt,name=template"`
 }
 
 // PullRequestGenerator defines connection info specific to Gitea.
 type PullRequestGeneratorGitea struct {
 	// Gitea org or user to scan. Required.
-	Owner string `json:"owner"`
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
 	// Gitea repo name to scan. Required.
-	Repo string `json:"repo"`
+	Repo string `json:"repo" protobuf:"bytes,2,opt,name=repo"`
 	// The Gitea API URL to talk to. Required
-	API string `json:"api"`
+	API string `json:"api" protobuf:"bytes,3,opt,name=api"`
 	// Authentication token reference.
-	TokenRef *SecretRef `json:"tokenRef,omitempty"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,4,opt,name=tokenRef"`
 	// Allow insecure tls, for self-signed certificates; default: false.
-	Insecure bool `json:"insecure,omitempty"`
+	Insecure bool `json:"insecure,omitempty" protobuf:"bytes,5,opt,name=insecure"`
 }
 
 // PullRequestGenerator defines connection info specific to GitHub.
 type PullRequestGeneratorGithub struct {
 	// GitHub org or user to scan. Required.
-	Owner string `json:"owner"`
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
 	// GitHub repo name to scan. Required.
-	Repo string `json:"repo"`
+	Repo string `json:"repo" protobuf:"bytes,2,opt,name=repo"`
 	// The GitHub API URL to talk to. If blank, use https://api.github.com/.
-	API string `json:"api,omitempty"`
+	API string `json:"api,omitempty" protobuf:"bytes,3,opt,name=api"`
 	// Authentication token reference.
-	TokenRef *SecretRef `json:"tokenRef,omitempty"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,4,opt,name=tokenRef"`
 	// Labels is used to filter the PRs that you want to target
-	Labels []string `json:"labels,omitempty"`
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,5,opt,name=labels"`
 }
 
 // PullRequestGeneratorGitLab defines connection info specific to GitLab.
 type PullRequestGeneratorGitLab struct {
 	// GitLab project to scan. Required.
-	Project string `json:"project"`
+	Project string `json:"project" protobuf:"bytes,1,opt,name=project"`
 	// The GitLab API URL to talk to. If blank, uses https://gitlab.com/.
-	API string `json:"api,omitempty"`
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
 	// Authentication token reference.
-	TokenRef *SecretRef `json:"tokenRef,omitempty"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,3,opt,name=tokenRef"`
 	// Labels is used to filter the MRs that you want to target
-	Labels []string `json:"labels,omitempty"`
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,4,opt,name=labels"`
 	// PullRequestState is an additional MRs filter to get only those with a certain state. Default: "" (all states)
-	PullRequestState string `json:"pullRequestState,omitempty"`
+	PullRequestState string `json:"pullRequestState,omitempty" protobuf:"bytes,5,opt,name=pullRequestState"`
 }
 
 // PullRequestGenerator defines connection info specific to BitbucketServer.
 type PullRequestGeneratorBitbucketServer struct {
 	// Project to scan. Required.
-	Project string `json:"project"`
+	Project string `json:"project" protobuf:"bytes,1,opt,name=project"`
 	// Repo name to scan. Required.
-	Repo string `json:"repo"`
+	Repo string `json:"repo" protobuf:"bytes,2,opt,name=repo"`
 	// The Bitbucket REST API URL to talk to e.g. https://bitbucket.org/rest Required.
-	API string `json:"api"`
+	API string `json:"api" protobuf:"bytes,3,opt,name=api"`
 	// Credentials for Basic auth
-	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty"`
+	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty" protobuf:"bytes,4,opt,name=basicAuth"`
+}
+
+// PullRequestGeneratorBitbucketCloud defines connection info specific to Bitbucket Cloud.
+type PullRequestGeneratorBitbucketCloud struct {
+	// Workspace which owns the repo. Required.
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
+	// Repo name to scan. Required.
+	Repo string `json:"repo" protobuf:"bytes,2,opt,name=repo"`
+	// The Bitbucket Cloud REST API URL to talk to. If blank, uses https://api.bitbucket.org/2.0.
+	API string `json:"api,omitempty" protobuf:"bytes,3,opt,name=api"`
+	// Credentials for Basic auth, using a username and an app password
+	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty" protobuf:"bytes,4,opt,name=basicAuth"`
+	// Authentication token reference, used as a bearer token (e.g. a repository or workspace access token)
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,5,opt,name=tokenRef"`
+}
+
+// PullRequestGeneratorAzureDevOps defines connection info specific to Azure DevOps.
+type PullRequestGeneratorAzureDevOps struct {
+	// Azure DevOps org to scan. Required.
+	Organization string `json:"organization" protobuf:"bytes,1,opt,name=organization"`
+	// Azure DevOps project name to scan. Required.
+	Project string `json:"project" protobuf:"bytes,2,opt,name=project"`
+	// Azure DevOps repo name to scan. Required.
+	Repo string `json:"repo" protobuf:"bytes,3,opt,name=repo"`
+	// The Azure DevOps API URL to talk to. If blank, use https://dev.azure.com/.
+	API string `json:"api,omitempty" protobuf:"bytes,4,opt,name=api"`
+	// Authentication token reference, a Personal Access Token (PAT).
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,5,opt,name=tokenRef"`
+	// Labels is used to filter the PRs that you want to target
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,6,opt,name=labels"`
+}
+
+// PullRequestGeneratorAWSCodeCommit defines connection info specific to AWS CodeCommit and APIs compatible with it.
+type PullRequestGeneratorAWSCodeCommit struct {
+	// Repo name to scan. Required.
+	Repo string `json:"repo" protobuf:"bytes,1,opt,name=repo"`
+	// AWS region of the repo. If blank, the region of the environment is used.
+	Region string `json:"region,omitempty" protobuf:"bytes,2,opt,name=region"`
+	// The API endpoint to talk to, for CodeCommit compatible APIs. If blank, uses the AWS endpoint of the region.
+	API string `json:"api,omitempty" protobuf:"bytes,3,opt,name=api"`
+	// Access key ID reference. If blank, the credentials of the environment (e.g. the IAM role of the pod) are used.
+	AccessKeyIDRef *SecretRef `json:"accessKeyIDRef,omitempty" protobuf:"bytes,4,opt,name=accessKeyIDRef"`
+	// Secret access key reference. Required if AccessKeyIDRef is set.
+	SecretAccessKeyRef *SecretRef `json:"secretAccessKeyRef,omitempty" protobuf:"bytes,5,opt,name=secretAccessKeyRef"`
 }
 
 // BasicAuthBitbucketServer defines the username/(password or personal access token) for Basic auth.
 type BasicAuthBitbucketServer struct {
 	// Username for Basic auth
-	Username string `json:"username"`
+	Username string `json:"username" protobuf:"bytes,1,opt,name=username"`
 	// Password (or personal access token) reference.
-	PasswordRef *SecretRef `json:"passwordRef"`
+	PasswordRef *SecretRef `json:"passwordRef" protobuf:"bytes,2,opt,name=passwordRef"`
 }
 
 // PullRequestGeneratorFilter is a single pull request filter.
 // If multiple filter types are set on a single struct, they will be AND'd together. All filters must
 // pass for a pull request to be included.
 type PullRequestGeneratorFilter struct {
-	BranchMatch *string `json:"branchMatch,omitempty"`
+	BranchMatch *string `json:"branchMatch,omitempty" protobuf:"bytes,1,opt,name=branchMatch"`
+	// TargetBranchMatch is a regexp matched against the branch the pull request is opened against.
+	TargetBranchMatch *string `json:"targetBranchMatch,omitempty" protobuf:"bytes,2,opt,name=targetBranchMatch"`
+	// TitleMatch is a regexp matched against the title of the pull request.
+	TitleMatch *string `json:"titleMatch,omitempty" protobuf:"bytes,3,opt,name=titleMatch"`
+	// AuthorMatch is a regexp matched against the login of the pull request author.
+	AuthorMatch *string `json:"authorMatch,omitempty" protobuf:"bytes,4,opt,name=authorMatch"`
+	// Draft, when set, only includes pull requests whose draft status is equal to it.
+	Draft *bool `json:"draft,omitempty" protobuf:"varint,5,opt,name=draft"`
+}
+
+// PluginGenerator defines a generator which retrieves its parameters from an external HTTP service. The service is
+// configured by a ConfigMap in the namespace of the ApplicationSet.
+type PluginGenerator struct {
+	// ConfigMapRef is the name of the ConfigMap holding the 'baseUrl' of the plugin service and the 'token' used to
+	// authenticate to it
+	ConfigMapRef string      `json:"configMapRef" protobuf:"bytes,1,opt,name=configMapRef"`
+	Input        PluginInput `json:"input,omitempty" protobuf:"bytes,2,opt,name=input"`
+	// RequeueAfterSeconds is how long the response of the plugin service is cached before it is called again
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"bytes,3,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,4,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,opt,name=values"`
+}
+
+// PluginInput is the input which is sent to the plugin service
+type PluginInput struct {
+	// Parameters contains arbitrary key/value pairs which are passed to the plugin service
+	Parameters map[string]apiextensionsv1.JSON `json:"parameters,omitempty" protobuf:"bytes,1,opt,name=parameters"`
 }
 
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
-	Conditions []ApplicationSetCondition `json:"conditions,omitempty"`
-}
+	Conditions []ApplicationSetCondition `json:"conditions,omitempty" protobuf:"bytes,1,opt,name=conditions"`
+	// ApplicationStatus records the progress of each Application through the rollout strategy steps.
+	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,opt,name=applicationStatus"`
+	// Resources is the list of Applications owned by the ApplicationSet, with their sync and health status.
+	Resources []ApplicationSetResourceStatus `json:"resources,omitempty" protobuf:"bytes,3,opt,name=resources"`
+	// ResourcesSummary aggregates the sync and health status of the owned Applications.
+	ResourcesSummary *ApplicationSetResourcesSummary `json:"resourcesSummary,omitempty" protobuf:"bytes,4,opt,name=resourcesSummary"`
+}
+
+// ApplicationSetResourceStatus holds the sync and health status of an Application owned by an ApplicationSet.
+type ApplicationSetResourceStatus struct {
+	// Name is the name of the Application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the Application
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// SyncStatus is the sync status of the Application, e.g. Synced or OutOfSync
+	SyncStatus v1alpha1.SyncStatusCode `json:"syncStatus,omitempty" protobuf:"bytes,3,opt,name=syncStatus"`
+	// Health is the health status of the Application, e.g. Healthy or Degraded
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,4,opt,name=health"`
+	// HealthMessage is a human-readable message indicating details about the health of the Application
+	HealthMessage string `json:"healthMessage,omitempty" protobuf:"bytes,5,opt,name=healthMessage"`
+	// LastTransitionTime is the time the sync or health status of the Application last changed
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,6,opt,name=lastTransitionTime"`
+	// SkippedByPolicy is the change, update or delete, which the controller did not make to the Application because of
+	// the applications sync policy
+	SkippedByPolicy string `json:"skippedByPolicy,omitempty" protobuf:"bytes,7,opt,name=skippedByPolicy"`
+}
+
+// ApplicationSetResourcesSummary holds the number of Applications owned by an ApplicationSet, grouped by their sync
+// and health status.
+type ApplicationSetResourcesSummary struct {
+	// Total is the number of Applications owned by the ApplicationSet
+	Total int64 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// Synced is the number of Applications which are Synced
+	Synced int64 `json:"synced" protobuf:"varint,2,opt,name=synced"`
+	// OutOfSync is the number of Applications which are OutOfSync
+	OutOfSync int64 `json:"outOfSync" protobuf:"varint,3,opt,name=outOfSync"`
+	// Healthy is the number of Applications which are Healthy
+	Healthy int64 `json:"healthy" protobuf:"varint,4,opt,name=healthy"`
+	// Progressing is the number of Applications which are Progressing
+	Progressing int64 `json:"progressing" protobuf:"varint,5,opt,name=progressing"`
+	// Degraded is the number of Applications which are Degraded
+	Degraded int64 `json:"degraded" protobuf:"varint,6,opt,name=degraded"`
+}
+
+// ApplicationSetApplicationStatus contains the progress of an Application through the rollout strategy.
+type ApplicationSetApplicationStatus struct {
+	// Application is the name of the Application
+	Application string `json:"application" protobuf:"bytes,1,opt,name=application"`
+	// LastTransitionTime is the time the status was last updated
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,2,opt,name=lastTransitionTime"`
+	// Message contains human-readable message indicating details about the status
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
+	// Status is one of Waiting, Pending, Progressing or Healthy
+	Status ApplicationSetApplicationStatusType `json:"status" protobuf:"bytes,4,opt,name=status"`
+	// Step is the (1-based) rollout step the Application belongs to
+	Step string `json:"step" protobuf:"bytes,5,opt,name=step"`
+}
+
+// ApplicationSetApplicationStatusType is the progress of an Application through the rollout strategy.
+type ApplicationSetApplicationStatusType string
+
+const (
+	// ApplicationSetApplicationStatusWaiting means the Application is out of date, and waits for its step to start
+	ApplicationSetApplicationStatusWaiting ApplicationSetApplicationStatusType = "Waiting"
+	// ApplicationSetApplicationStatusPending means a sync of the Application was requested
+	ApplicationSetApplicationStatusPending ApplicationSetApplicationStatusType = "Pending"
+	// ApplicationSetApplicationStatusProgressing means the Application is being synced
+	ApplicationSetApplicationStatusProgressing ApplicationSetApplicationStatusType = "Progressing"
+	// ApplicationSetApplicationStatusHealthy means the Application is synced and Healthy
+	ApplicationSetApplicationStatusHealthy ApplicationSetApplicationStatusType = "Healthy"
+)
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usally an error or warning
 type ApplicationSetCondition struct {
@@ -527,7 +761,7 @@ const (
 // prefix "Info" means informational condition
 type ApplicationSetConditionType string
 
-//ErrorOccurred / ParametersGenerated / TemplateRendered / ResourcesUpToDate
+// ErrorOccurred / ParametersGenerated / TemplateRendered / ResourcesUpToDate
 const (
 	ApplicationSetConditionErrorOccurred       ApplicationSetConditionType = "ErrorOccurred"
 	ApplicationSetConditionParametersGenerated ApplicationSetConditionType = "ParametersGenerated"
@@ -548,14 +782,26 @@ const (
 	ApplicationSetReasonDeleteApplicationError           = "DeleteApplicationError"
 	ApplicationSetReasonRefreshApplicationError          = "RefreshApplicationError"
 	ApplicationSetReasonApplicationValidationError       = "ApplicationValidationError"
+	ApplicationSetReasonSyncApplicationError             = "SyncApplicationError"
+	ApplicationSetReasonSyncPolicyError                  = "SyncPolicyError"
 )
 
 // ApplicationSetList contains a list of ApplicationSet
 // +kubebuilder:object:root=true
 type ApplicationSetList struct {
 	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []ApplicationSet `json:"items"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ApplicationSet `json:"items" protobuf:"bytes,2,opt,name=items"`
+}
+
+// ApplicationSetWatchEvent contains information about application set change.
+type ApplicationSetWatchEvent struct {
+	Type watch.EventType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=k8s.io/apimachinery/pkg/watch.EventType"`
+
+	// ApplicationSet is:
+	//  * If Type is Added or Modified: the new state of the object.
+	//  * If Type is Deleted: the state of the object immediately before deletion.
+	ApplicationSet ApplicationSet `json:"applicationSet" protobuf:"bytes,2,opt,name=applicationSet"`
 }
 
 func init() {
@@ -568,6 +814,12 @@ func (a *ApplicationSet) RefreshRequired() bool {
 	return found
 }
 
+// RBACName returns the full qualified RBAC resource name for the application set, which is the project of the
+// application template followed by the name of the application set
+func (a *ApplicationSet) RBACName() string {
+	return fmt.Sprintf("%s/%s", a.Spec.Template.Spec.GetProject(), a.Name)
+}
+
 // SetConditions updates the applicationset status conditions for a subset of evaluated types.
 // If the applicationset has a pre-existing condition of a type that is not in the evaluated list,
 // it will be preserved. If the applicationset has a pre-existing condition of a type, status, reason that
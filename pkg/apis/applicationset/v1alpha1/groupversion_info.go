@@ -33,4 +33,7 @@ var (
 
 	// AddToScheme adds the types in this group-version to the given scheme.
 	AddToScheme = SchemeBuilder.AddToScheme
+
+	// ApplicationSetResource is the group version resource of the ApplicationSet CRD
+	ApplicationSetResource = GroupVersion.WithResource("applicationsets")
 )
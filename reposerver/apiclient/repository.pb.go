@@ -6,6 +6,7 @@ package apiclient
 import (
 	context "context"
 	fmt "fmt"
+	apiclient "github.com/argoproj/argo-cd/v2/cmpserver/apiclient"
 	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -44,15 +45,21 @@ type ManifestRequest struct {
 	KubeVersion       string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
 	ApiVersions       []string                           `protobuf:"bytes,15,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
 	// Request to verify the signature when generating the manifests (only for Git repositories)
-	VerifySignature      bool                  `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
-	HelmRepoCreds        []*v1alpha1.RepoCreds `protobuf:"bytes,17,rep,name=helmRepoCreds,proto3" json:"helmRepoCreds,omitempty"`
-	NoRevisionCache      bool                  `protobuf:"varint,18,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	TrackingMethod       string                `protobuf:"bytes,19,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	EnabledSourceTypes   map[string]bool       `protobuf:"bytes,20,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	HelmOptions          *v1alpha1.HelmOptions `protobuf:"bytes,21,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	VerifySignature    bool                  `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
+	HelmRepoCreds      []*v1alpha1.RepoCreds `protobuf:"bytes,17,rep,name=helmRepoCreds,proto3" json:"helmRepoCreds,omitempty"`
+	NoRevisionCache    bool                  `protobuf:"varint,18,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	TrackingMethod     string                `protobuf:"bytes,19,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	EnabledSourceTypes map[string]bool       `protobuf:"bytes,20,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	HelmOptions        *v1alpha1.HelmOptions `protobuf:"bytes,21,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
+	// Sources of the same application which can be referenced from Helm value files, keyed by "$" followed by the name of the ref
+	RefSources map[string]*v1alpha1.RefTarget `protobuf:"bytes,22,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether the application has multiple sources
+	HasMultipleSources bool `protobuf:"varint,23,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
+	// Request to verify the signature of the chart when generating the manifests (only for Helm charts)
+	VerifyChartSignature bool     `protobuf:"varint,24,opt,name=verifyChartSignature,proto3" json:"verifyChartSignature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -214,6 +221,27 @@ func (m *ManifestRequest) GetHelmOptions() *v1alpha1.HelmOptions {
 	return nil
 }
 
+func (m *ManifestRequest) GetRefSources() map[string]*v1alpha1.RefTarget {
+	if m != nil {
+		return m.RefSources
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetHasMultipleSources() bool {
+	if m != nil {
+		return m.HasMultipleSources
+	}
+	return false
+}
+
+func (m *ManifestRequest) GetVerifyChartSignature() bool {
+	if m != nil {
+		return m.VerifyChartSignature
+	}
+	return false
+}
+
 // TestRepositoryRequest is a query to test repository is valid or not and has valid access.
 type TestRepositoryRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -313,12 +341,14 @@ func (m *TestRepositoryResponse) GetVerifiedRepository() bool {
 
 // ResolveRevisionRequest
 type ResolveRevisionRequest struct {
-	Repo                 *v1alpha1.Repository  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	App                  *v1alpha1.Application `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
-	AmbiguousRevision    string                `protobuf:"bytes,3,opt,name=ambiguousRevision,proto3" json:"ambiguousRevision,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Repo              *v1alpha1.Repository  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	App               *v1alpha1.Application `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	AmbiguousRevision string                `protobuf:"bytes,3,opt,name=ambiguousRevision,proto3" json:"ambiguousRevision,omitempty"`
+	// sourceIndex is the index of the source of the application whose revision is resolved
+	SourceIndex          int64    `protobuf:"varint,4,opt,name=sourceIndex,proto3" json:"sourceIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ResolveRevisionRequest) Reset()         { *m = ResolveRevisionRequest{} }
@@ -375,6 +405,13 @@ func (m *ResolveRevisionRequest) GetAmbiguousRevision() string {
 	return ""
 }
 
+func (m *ResolveRevisionRequest) GetSourceIndex() int64 {
+	if m != nil {
+		return m.SourceIndex
+	}
+	return 0
+}
+
 // ResolveRevisionResponse
 type ResolveRevisionResponse struct {
 	// returns the resolved revision
@@ -440,10 +477,12 @@ type ManifestResponse struct {
 	Revision   string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	SourceType string `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
 	// Raw response of git verify-commit operation (always the empty string for Helm)
-	VerifyResult         string   `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
+	// Result of the chart signature verification, if it has been requested (only for Helm charts)
+	ChartSignature       *ChartSignatureVerification `protobuf:"bytes,8,opt,name=chartSignature,proto3" json:"chartSignature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
@@ -521,6 +560,98 @@ func (m *ManifestResponse) GetVerifyResult() string {
 	return ""
 }
 
+func (m *ManifestResponse) GetChartSignature() *ChartSignatureVerification {
+	if m != nil {
+		return m.ChartSignature
+	}
+	return nil
+}
+
+// ChartSignatureVerification is the result of verifying the signature of a Helm chart
+type ChartSignatureVerification struct {
+	// Type of the signature, provenance for charts from Helm repositories or cosign for charts from OCI registries
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Whether a signature has been found for the chart
+	Signed bool `protobuf:"varint,2,opt,name=signed,proto3" json:"signed,omitempty"`
+	// Raw response of the GnuPG verification of the provenance file
+	VerifyResult string `protobuf:"bytes,3,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
+	// Reason why the signature is not valid
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// Names of the cosign keys which the signature has been verified with
+	KeyIDs               []string `protobuf:"bytes,6,rep,name=keyIDs,proto3" json:"keyIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChartSignatureVerification) Reset()         { *m = ChartSignatureVerification{} }
+func (m *ChartSignatureVerification) String() string { return proto.CompactTextString(m) }
+func (*ChartSignatureVerification) ProtoMessage()    {}
+func (*ChartSignatureVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{6}
+}
+func (m *ChartSignatureVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChartSignatureVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChartSignatureVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChartSignatureVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChartSignatureVerification.Merge(m, src)
+}
+func (m *ChartSignatureVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChartSignatureVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChartSignatureVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChartSignatureVerification proto.InternalMessageInfo
+
+func (m *ChartSignatureVerification) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ChartSignatureVerification) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+func (m *ChartSignatureVerification) GetVerifyResult() string {
+	if m != nil {
+		return m.VerifyResult
+	}
+	return ""
+}
+
+func (m *ChartSignatureVerification) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChartSignatureVerification) GetKeyIDs() []string {
+	if m != nil {
+		return m.KeyIDs
+	}
+	return nil
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -532,7 +663,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{6}
+	return fileDescriptor_dd8723cfcc820480, []int{7}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +712,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{7}
+	return fileDescriptor_dd8723cfcc820480, []int{8}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +769,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{8}
+	return fileDescriptor_dd8723cfcc820480, []int{9}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +831,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{10}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +888,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{11}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,6 +993,7 @@ type RepoAppDetailsResponse struct {
 	Helm                 *HelmAppSpec      `protobuf:"bytes,3,opt,name=helm,proto3" json:"helm,omitempty"`
 	Kustomize            *KustomizeAppSpec `protobuf:"bytes,4,opt,name=kustomize,proto3" json:"kustomize,omitempty"`
 	Directory            *DirectoryAppSpec `protobuf:"bytes,5,opt,name=directory,proto3" json:"directory,omitempty"`
+	Plugin               *PluginAppSpec    `protobuf:"bytes,6,opt,name=plugin,proto3" json:"plugin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -871,7 +1003,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,6 +1060,13 @@ func (m *RepoAppDetailsResponse) GetDirectory() *DirectoryAppSpec {
 	return nil
 }
 
+func (m *RepoAppDetailsResponse) GetPlugin() *PluginAppSpec {
+	if m != nil {
+		return m.Plugin
+	}
+	return nil
+}
+
 type RepoServerRevisionMetadataRequest struct {
 	// the repo
 	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -944,7 +1083,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1152,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1090,7 +1229,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1276,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,6 +1305,55 @@ func (m *DirectoryAppSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DirectoryAppSpec proto.InternalMessageInfo
 
+// PluginAppSpec contains details about a plugin-type Application
+type PluginAppSpec struct {
+	// parametersAnnouncement is a list of the parameters the plugin accepts for the application
+	ParametersAnnouncement []*apiclient.ParameterAnnouncement `protobuf:"bytes,1,rep,name=parametersAnnouncement,proto3" json:"parametersAnnouncement,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
+	XXX_unrecognized       []byte                             `json:"-"`
+	XXX_sizecache          int32                              `json:"-"`
+}
+
+func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
+func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
+func (*PluginAppSpec) ProtoMessage()    {}
+func (*PluginAppSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{17}
+}
+func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginAppSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PluginAppSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PluginAppSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginAppSpec.Merge(m, src)
+}
+func (m *PluginAppSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginAppSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginAppSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginAppSpec proto.InternalMessageInfo
+
+func (m *PluginAppSpec) GetParametersAnnouncement() []*apiclient.ParameterAnnouncement {
+	if m != nil {
+		return m.ParametersAnnouncement
+	}
+	return nil
+}
+
 type HelmChartsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -1177,7 +1365,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1225,7 +1413,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,7 +1467,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,14 +1503,164 @@ func (m *HelmChartsResponse) GetItems() []*HelmChart {
 	return nil
 }
 
+// HydrateManifestsRequest requests to commit the rendered manifests of an application to a branch
+type HydrateManifestsRequest struct {
+	Repo    *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	AppName string               `protobuf:"bytes,2,opt,name=appName,proto3" json:"appName,omitempty"`
+	// the source the manifests have been rendered from
+	DrySource *v1alpha1.ApplicationSource `protobuf:"bytes,3,opt,name=drySource,proto3" json:"drySource,omitempty"`
+	// the resolved revision of the source the manifests have been rendered from
+	DrySHA       string `protobuf:"bytes,4,opt,name=drySHA,proto3" json:"drySHA,omitempty"`
+	TargetBranch string `protobuf:"bytes,5,opt,name=targetBranch,proto3" json:"targetBranch,omitempty"`
+	// the directory in the target branch to write the manifests to
+	Path                 string   `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	Manifests            []string `protobuf:"bytes,7,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HydrateManifestsRequest) Reset()         { *m = HydrateManifestsRequest{} }
+func (m *HydrateManifestsRequest) String() string { return proto.CompactTextString(m) }
+func (*HydrateManifestsRequest) ProtoMessage()    {}
+func (*HydrateManifestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{21}
+}
+func (m *HydrateManifestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateManifestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HydrateManifestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HydrateManifestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateManifestsRequest.Merge(m, src)
+}
+func (m *HydrateManifestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateManifestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateManifestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateManifestsRequest proto.InternalMessageInfo
+
+func (m *HydrateManifestsRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *HydrateManifestsRequest) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
+func (m *HydrateManifestsRequest) GetDrySource() *v1alpha1.ApplicationSource {
+	if m != nil {
+		return m.DrySource
+	}
+	return nil
+}
+
+func (m *HydrateManifestsRequest) GetDrySHA() string {
+	if m != nil {
+		return m.DrySHA
+	}
+	return ""
+}
+
+func (m *HydrateManifestsRequest) GetTargetBranch() string {
+	if m != nil {
+		return m.TargetBranch
+	}
+	return ""
+}
+
+func (m *HydrateManifestsRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HydrateManifestsRequest) GetManifests() []string {
+	if m != nil {
+		return m.Manifests
+	}
+	return nil
+}
+
+// HydrateManifestsResponse
+type HydrateManifestsResponse struct {
+	// the revision of the target branch holding the rendered manifests
+	HydratedSHA          string   `protobuf:"bytes,1,opt,name=hydratedSHA,proto3" json:"hydratedSHA,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HydrateManifestsResponse) Reset()         { *m = HydrateManifestsResponse{} }
+func (m *HydrateManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*HydrateManifestsResponse) ProtoMessage()    {}
+func (*HydrateManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{22}
+}
+func (m *HydrateManifestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HydrateManifestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HydrateManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateManifestsResponse.Merge(m, src)
+}
+func (m *HydrateManifestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateManifestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateManifestsResponse proto.InternalMessageInfo
+
+func (m *HydrateManifestsResponse) GetHydratedSHA() string {
+	if m != nil {
+		return m.HydratedSHA
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
+	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
 	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
 	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
 	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
 	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ChartSignatureVerification)(nil), "repository.ChartSignatureVerification")
 	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
 	proto.RegisterType((*Refs)(nil), "repository.Refs")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
@@ -1336,9 +1674,12 @@ func init() {
 	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
 	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
 	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
+	proto.RegisterType((*PluginAppSpec)(nil), "repository.PluginAppSpec")
 	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
 	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
 	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
+	proto.RegisterType((*HydrateManifestsRequest)(nil), "repository.HydrateManifestsRequest")
+	proto.RegisterType((*HydrateManifestsResponse)(nil), "repository.HydrateManifestsResponse")
 }
 
 func init() {
@@ -1346,96 +1687,118 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 1419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdd, 0x6f, 0x1b, 0xc5,
-	0x16, 0xcf, 0xda, 0x8e, 0x63, 0x1f, 0xb7, 0x89, 0x33, 0xfd, 0xda, 0xeb, 0x9b, 0x46, 0xe9, 0x5e,
-	0xdd, 0x2a, 0xba, 0xbd, 0xb5, 0xd5, 0x54, 0x02, 0xd4, 0x0a, 0x24, 0x93, 0xb6, 0x29, 0x6a, 0xd3,
-	0x84, 0x4d, 0x85, 0x04, 0x54, 0xa0, 0xf1, 0xfa, 0x64, 0x3d, 0x78, 0x3f, 0xa6, 0xfb, 0x61, 0xe4,
-	0x4a, 0x3c, 0xf0, 0x2f, 0xf0, 0xc0, 0xbf, 0xc2, 0x23, 0x4f, 0x20, 0x1e, 0xe1, 0x3f, 0x40, 0xe5,
-	0x81, 0x7f, 0x03, 0xcd, 0xec, 0xa7, 0xd7, 0x9b, 0xb6, 0xc8, 0x6d, 0x2a, 0x5e, 0x92, 0x99, 0x33,
-	0x33, 0xe7, 0x6b, 0x7e, 0x73, 0xce, 0x6f, 0x0d, 0x57, 0x3d, 0xe4, 0xae, 0x8f, 0xde, 0x04, 0xbd,
-	0x9e, 0x1c, 0xb2, 0xc0, 0xf5, 0xa6, 0xb9, 0x61, 0x97, 0x7b, 0x6e, 0xe0, 0x12, 0xc8, 0x24, 0x9d,
-	0x87, 0x26, 0x0b, 0x46, 0xe1, 0xa0, 0x6b, 0xb8, 0x76, 0x8f, 0x7a, 0xa6, 0xcb, 0x3d, 0xf7, 0x2b,
-	0x39, 0xb8, 0x6e, 0x0c, 0x7b, 0x93, 0x9d, 0x1e, 0x1f, 0x9b, 0x3d, 0xca, 0x99, 0xdf, 0xa3, 0x9c,
-	0x5b, 0xcc, 0xa0, 0x01, 0x73, 0x9d, 0xde, 0xe4, 0x06, 0xb5, 0xf8, 0x88, 0xde, 0xe8, 0x99, 0xe8,
-	0xa0, 0x47, 0x03, 0x1c, 0x46, 0x9a, 0xb5, 0xef, 0x00, 0xd6, 0xf6, 0xa9, 0xc3, 0x8e, 0xd1, 0x0f,
-	0x74, 0x7c, 0x1a, 0xa2, 0x1f, 0x90, 0x27, 0x50, 0x13, 0xf6, 0x54, 0x65, 0x4b, 0xd9, 0x6e, 0xed,
-	0xdc, 0xef, 0x66, 0x06, 0xbb, 0x89, 0x41, 0x39, 0xf8, 0xd2, 0x18, 0x76, 0x27, 0x3b, 0x5d, 0x3e,
-	0x36, 0xbb, 0xc2, 0x60, 0x37, 0x67, 0xb0, 0x9b, 0x18, 0xec, 0xea, 0xa9, 0xe7, 0xba, 0xd4, 0x4a,
-	0x3a, 0xd0, 0xf0, 0x70, 0xc2, 0x7c, 0xe6, 0x3a, 0x6a, 0x65, 0x4b, 0xd9, 0x6e, 0xea, 0xe9, 0x9c,
-	0xa8, 0xb0, 0xe2, 0xb8, 0xbb, 0xd4, 0x18, 0xa1, 0x5a, 0xdd, 0x52, 0xb6, 0x1b, 0x7a, 0x32, 0x25,
-	0x5b, 0xd0, 0xa2, 0x9c, 0x3f, 0xa4, 0x03, 0xb4, 0x1e, 0xe0, 0x54, 0xad, 0xc9, 0x83, 0x79, 0x91,
-	0x38, 0x4b, 0x39, 0x7f, 0x44, 0x6d, 0x54, 0x97, 0xe5, 0x6a, 0x32, 0x25, 0x1b, 0xd0, 0x74, 0xa8,
-	0x8d, 0x3e, 0xa7, 0x06, 0xaa, 0x0d, 0xb9, 0x96, 0x09, 0xc8, 0x37, 0xb0, 0x9e, 0x73, 0xfc, 0xc8,
-	0x0d, 0x3d, 0x03, 0x55, 0x90, 0xa1, 0x1f, 0x2c, 0x16, 0x7a, 0xbf, 0xa8, 0x56, 0x9f, 0xb7, 0x44,
-	0xbe, 0x80, 0x65, 0x79, 0xb9, 0x6a, 0x6b, 0xab, 0xfa, 0x5a, 0xb3, 0x1d, 0xa9, 0x25, 0x0e, 0xac,
-	0x70, 0x2b, 0x34, 0x99, 0xe3, 0xab, 0x67, 0xa4, 0x85, 0xc7, 0x8b, 0x59, 0xd8, 0x75, 0x9d, 0x63,
-	0x66, 0xee, 0x53, 0x87, 0x9a, 0x68, 0xa3, 0x13, 0x1c, 0x4a, 0xe5, 0x7a, 0x62, 0x84, 0x3c, 0x83,
-	0xf6, 0x38, 0xf4, 0x03, 0xd7, 0x66, 0xcf, 0xf0, 0x80, 0x8b, 0xb3, 0xbe, 0x7a, 0x56, 0x66, 0xf3,
-	0xd1, 0x62, 0x86, 0x1f, 0x14, 0xb4, 0xea, 0x73, 0x76, 0x04, 0x48, 0xc6, 0xe1, 0x00, 0x3f, 0x41,
-	0x4f, 0xa2, 0x6b, 0x35, 0x02, 0x49, 0x4e, 0x14, 0xc1, 0x88, 0xc5, 0x33, 0x5f, 0x5d, 0xdb, 0xaa,
-	0x46, 0x30, 0x4a, 0x45, 0x64, 0x1b, 0xd6, 0x26, 0xe8, 0xb1, 0xe3, 0xe9, 0x11, 0x33, 0x1d, 0x1a,
-	0x84, 0x1e, 0xaa, 0x6d, 0x09, 0xc5, 0xa2, 0x98, 0xd8, 0x70, 0x76, 0x84, 0x96, 0x2d, 0x52, 0xbe,
-	0xeb, 0xe1, 0xd0, 0x57, 0xd7, 0x65, 0x7e, 0xf7, 0x16, 0xbf, 0x41, 0xa9, 0x4e, 0x9f, 0xd5, 0x2e,
-	0x1c, 0x73, 0x5c, 0x3d, 0x7e, 0x29, 0xd1, 0x1b, 0x21, 0x91, 0x63, 0x05, 0x31, 0xb9, 0x0a, 0xab,
-	0x81, 0x47, 0x8d, 0x31, 0x73, 0xcc, 0x7d, 0x0c, 0x46, 0xee, 0x50, 0x3d, 0x27, 0x33, 0x51, 0x90,
-	0x12, 0x03, 0x08, 0x3a, 0x74, 0x60, 0xe1, 0x30, 0xc2, 0xe2, 0xe3, 0x29, 0x47, 0x5f, 0x3d, 0x2f,
-	0xa3, 0xb8, 0xd9, 0xcd, 0x15, 0xa1, 0x42, 0x81, 0xe8, 0xde, 0x9d, 0x3b, 0x75, 0xd7, 0x09, 0xbc,
-	0xa9, 0x5e, 0xa2, 0x8e, 0x8c, 0xa1, 0x25, 0xe2, 0x48, 0xa0, 0x70, 0x41, 0x42, 0xe1, 0xa3, 0xc5,
-	0x72, 0x74, 0x3f, 0x53, 0xa8, 0xe7, 0xb5, 0x77, 0xee, 0xc2, 0xa5, 0x13, 0x7c, 0x23, 0x6d, 0xa8,
-	0x8e, 0x71, 0x2a, 0x6b, 0x5a, 0x53, 0x17, 0x43, 0x72, 0x1e, 0x96, 0x27, 0xd4, 0x0a, 0x51, 0x56,
-	0xa1, 0x86, 0x1e, 0x4d, 0x6e, 0x55, 0xde, 0x53, 0xb4, 0x10, 0x2e, 0x3c, 0x96, 0xe1, 0xa6, 0x8f,
-	0xe9, 0x34, 0x2a, 0xa3, 0x76, 0x1f, 0x2e, 0x16, 0xcd, 0xfa, 0xdc, 0x75, 0x7c, 0x24, 0x5d, 0x20,
-	0x12, 0x7d, 0x0c, 0x87, 0xd9, 0xaa, 0xf4, 0xa2, 0xa1, 0x97, 0xac, 0x68, 0xdf, 0x56, 0xe0, 0xa2,
-	0x8e, 0xbe, 0x6b, 0x4d, 0x30, 0x81, 0xc6, 0xe9, 0x14, 0xf7, 0xcf, 0xa1, 0x4a, 0x39, 0x97, 0x19,
-	0x5d, 0xf8, 0x96, 0x73, 0xe5, 0x53, 0x17, 0x5a, 0xc9, 0xff, 0x61, 0x9d, 0xda, 0x03, 0x66, 0x86,
-	0x6e, 0xe8, 0x27, 0x61, 0xc9, 0x3e, 0xd1, 0xd4, 0xe7, 0x17, 0x34, 0x03, 0x2e, 0xcd, 0xa5, 0x20,
-	0x4e, 0x67, 0xbe, 0x05, 0x29, 0x85, 0x16, 0x54, 0x6a, 0xa4, 0x72, 0x92, 0x91, 0x9f, 0x15, 0x68,
-	0x67, 0xaf, 0x23, 0x56, 0xbf, 0x01, 0x4d, 0x3b, 0x96, 0xf9, 0xaa, 0x22, 0x4b, 0x4c, 0x26, 0x98,
-	0xed, 0x46, 0x95, 0x62, 0x37, 0xba, 0x08, 0xf5, 0x88, 0x0f, 0xc4, 0x81, 0xc5, 0xb3, 0x19, 0x97,
-	0x6b, 0x05, 0x97, 0x37, 0x01, 0xfc, 0x14, 0xee, 0x6a, 0x5d, 0xae, 0xe6, 0x24, 0x44, 0x83, 0x33,
-	0x51, 0xed, 0xd2, 0xd1, 0x0f, 0xad, 0x40, 0x5d, 0x91, 0x3b, 0x66, 0x64, 0x9a, 0x0b, 0x6b, 0x0f,
-	0x99, 0x88, 0xe1, 0xd8, 0x3f, 0x1d, 0xb0, 0xbf, 0x03, 0x35, 0x61, 0x4c, 0x04, 0x36, 0xf0, 0xa8,
-	0x63, 0x8c, 0x30, 0xc9, 0x55, 0x3a, 0x27, 0x04, 0x6a, 0x01, 0x35, 0x7d, 0xb5, 0x22, 0xe5, 0x72,
-	0xac, 0xfd, 0x50, 0x89, 0x3c, 0xed, 0x73, 0xee, 0xbf, 0x7d, 0xc2, 0x52, 0x5e, 0x42, 0xab, 0xf3,
-	0x25, 0xb4, 0xe0, 0xf2, 0xdf, 0x29, 0xa1, 0xaf, 0xaf, 0xaa, 0xad, 0xf4, 0x39, 0x17, 0x8e, 0x90,
-	0x1b, 0x50, 0xa3, 0x9c, 0x47, 0x09, 0x6f, 0xed, 0x5c, 0xce, 0x3b, 0x1a, 0x6f, 0x11, 0xff, 0x63,
-	0x97, 0xe4, 0xd6, 0xce, 0xbb, 0xd0, 0x4c, 0x45, 0x2f, 0x33, 0xdb, 0xcc, 0x9b, 0xfd, 0xb3, 0x0e,
-	0xff, 0x12, 0x39, 0x3d, 0x92, 0x40, 0xee, 0x73, 0x7e, 0x07, 0x03, 0xca, 0x2c, 0xff, 0xe3, 0x10,
-	0xbd, 0xe9, 0x1b, 0xbe, 0x3a, 0x13, 0xea, 0xd1, 0x3b, 0x88, 0x2b, 0xd2, 0x6b, 0x27, 0x74, 0xb1,
-	0xfa, 0x8c, 0xc5, 0x55, 0xdf, 0x0c, 0x8b, 0x2b, 0x63, 0x55, 0xb5, 0x53, 0x62, 0x55, 0x27, 0x13,
-	0xeb, 0x1c, 0x5d, 0xaf, 0xcf, 0xd2, 0xf5, 0x12, 0xb2, 0xb2, 0xf2, 0xaa, 0x64, 0xa5, 0x51, 0x4a,
-	0x56, 0xec, 0xd2, 0x97, 0xd6, 0x94, 0xe9, 0x7e, 0x3f, 0x0f, 0xe0, 0x13, 0xb1, 0xb6, 0x08, 0x6d,
-	0x81, 0x7f, 0x02, 0x6d, 0xf9, 0x4d, 0x11, 0x5d, 0x9f, 0xbb, 0x59, 0xdc, 0x69, 0x4b, 0x12, 0x95,
-	0x54, 0x34, 0x87, 0x48, 0x8f, 0x1c, 0x93, 0x6b, 0x50, 0x13, 0x4e, 0xc8, 0x46, 0xd3, 0xda, 0xb9,
-	0x94, 0xcf, 0xa1, 0xf0, 0xb4, 0xcf, 0xf9, 0x11, 0x47, 0x43, 0x97, 0x9b, 0xc8, 0x2d, 0x68, 0xa6,
-	0xc0, 0x88, 0x91, 0xb7, 0x91, 0x3f, 0x91, 0xe2, 0x28, 0x39, 0x96, 0x6d, 0x17, 0x67, 0x87, 0xcc,
-	0x43, 0x43, 0x92, 0x96, 0xe5, 0xf9, 0xb3, 0x77, 0x92, 0xc5, 0xf4, 0x6c, 0xba, 0x5d, 0xfb, 0x49,
-	0x81, 0x2b, 0xd9, 0x8d, 0x26, 0xd0, 0xd9, 0xc7, 0x80, 0x0e, 0x69, 0x40, 0xdf, 0x7e, 0x03, 0xb8,
-	0x0a, 0xab, 0xc6, 0x08, 0x8d, 0x71, 0xf6, 0xb5, 0x10, 0x7d, 0xb8, 0x16, 0xa4, 0xda, 0x8f, 0x15,
-	0x68, 0xe5, 0xb2, 0x2a, 0x2e, 0x44, 0x34, 0xfd, 0xe4, 0x42, 0xc4, 0x58, 0xf4, 0x71, 0x79, 0x99,
-	0xf7, 0x98, 0x15, 0x37, 0x91, 0xa6, 0x9e, 0x93, 0x90, 0x31, 0x00, 0xa7, 0x1e, 0xb5, 0x31, 0x40,
-	0x4f, 0x3c, 0x7f, 0x01, 0xfd, 0x07, 0x8b, 0x43, 0xf2, 0x30, 0xd1, 0xa9, 0xe7, 0xd4, 0x0b, 0x22,
-	0x22, 0x4d, 0xfb, 0xf1, 0xa3, 0x8f, 0x67, 0xe4, 0x6b, 0x58, 0x3d, 0x66, 0x16, 0x1e, 0x66, 0x8e,
-	0xd4, 0xa5, 0x23, 0x07, 0x8b, 0x3b, 0x72, 0x2f, 0xaf, 0x57, 0x2f, 0x98, 0xd1, 0xfe, 0x07, 0xed,
-	0x22, 0xc8, 0x84, 0x93, 0xcc, 0xa6, 0x66, 0x9a, 0xad, 0x78, 0xa6, 0x11, 0x68, 0x17, 0x41, 0xa5,
-	0x3d, 0x85, 0x75, 0x61, 0x64, 0x77, 0x44, 0xbd, 0xe0, 0x94, 0x38, 0xce, 0x6d, 0x68, 0xa6, 0x26,
-	0x4b, 0x6f, 0xbc, 0x03, 0x8d, 0x49, 0xf2, 0x2d, 0x1a, 0x91, 0x9c, 0x74, 0xae, 0xf5, 0x81, 0xe4,
-	0xfd, 0x8d, 0x1f, 0xf2, 0x35, 0x58, 0x66, 0x01, 0xda, 0x49, 0xeb, 0xbe, 0x50, 0x7c, 0xb5, 0x72,
-	0xbb, 0x1e, 0xed, 0xd9, 0xf9, 0x63, 0x19, 0xd6, 0xb3, 0xc7, 0x23, 0xfe, 0x32, 0x03, 0xc9, 0x01,
-	0xb4, 0xf7, 0xe2, 0x5f, 0x81, 0x12, 0xea, 0x4a, 0xfe, 0xfd, 0x82, 0xcf, 0xbd, 0xce, 0x46, 0xf9,
-	0x62, 0xe4, 0x91, 0xb6, 0x44, 0x3e, 0x85, 0xd5, 0xd9, 0xef, 0x16, 0x72, 0x25, 0x7f, 0xa2, 0xf4,
-	0x53, 0xaa, 0xa3, 0xbd, 0x68, 0x4b, 0xaa, 0xfa, 0x09, 0xac, 0x15, 0x48, 0x3c, 0xd1, 0x66, 0x8b,
-	0x7d, 0xd9, 0x47, 0x4e, 0xe7, 0x3f, 0x2f, 0xdc, 0x93, 0x6a, 0xbf, 0x0d, 0x8d, 0x84, 0xf4, 0xce,
-	0x66, 0xa0, 0x40, 0x85, 0x3b, 0xed, 0x59, 0x7d, 0xc7, 0xbe, 0xb6, 0x44, 0x3e, 0x88, 0x0e, 0x0b,
-	0x52, 0x34, 0x7f, 0x38, 0x47, 0xf5, 0x3a, 0xe7, 0x4a, 0xe8, 0x95, 0x0c, 0xed, 0xec, 0x1e, 0x06,
-	0x59, 0xad, 0x26, 0xff, 0x7d, 0xa5, 0x2e, 0xd6, 0xd1, 0x8a, 0xdb, 0xe6, 0xcb, 0xbd, 0xb6, 0x44,
-	0xbe, 0x57, 0xe0, 0xdc, 0x1e, 0x06, 0xc5, 0x82, 0x49, 0xae, 0x97, 0x1b, 0x39, 0xa1, 0xb0, 0x76,
-	0x1e, 0x2d, 0xfa, 0x22, 0x66, 0xd5, 0x6a, 0x4b, 0xe4, 0x50, 0x86, 0x9d, 0x21, 0x9b, 0x5c, 0x2e,
-	0x85, 0x70, 0x9a, 0xbd, 0xcd, 0x93, 0x96, 0x93, 0x50, 0x3f, 0xec, 0xff, 0xf2, 0x7c, 0x53, 0xf9,
-	0xf5, 0xf9, 0xa6, 0xf2, 0xfb, 0xf3, 0x4d, 0xe5, 0xb3, 0x9b, 0x2f, 0xf9, 0x79, 0x34, 0xf7, 0x8b,
-	0x2b, 0xe5, 0xcc, 0xb0, 0x18, 0x3a, 0xc1, 0xa0, 0x2e, 0x7f, 0x0c, 0xbd, 0xf9, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x65, 0x04, 0x2b, 0x5c, 0x90, 0x15, 0x00, 0x00,
+	// 1763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0x4d, 0x6f, 0x23, 0x49,
+	0x35, 0xfe, 0x88, 0x63, 0x3f, 0x4f, 0x12, 0xa7, 0x66, 0x26, 0xe9, 0x6d, 0x32, 0x91, 0xb7, 0x81,
+	0x28, 0x62, 0x58, 0x5b, 0xf1, 0x48, 0x80, 0x76, 0x01, 0xc9, 0x9b, 0x99, 0x4d, 0x46, 0x99, 0x64,
+	0x42, 0x27, 0xac, 0x04, 0x2c, 0xac, 0x2a, 0xed, 0x72, 0xbb, 0xb1, 0xbb, 0xbb, 0xb6, 0xab, 0xdb,
+	0xe0, 0x95, 0xb8, 0x21, 0x7e, 0xc2, 0x1e, 0xb9, 0xf0, 0x23, 0x38, 0x72, 0x01, 0x89, 0x03, 0x07,
+	0x7e, 0x02, 0x9a, 0x0b, 0x37, 0x7e, 0x03, 0xaa, 0xea, 0xaf, 0xea, 0x76, 0x3b, 0x3b, 0x2b, 0x27,
+	0x99, 0x4b, 0xd2, 0xf5, 0xea, 0x7d, 0xd7, 0xab, 0xf7, 0x51, 0x86, 0x7d, 0x8f, 0x50, 0x97, 0x11,
+	0x6f, 0x4a, 0xbc, 0xae, 0xf8, 0xb4, 0x7c, 0xd7, 0x9b, 0x49, 0x9f, 0x1d, 0xea, 0xb9, 0xbe, 0x8b,
+	0x20, 0x85, 0xa8, 0xaf, 0x4c, 0xcb, 0x1f, 0x05, 0xd7, 0x1d, 0xc3, 0xb5, 0xbb, 0xd8, 0x33, 0x5d,
+	0xea, 0xb9, 0xbf, 0x15, 0x1f, 0x1f, 0x18, 0x83, 0xee, 0xb4, 0xd7, 0xa5, 0x63, 0xb3, 0x8b, 0xa9,
+	0xc5, 0xba, 0x98, 0xd2, 0x89, 0x65, 0x60, 0xdf, 0x72, 0x9d, 0xee, 0xf4, 0x10, 0x4f, 0xe8, 0x08,
+	0x1f, 0x76, 0x4d, 0xe2, 0x10, 0x0f, 0xfb, 0x64, 0x10, 0x72, 0x56, 0x9f, 0x18, 0x36, 0x8d, 0x14,
+	0xa0, 0x93, 0xc0, 0xb4, 0x9c, 0xe8, 0x5f, 0xb8, 0xad, 0xfd, 0xeb, 0x01, 0x6c, 0x9e, 0x61, 0xc7,
+	0x1a, 0x12, 0xe6, 0xeb, 0xe4, 0x8b, 0x80, 0x30, 0x1f, 0x7d, 0x06, 0x55, 0xae, 0x8e, 0x52, 0x6a,
+	0x97, 0x0e, 0x9a, 0xbd, 0x93, 0x4e, 0xaa, 0x4f, 0x27, 0xd6, 0x47, 0x7c, 0x7c, 0x6e, 0x0c, 0x3a,
+	0xd3, 0x5e, 0x87, 0x8e, 0xcd, 0x0e, 0xd7, 0xa7, 0x23, 0xe9, 0xd3, 0x89, 0xf5, 0xe9, 0xe8, 0x89,
+	0x61, 0xba, 0xe0, 0x8a, 0x54, 0xa8, 0x7b, 0x64, 0x6a, 0x31, 0xcb, 0x75, 0x94, 0x72, 0xbb, 0x74,
+	0xd0, 0xd0, 0x93, 0x35, 0x52, 0x60, 0xcd, 0x71, 0x8f, 0xb0, 0x31, 0x22, 0x4a, 0xa5, 0x5d, 0x3a,
+	0xa8, 0xeb, 0xf1, 0x12, 0xb5, 0xa1, 0x89, 0x29, 0x7d, 0x85, 0xaf, 0xc9, 0xe4, 0x94, 0xcc, 0x94,
+	0xaa, 0x20, 0x94, 0x41, 0x9c, 0x16, 0x53, 0x7a, 0x8e, 0x6d, 0xa2, 0xac, 0x8a, 0xdd, 0x78, 0x89,
+	0x76, 0xa1, 0xe1, 0x60, 0x9b, 0x30, 0x8a, 0x0d, 0xa2, 0xd4, 0xc5, 0x5e, 0x0a, 0x40, 0x7f, 0x80,
+	0x2d, 0x49, 0xf1, 0x4b, 0x37, 0xf0, 0x0c, 0xa2, 0x80, 0x30, 0xfd, 0xf5, 0x72, 0xa6, 0xf7, 0xf3,
+	0x6c, 0xf5, 0x79, 0x49, 0xe8, 0x37, 0xb0, 0x2a, 0xce, 0x5e, 0x69, 0xb6, 0x2b, 0xb7, 0xea, 0xed,
+	0x90, 0x2d, 0x72, 0x60, 0x2d, 0x3c, 0x70, 0xa6, 0x3c, 0x10, 0x12, 0xae, 0x96, 0x93, 0x70, 0xe4,
+	0x3a, 0x43, 0xcb, 0x3c, 0xc3, 0x0e, 0x36, 0x89, 0x4d, 0x1c, 0xff, 0x42, 0x30, 0xd7, 0x63, 0x21,
+	0xe8, 0x4b, 0x68, 0x8d, 0x03, 0xe6, 0xbb, 0xb6, 0xf5, 0x25, 0x79, 0x4d, 0x39, 0x2d, 0x53, 0xd6,
+	0x85, 0x37, 0xcf, 0x97, 0x13, 0x7c, 0x9a, 0xe3, 0xaa, 0xcf, 0xc9, 0xe1, 0x41, 0x32, 0x0e, 0xae,
+	0xc9, 0xa7, 0xc4, 0x13, 0xd1, 0xb5, 0x11, 0x06, 0x89, 0x04, 0x0a, 0xc3, 0xc8, 0x8a, 0x56, 0x4c,
+	0xd9, 0x6c, 0x57, 0xc2, 0x30, 0x4a, 0x40, 0xe8, 0x00, 0x36, 0xa7, 0xc4, 0xb3, 0x86, 0xb3, 0x4b,
+	0xcb, 0x74, 0xb0, 0x1f, 0x78, 0x44, 0x69, 0x89, 0x50, 0xcc, 0x83, 0x91, 0x0d, 0xeb, 0x23, 0x32,
+	0xb1, 0xb9, 0xcb, 0x8f, 0x3c, 0x32, 0x60, 0xca, 0x96, 0xf0, 0xef, 0xf1, 0xf2, 0x27, 0x28, 0xd8,
+	0xe9, 0x59, 0xee, 0x5c, 0x31, 0xc7, 0xd5, 0xa3, 0x9b, 0x12, 0xde, 0x11, 0x14, 0x2a, 0x96, 0x03,
+	0xa3, 0x7d, 0xd8, 0xf0, 0x3d, 0x6c, 0x8c, 0x2d, 0xc7, 0x3c, 0x23, 0xfe, 0xc8, 0x1d, 0x28, 0x0f,
+	0x85, 0x27, 0x72, 0x50, 0x64, 0x00, 0x22, 0x0e, 0xbe, 0x9e, 0x90, 0x41, 0x18, 0x8b, 0x57, 0x33,
+	0x4a, 0x98, 0xf2, 0x48, 0x58, 0xf1, 0xac, 0x23, 0xe5, 0xa8, 0x5c, 0x82, 0xe8, 0xbc, 0x98, 0xa3,
+	0x7a, 0xe1, 0xf8, 0xde, 0x4c, 0x2f, 0x60, 0x87, 0xc6, 0xd0, 0xe4, 0x76, 0xc4, 0xa1, 0xf0, 0x58,
+	0x84, 0xc2, 0xcb, 0xe5, 0x7c, 0x74, 0x92, 0x32, 0xd4, 0x65, 0xee, 0xe8, 0x14, 0xc0, 0x23, 0xc3,
+	0x50, 0x3c, 0x53, 0xb6, 0x85, 0x25, 0x4f, 0x6f, 0xb2, 0x44, 0x4f, 0xb0, 0x43, 0x0b, 0x24, 0x72,
+	0xd4, 0x01, 0x34, 0xc2, 0xec, 0x2c, 0x98, 0xf8, 0x16, 0x9d, 0x90, 0x98, 0xe9, 0x8e, 0xf0, 0x79,
+	0xc1, 0x0e, 0xea, 0xc1, 0xa3, 0x30, 0x44, 0x8e, 0x46, 0xd8, 0xf3, 0xd3, 0xf0, 0x51, 0x04, 0x45,
+	0xe1, 0x9e, 0xfa, 0x02, 0x76, 0x16, 0x38, 0x13, 0xb5, 0xa0, 0x32, 0x26, 0x33, 0x91, 0x84, 0x1b,
+	0x3a, 0xff, 0x44, 0x8f, 0x60, 0x75, 0x8a, 0x27, 0x01, 0x11, 0x69, 0xb3, 0xae, 0x87, 0x8b, 0x0f,
+	0xcb, 0x3f, 0x2a, 0xa9, 0x7f, 0x2a, 0xc1, 0x66, 0xce, 0x94, 0x02, 0xfa, 0x5f, 0xcb, 0xf4, 0xb7,
+	0x10, 0xa8, 0xc3, 0x2b, 0xec, 0x99, 0xc4, 0x97, 0x14, 0xd1, 0x02, 0x78, 0x7c, 0x25, 0xdc, 0x9b,
+	0xa4, 0xa1, 0xfb, 0xa8, 0x29, 0xda, 0x09, 0x6c, 0xe7, 0xc5, 0x32, 0xea, 0x3a, 0x8c, 0xf0, 0x43,
+	0x14, 0x8e, 0xb7, 0xc8, 0x20, 0xdd, 0x15, 0x5a, 0xd4, 0xf5, 0x82, 0x1d, 0xed, 0x2f, 0x65, 0xd8,
+	0xd6, 0x09, 0x73, 0x27, 0x53, 0x12, 0x5f, 0xaa, 0xfb, 0x29, 0x8b, 0xbf, 0x82, 0x0a, 0xa6, 0x54,
+	0x29, 0xdf, 0xc6, 0xfd, 0x90, 0x0a, 0x8f, 0xce, 0xb9, 0xa2, 0xef, 0xc3, 0x16, 0xb6, 0xaf, 0x2d,
+	0x33, 0x70, 0x03, 0x16, 0x9b, 0x25, 0x2a, 0x6c, 0x43, 0x9f, 0xdf, 0xe0, 0x49, 0x92, 0x89, 0x48,
+	0x7a, 0xe9, 0x0c, 0xc8, 0xef, 0x45, 0xad, 0xad, 0xe8, 0x32, 0x48, 0x33, 0x60, 0x67, 0xce, 0x49,
+	0x91, 0xc3, 0xe5, 0xf2, 0x5e, 0xca, 0x95, 0xf7, 0x42, 0x35, 0xca, 0x0b, 0xd4, 0xd0, 0xbe, 0x2a,
+	0x43, 0x2b, 0xbd, 0xaf, 0x11, 0xfb, 0x5d, 0x68, 0xd8, 0x11, 0x8c, 0x29, 0x25, 0x91, 0xbe, 0x53,
+	0x40, 0xb6, 0xd2, 0x97, 0xf3, 0x95, 0x7e, 0x1b, 0x6a, 0x61, 0x27, 0x14, 0x99, 0x1e, 0xad, 0x32,
+	0x2a, 0x57, 0x73, 0x2a, 0xef, 0x01, 0xb0, 0xe4, 0x66, 0x2a, 0x35, 0xb1, 0x2b, 0x41, 0x90, 0x06,
+	0x0f, 0xc2, 0x8b, 0xad, 0x13, 0x16, 0x4c, 0x7c, 0x65, 0x4d, 0x60, 0x64, 0x60, 0xe8, 0x1c, 0x36,
+	0x8c, 0x6c, 0x4a, 0xa8, 0x8b, 0x53, 0xde, 0x97, 0x33, 0x53, 0x36, 0x31, 0x7c, 0x2a, 0x22, 0x33,
+	0x3a, 0xc2, 0x1c, 0xb5, 0xf6, 0xe7, 0x12, 0xa8, 0x8b, 0xd1, 0x11, 0x82, 0xaa, 0xcf, 0x95, 0x0d,
+	0xbd, 0x2f, 0xbe, 0x85, 0xe9, 0x96, 0xe9, 0x90, 0x41, 0x94, 0x3b, 0xa2, 0xd5, 0x9c, 0xfa, 0x95,
+	0x02, 0xf5, 0x15, 0x58, 0xb3, 0x09, 0x63, 0xd8, 0x4c, 0x1a, 0xab, 0x68, 0xc9, 0xb9, 0x8e, 0xc9,
+	0xec, 0xe5, 0x73, 0xa6, 0xd4, 0xc4, 0x49, 0x44, 0x2b, 0xcd, 0x85, 0xcd, 0x57, 0x16, 0x3f, 0xb4,
+	0x21, 0xbb, 0x9f, 0xfb, 0xff, 0x03, 0xa8, 0x72, 0x61, 0xfc, 0x24, 0xaf, 0x3d, 0xec, 0x18, 0x23,
+	0x12, 0x07, 0x47, 0xb2, 0x16, 0x6e, 0xc1, 0x26, 0x53, 0xca, 0x02, 0x2e, 0xbe, 0xb5, 0xbf, 0x96,
+	0x43, 0x4d, 0xfb, 0x94, 0xb2, 0x77, 0xdf, 0xfd, 0x16, 0xd7, 0xe3, 0xca, 0x7c, 0x3d, 0xce, 0xa9,
+	0xfc, 0x4d, 0xea, 0xf1, 0x2d, 0x55, 0x1c, 0x2d, 0x80, 0xb5, 0x3e, 0xa5, 0x5c, 0x11, 0x74, 0x08,
+	0x55, 0x4c, 0x69, 0xe8, 0xf0, 0x66, 0xef, 0x89, 0xac, 0x68, 0x84, 0xc2, 0xff, 0x47, 0x2a, 0x09,
+	0x54, 0xf5, 0x87, 0xd0, 0x48, 0x40, 0x5f, 0x27, 0xb6, 0x21, 0x8b, 0xfd, 0x6f, 0x0d, 0xde, 0xe3,
+	0x3e, 0xbd, 0x14, 0x37, 0xb7, 0x4f, 0xe9, 0x73, 0xe2, 0x63, 0x6b, 0xc2, 0x7e, 0x16, 0x10, 0x6f,
+	0x76, 0xc7, 0x47, 0x67, 0x42, 0x2d, 0xbc, 0xf8, 0x4a, 0xf9, 0x6e, 0xa6, 0x83, 0x1a, 0xcb, 0x8d,
+	0x04, 0x95, 0xbb, 0x19, 0x09, 0x8a, 0x5a, 0xf4, 0xea, 0x3d, 0xb5, 0xe8, 0x8b, 0xa7, 0x34, 0x69,
+	0xf6, 0xab, 0x65, 0x67, 0xbf, 0x82, 0xce, 0x77, 0xed, 0x6d, 0x3b, 0xdf, 0x7a, 0x61, 0xe7, 0x6b,
+	0x17, 0xde, 0xb4, 0x86, 0x70, 0xf7, 0x4f, 0xe4, 0x00, 0x5e, 0x18, 0x6b, 0xcb, 0xf4, 0xc0, 0x70,
+	0x97, 0x3d, 0xf0, 0x6d, 0x5d, 0xf0, 0x3f, 0x8a, 0x46, 0x88, 0xba, 0xa9, 0xdd, 0x49, 0x0d, 0x2e,
+	0x2a, 0x30, 0x4f, 0xa1, 0xca, 0x95, 0x10, 0x05, 0xa4, 0xd9, 0xdb, 0x91, 0x7d, 0xc8, 0x35, 0xed,
+	0x53, 0x7a, 0x49, 0x89, 0xa1, 0x0b, 0x24, 0xf4, 0x21, 0x34, 0x92, 0xc0, 0x88, 0x22, 0x6f, 0x57,
+	0xa6, 0x48, 0xe2, 0x28, 0x26, 0x4b, 0xd1, 0x39, 0xed, 0xc0, 0xf2, 0x88, 0xc1, 0x11, 0x95, 0xd5,
+	0x79, 0xda, 0xe7, 0xf1, 0x66, 0x42, 0x9b, 0xa0, 0xa3, 0x43, 0xa8, 0x85, 0x63, 0xaa, 0x88, 0xb0,
+	0x66, 0xef, 0x3d, 0x99, 0x30, 0x1c, 0x64, 0x63, 0xaa, 0x08, 0x51, 0xfb, 0x47, 0x09, 0xde, 0x4f,
+	0x83, 0x20, 0x8e, 0xb6, 0x33, 0xe2, 0xe3, 0x01, 0xf6, 0xf1, 0xbb, 0xaf, 0x19, 0xfb, 0xbc, 0xb7,
+	0x20, 0xc6, 0x38, 0xed, 0x2d, 0xc2, 0x87, 0x93, 0x1c, 0x54, 0xfb, 0x5b, 0x19, 0x9a, 0xd2, 0x41,
+	0xf0, 0x33, 0xe4, 0x8d, 0x51, 0x7c, 0x86, 0xfc, 0x9b, 0xf7, 0x3a, 0xe2, 0xfc, 0x3f, 0xb1, 0x26,
+	0x51, 0xdd, 0x69, 0xe8, 0x12, 0x04, 0x8d, 0x01, 0x28, 0xf6, 0xb0, 0x4d, 0x7c, 0xe2, 0xf1, 0x8c,
+	0xc1, 0x6f, 0xcb, 0xe9, 0xf2, 0x51, 0x7c, 0x11, 0xf3, 0xd4, 0x25, 0xf6, 0xbc, 0xb7, 0x10, 0xa2,
+	0x59, 0x94, 0x27, 0xa2, 0x15, 0xfa, 0x1d, 0x6c, 0x0c, 0xad, 0x09, 0xb9, 0x48, 0x15, 0xa9, 0xb5,
+	0x2b, 0xcb, 0x67, 0x63, 0xae, 0xc8, 0x27, 0x32, 0x5f, 0x3d, 0x27, 0x46, 0xfb, 0x1e, 0xb4, 0xf2,
+	0x71, 0xc9, 0x95, 0xb4, 0x6c, 0x6c, 0x26, 0xde, 0x8a, 0x56, 0x1a, 0x82, 0x56, 0x3e, 0x0e, 0xb5,
+	0x21, 0xac, 0x67, 0x42, 0x0c, 0xfd, 0x1c, 0xb6, 0x53, 0x7b, 0xfb, 0x8e, 0xe3, 0x06, 0x8e, 0x21,
+	0x9e, 0x54, 0x92, 0x4a, 0x1a, 0xbd, 0xd4, 0x25, 0x4a, 0xc8, 0x48, 0xfa, 0x02, 0x62, 0xed, 0x0b,
+	0xd8, 0xe2, 0xc6, 0x88, 0x06, 0xf1, 0x9e, 0xda, 0xaf, 0x8f, 0xa0, 0x91, 0x88, 0x2c, 0x8c, 0x2c,
+	0x15, 0xea, 0xd3, 0xf8, 0xcd, 0x25, 0xec, 0xbf, 0x92, 0xb5, 0xd6, 0x07, 0x24, 0xeb, 0x1b, 0xe5,
+	0x98, 0xa7, 0xb0, 0x6a, 0xf9, 0xc4, 0x8e, 0xbb, 0x8a, 0xc7, 0xf9, 0x84, 0x22, 0xd0, 0xf5, 0x10,
+	0x47, 0xfb, 0x5f, 0x19, 0x76, 0x4e, 0x66, 0x03, 0x0f, 0xfb, 0x24, 0x1e, 0x18, 0xee, 0xa9, 0x9d,
+	0x93, 0xca, 0x59, 0x39, 0x5b, 0xce, 0x6c, 0x68, 0x0c, 0xbc, 0x59, 0xf4, 0x9c, 0x58, 0xb9, 0x9b,
+	0x86, 0x21, 0x95, 0xc0, 0x23, 0x91, 0x2f, 0x4e, 0xfa, 0xd1, 0x04, 0x13, 0xad, 0x78, 0x83, 0xef,
+	0x8b, 0x29, 0xfd, 0x63, 0xd1, 0x07, 0x47, 0x97, 0x29, 0x03, 0xe3, 0x27, 0x46, 0xb1, 0x3f, 0x8a,
+	0xa6, 0x1b, 0xf1, 0x9d, 0x9d, 0xb3, 0xd6, 0x72, 0x73, 0x96, 0xf6, 0x63, 0x50, 0xe6, 0xfd, 0x1d,
+	0x9d, 0x5c, 0x1b, 0x9a, 0xa3, 0x70, 0x6f, 0xc0, 0xd5, 0x09, 0xc3, 0x40, 0x06, 0xf5, 0xfe, 0x5e,
+	0x83, 0xad, 0x34, 0xa7, 0xf2, 0xbf, 0x96, 0x41, 0xd0, 0x6b, 0x68, 0x1d, 0x47, 0x6f, 0xd7, 0x31,
+	0x53, 0xf4, 0xad, 0x1b, 0xde, 0x6e, 0xd4, 0xdd, 0xe2, 0xcd, 0x50, 0x0d, 0x6d, 0x05, 0xfd, 0x02,
+	0x36, 0xb2, 0x8f, 0x02, 0xe8, 0x7d, 0x99, 0xa2, 0xf0, 0x9d, 0x42, 0xd5, 0x6e, 0x42, 0x49, 0x58,
+	0x7f, 0x06, 0x9b, 0xb9, 0xf9, 0x17, 0x69, 0xd9, 0xb6, 0xa1, 0xe8, 0x05, 0x41, 0xfd, 0xf6, 0x8d,
+	0x38, 0x09, 0xf7, 0x8f, 0xa0, 0x1e, 0x8f, 0x4f, 0x59, 0x0f, 0xe4, 0x86, 0x2a, 0xb5, 0x95, 0xe5,
+	0x37, 0x64, 0xda, 0x0a, 0xfa, 0x69, 0x48, 0xcc, 0xdb, 0xeb, 0x79, 0x62, 0x69, 0x68, 0x50, 0x1f,
+	0x16, 0x34, 0xea, 0xc2, 0xb4, 0xf5, 0x63, 0xe2, 0xa7, 0x55, 0x1f, 0x7d, 0xf7, 0xad, 0xfa, 0x21,
+	0x55, 0xcb, 0xa3, 0xcd, 0x37, 0x0e, 0xda, 0x0a, 0xfa, 0xaa, 0x04, 0x0f, 0x8f, 0x89, 0x9f, 0xaf,
+	0xa3, 0xe8, 0x83, 0x62, 0x21, 0x0b, 0xea, 0xad, 0x7a, 0xbe, 0xec, 0x35, 0xce, 0xb2, 0xd5, 0x56,
+	0xd0, 0x85, 0x30, 0x3b, 0x4d, 0x44, 0xe8, 0x49, 0x61, 0xc6, 0x49, 0xbc, 0xb7, 0xb7, 0x68, 0x3b,
+	0x31, 0xf5, 0x73, 0x68, 0xe5, 0xef, 0x08, 0xca, 0x04, 0xc0, 0x82, 0x8c, 0xa5, 0x7e, 0xe7, 0x66,
+	0xa4, 0x58, 0xc0, 0xc7, 0xfd, 0x7f, 0xbe, 0xd9, 0x2b, 0xfd, 0xfb, 0xcd, 0x5e, 0xe9, 0x3f, 0x6f,
+	0xf6, 0x4a, 0xbf, 0x7c, 0xf6, 0x35, 0xbf, 0x1a, 0x49, 0x3f, 0x44, 0x61, 0x6a, 0x19, 0x13, 0x8b,
+	0x38, 0xfe, 0x75, 0x4d, 0xfc, 0x08, 0xf4, 0xec, 0xff, 0x03, 0x00, 0xef, 0x3e, 0x77, 0x8d, 0xa7,
+	0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1466,6 +1829,8 @@ type RepoServerServiceClient interface {
 	GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
 	GetHelmCharts(ctx context.Context, in *HelmChartsRequest, opts ...grpc.CallOption) (*HelmChartsResponse, error)
+	// HydrateManifests commits the rendered manifests of an application to a branch of its repository
+	HydrateManifests(ctx context.Context, in *HydrateManifestsRequest, opts ...grpc.CallOption) (*HydrateManifestsResponse, error)
 }
 
 type repoServerServiceClient struct {
@@ -1548,6 +1913,15 @@ func (c *repoServerServiceClient) GetHelmCharts(ctx context.Context, in *HelmCha
 	return out, nil
 }
 
+func (c *repoServerServiceClient) HydrateManifests(ctx context.Context, in *HydrateManifestsRequest, opts ...grpc.CallOption) (*HydrateManifestsResponse, error) {
+	out := new(HydrateManifestsResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/HydrateManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepoServerServiceServer is the server API for RepoServerService service.
 type RepoServerServiceServer interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
@@ -1566,6 +1940,8 @@ type RepoServerServiceServer interface {
 	GetRevisionMetadata(context.Context, *RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
 	GetHelmCharts(context.Context, *HelmChartsRequest) (*HelmChartsResponse, error)
+	// HydrateManifests commits the rendered manifests of an application to a branch of its repository
+	HydrateManifests(context.Context, *HydrateManifestsRequest) (*HydrateManifestsResponse, error)
 }
 
 // UnimplementedRepoServerServiceServer can be embedded to have forward compatible implementations.
@@ -1596,6 +1972,9 @@ func (*UnimplementedRepoServerServiceServer) GetRevisionMetadata(ctx context.Con
 func (*UnimplementedRepoServerServiceServer) GetHelmCharts(ctx context.Context, req *HelmChartsRequest) (*HelmChartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHelmCharts not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) HydrateManifests(ctx context.Context, req *HydrateManifestsRequest) (*HydrateManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HydrateManifests not implemented")
+}
 
 func RegisterRepoServerServiceServer(s *grpc.Server, srv RepoServerServiceServer) {
 	s.RegisterService(&_RepoServerService_serviceDesc, srv)
@@ -1745,6 +2124,24 @@ func _RepoServerService_GetHelmCharts_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_HydrateManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HydrateManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).HydrateManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/HydrateManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).HydrateManifests(ctx, req.(*HydrateManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "repository.RepoServerService",
 	HandlerType: (*RepoServerServiceServer)(nil),
@@ -1781,7 +2178,11 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHelmCharts",
 			Handler:    _RepoServerService_GetHelmCharts_Handler,
 		},
-	},
+		{
+			MethodName: "HydrateManifests",
+			Handler:    _RepoServerService_HydrateManifests_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reposerver/repository/repository.proto",
 }
@@ -1810,6 +2211,58 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.VerifyChartSignature {
+		i--
+		if m.VerifyChartSignature {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.HasMultipleSources {
+		i--
+		if m.HasMultipleSources {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.RefSources) > 0 {
+		for k := range m.RefSources {
+			v := m.RefSources[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if m.HelmOptions != nil {
 		{
 			size, err := m.HelmOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -2118,6 +2571,11 @@ func (m *ResolveRevisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SourceIndex != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.SourceIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AmbiguousRevision) > 0 {
 		i -= len(m.AmbiguousRevision)
 		copy(dAtA[i:], m.AmbiguousRevision)
@@ -2217,6 +2675,18 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ChartSignature != nil {
+		{
+			size, err := m.ChartSignature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.VerifyResult) > 0 {
 		i -= len(m.VerifyResult)
 		copy(dAtA[i:], m.VerifyResult)
@@ -2264,6 +2734,73 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChartSignatureVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChartSignatureVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartSignatureVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.KeyIDs) > 0 {
+		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyIDs[iNdEx])
+			copy(dAtA[i:], m.KeyIDs[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VerifyResult) > 0 {
+		i -= len(m.VerifyResult)
+		copy(dAtA[i:], m.VerifyResult)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.VerifyResult)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Signed {
+		i--
+		if m.Signed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListRefsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2631,6 +3168,18 @@ func (m *RepoAppDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Directory != nil {
 		{
 			size, err := m.Directory.MarshalToSizedBuffer(dAtA[:i])
@@ -2874,6 +3423,47 @@ func (m *DirectoryAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PluginAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ParametersAnnouncement) > 0 {
+		for iNdEx := len(m.ParametersAnnouncement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParametersAnnouncement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HelmChartsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2997,6 +3587,128 @@ func (m *HelmChartsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydrateManifestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydrateManifestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydrateManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Manifests) > 0 {
+		for iNdEx := len(m.Manifests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Manifests[iNdEx])
+			copy(dAtA[i:], m.Manifests[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Manifests[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetBranch) > 0 {
+		i -= len(m.TargetBranch)
+		copy(dAtA[i:], m.TargetBranch)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TargetBranch)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DrySHA) > 0 {
+		i -= len(m.DrySHA)
+		copy(dAtA[i:], m.DrySHA)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.DrySHA)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DrySource != nil {
+		{
+			size, err := m.DrySource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppName) > 0 {
+		i -= len(m.AppName)
+		copy(dAtA[i:], m.AppName)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HydrateManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydrateManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydrateManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HydratedSHA) > 0 {
+		i -= len(m.HydratedSHA)
+		copy(dAtA[i:], m.HydratedSHA)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.HydratedSHA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRepository(v)
 	base := offset
@@ -3095,6 +3807,25 @@ func (m *ManifestRequest) Size() (n int) {
 		l = m.HelmOptions.Size()
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if len(m.RefSources) > 0 {
+		for k, v := range m.RefSources {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRepository(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if m.HasMultipleSources {
+		n += 3
+	}
+	if m.VerifyChartSignature {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3150,6 +3881,9 @@ func (m *ResolveRevisionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.SourceIndex != 0 {
+		n += 1 + sovRepository(uint64(m.SourceIndex))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3208,6 +3942,43 @@ func (m *ManifestResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.ChartSignature != nil {
+		l = m.ChartSignature.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChartSignatureVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Signed {
+		n += 2
+	}
+	l = len(m.VerifyResult)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.KeyIDs) > 0 {
+		for _, s := range m.KeyIDs {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3380,6 +4151,10 @@ func (m *RepoAppDetailsResponse) Size() (n int) {
 		l = m.Directory.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3477,6 +4252,24 @@ func (m *DirectoryAppSpec) Size() (n int) {
 	return n
 }
 
+func (m *PluginAppSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParametersAnnouncement) > 0 {
+		for _, e := range m.ParametersAnnouncement {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *HelmChartsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3533,6 +4326,64 @@ func (m *HelmChartsResponse) Size() (n int) {
 	return n
 }
 
+func (m *HydrateManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AppName)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.DrySource != nil {
+		l = m.DrySource.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.DrySHA)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HydrateManifestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HydratedSHA)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4213,6 +5064,175 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RefSources == nil {
+				m.RefSources = make(map[string]*v1alpha1.RefTarget)
+			}
+			var mapkey string
+			var mapvalue *v1alpha1.RefTarget
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1alpha1.RefTarget{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RefSources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMultipleSources", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMultipleSources = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyChartSignature", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyChartSignature = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4526,17 +5546,36 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			m.SourceIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -4884,6 +5923,241 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.VerifyResult = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChartSignature == nil {
+				m.ChartSignature = &ChartSignatureVerification{}
+			}
+			if err := m.ChartSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartSignatureVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartSignatureVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartSignatureVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Signed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyIDs = append(m.KeyIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -6137,14 +7411,50 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginAppSpec{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -6647,6 +7957,91 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginAppSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParametersAnnouncement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParametersAnnouncement = append(m.ParametersAnnouncement, &apiclient.ParameterAnnouncement{})
+			if err := m.ParametersAnnouncement[len(m.ParametersAnnouncement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6934,6 +8329,372 @@ func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HydrateManifestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydrateManifestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydrateManifestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DrySource == nil {
+				m.DrySource = &v1alpha1.ApplicationSource{}
+			}
+			if err := m.DrySource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HydrateManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydrateManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydrateManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRepository(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -257,6 +257,29 @@ func (c *Cache) SetRevisionMetadata(repoURL, revision string, item *appv1.Revisi
 	return c.cache.SetItem(revisionMetadataKey(repoURL, revision), item, c.repoCacheExpiration, false)
 }
 
+func hydratedRevisionKey(repoURL, targetBranch, path, drySHA string, manifests []string) string {
+	// the manifests rendered from the same dry revision change with the parameters of the application
+	h := fnv.New64a()
+	for _, manifest := range manifests {
+		_, _ = h.Write([]byte(manifest))
+		_, _ = h.Write([]byte{0})
+	}
+	return fmt.Sprintf("hydrated|%s|%s|%s|%s|%s", repoURL, targetBranch, path, drySHA, base64.URLEncoding.EncodeToString(h.Sum(nil)))
+}
+
+// GetHydratedRevision retrieves the revision of the target branch which the given manifests rendered from the given
+// dry revision have been committed to
+func (c *Cache) GetHydratedRevision(repoURL, targetBranch, path, drySHA string, manifests []string) (string, error) {
+	var hydratedSHA string
+	return hydratedSHA, c.cache.GetItem(hydratedRevisionKey(repoURL, targetBranch, path, drySHA, manifests), &hydratedSHA)
+}
+
+// SetHydratedRevision saves the revision of the target branch which the given manifests rendered from the given dry
+// revision have been committed to
+func (c *Cache) SetHydratedRevision(repoURL, targetBranch, path, drySHA string, manifests []string, hydratedSHA string) error {
+	return c.cache.SetItem(hydratedRevisionKey(repoURL, targetBranch, path, drySHA, manifests), hydratedSHA, c.repoCacheExpiration, false)
+}
+
 func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 	if cmr == nil {
 		return nil
@@ -48,6 +48,27 @@ func TestCache_GetRevisionMetadata(t *testing.T) {
 	assert.Equal(t, &RevisionMetadata{Message: "my-message"}, value)
 }
 
+func TestCache_GetHydratedRevision(t *testing.T) {
+	cache := newFixtures().Cache
+	manifests := []string{`{"kind":"ConfigMap"}`}
+	// cache miss
+	_, err := cache.GetHydratedRevision("my-repo-url", "hydrated", "guestbook", "dry-sha", manifests)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetHydratedRevision("my-repo-url", "hydrated", "guestbook", "dry-sha", manifests, "hydrated-sha")
+	assert.NoError(t, err)
+	// cache miss
+	_, err = cache.GetHydratedRevision("my-repo-url", "hydrated", "guestbook", "other-dry-sha", manifests)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache miss
+	_, err = cache.GetHydratedRevision("my-repo-url", "hydrated", "guestbook", "dry-sha", []string{`{"kind":"Secret"}`})
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	value, err := cache.GetHydratedRevision("my-repo-url", "hydrated", "guestbook", "dry-sha", manifests)
+	assert.NoError(t, err)
+	assert.Equal(t, "hydrated-sha", value)
+}
+
 func TestCache_ListApps(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
@@ -15,6 +15,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -62,6 +63,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/io"
 	pathutil "github.com/argoproj/argo-cd/v2/util/io/path"
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/text"
 )
 
@@ -72,6 +74,8 @@ const (
 	allowConcurrencyFile           = ".argocd-allow-concurrency"
 	repoSourceFile                 = ".argocd-source.yaml"
 	appSourceFile                  = ".argocd-source-%s.yaml"
+	hydratedManifestFile           = "manifest.yaml"
+	helmPostRendererInputFile      = "helm-output.yaml"
 	ociPrefix                      = "oci://"
 )
 
@@ -83,14 +87,17 @@ type Service struct {
 	rootDir                   string
 	gitRepoPaths              *io.TempPaths
 	chartPaths                *io.TempPaths
+	ociBlobCache              *oci.BlobCache
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
+	hydrationLock             sync.KeyLock
 	cache                     *reposervercache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
 	metricsServer             *metrics.MetricsServer
 	resourceTracking          argo.ResourceTracking
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client
+	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, opts ...oci.ClientOpts) (oci.Client, error)
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
@@ -104,6 +111,9 @@ type RepoServerInitConstants struct {
 	SubmoduleEnabled                             bool
 	MaxCombinedDirectoryManifestsSize            resource.Quantity
 	CMPTarExcludedGlobs                          []string
+	OCILayerMediaTypes                           []string
+	OCIMaxExtractedSize                          resource.Quantity
+	OCIBlobCacheSize                             resource.Quantity
 }
 
 // NewService returns a new instance of the Manifest service
@@ -116,6 +126,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *reposervercache.Cac
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
+		hydrationLock:             sync.NewKeyLock(),
 		cache:                     cache,
 		metricsServer:             metricsServer,
 		newGitClient:              git.NewClientExt,
@@ -123,11 +134,15 @@ func NewService(metricsServer *metrics.MetricsServer, cache *reposervercache.Cac
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client {
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, opts...)
 		},
+		newOCIClient: func(repoURL string, creds oci.Creds, proxy string, opts ...oci.ClientOpts) (oci.Client, error) {
+			return oci.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), proxy, opts...)
+		},
 		initConstants:      initConstants,
 		now:                time.Now,
 		gitCredsStore:      gitCredsStore,
 		gitRepoPaths:       io.NewTempPaths(rootDir),
 		chartPaths:         io.NewTempPaths(rootDir),
+		ociBlobCache:       oci.NewBlobCache(rootDir, initConstants.OCIBlobCacheSize.Value()),
 		gitRepoInitializer: directoryPermissionInitializer,
 		rootDir:            rootDir,
 	}
@@ -231,6 +246,10 @@ type operationSettings struct {
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// verifyChartSignature requests the signature of Helm charts to be verified
+	verifyChartSignature bool
+	// refSources are checked out together with the repository of the source
+	refSources []referencedSource
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -242,6 +261,9 @@ type operationContext struct {
 
 	// output of 'git verify-(tag/commit)', if signature verification is enabled (otherwise "")
 	verificationResult string
+
+	// result of the helm chart signature verification, if it is enabled (otherwise nil)
+	chartSignature *apiclient.ChartSignatureVerification
 }
 
 // The 'operation' function parameter of 'runRepoOperation' may call this function to retrieve
@@ -271,6 +293,7 @@ func (s *Service) runRepoOperation(
 
 	var gitClient git.Client
 	var helmClient helm.Client
+	var ociClient oci.Client
 	var err error
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	if source.IsHelm() {
@@ -278,6 +301,11 @@ func (s *Service) runRepoOperation(
 		if err != nil {
 			return err
 		}
+	} else if source.IsOCI() {
+		ociClient, revision, err = s.newOCIClientResolveRevision(ctx, repo, revision)
+		if err != nil {
+			return err
+		}
 	} else {
 		gitClient, revision, err = s.newClientResolveRevision(repo, revision, git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache))
 		if err != nil {
@@ -318,14 +346,49 @@ func (s *Service) runRepoOperation(
 			return err
 		}
 		defer io.Close(closer)
+		refCloser, err := s.checkoutRepositories(nil, "", false, settings.refSources)
+		if err != nil {
+			return err
+		}
+		defer io.Close(refCloser)
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{chartPath, ""}, nil
+			var chartSignature *apiclient.ChartSignatureVerification
+			if settings.verifyChartSignature {
+				verification, err := helmClient.VerifyChartSignature(source.Chart, revision, helmPassCredentials)
+				if err != nil {
+					return nil, fmt.Errorf("error verifying signature of chart %s version %s: %w", source.Chart, revision, err)
+				}
+				chartSignature = &apiclient.ChartSignatureVerification{
+					Type:         verification.Type,
+					Signed:       verification.Signed,
+					VerifyResult: verification.VerifyResult,
+					KeyIDs:       verification.KeyIDs,
+					Message:      verification.Message,
+				}
+			}
+			return &operationContext{chartPath, "", chartSignature}, nil
 		})
-	} else {
-		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
-			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+	} else if source.IsOCI() {
+		// the artifact is extracted into a new directory on each invocation, while its layers are cached by digest
+		artifactPath, closer, err := ociClient.Extract(ctx, revision)
+		if err != nil {
+			return err
+		}
+		defer io.Close(closer)
+		refCloser, err := s.checkoutRepositories(nil, "", false, settings.refSources)
+		if err != nil {
+			return err
+		}
+		defer io.Close(refCloser)
+		return operation(artifactPath, revision, revision, func() (*operationContext, error) {
+			appPath, err := argopath.Path(artifactPath, source.Path)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{appPath, "", nil}, nil
 		})
-
+	} else {
+		closer, err := s.checkoutRepositories(gitClient, revision, settings.allowConcurrent, settings.refSources)
 		if err != nil {
 			return err
 		}
@@ -357,7 +420,7 @@ func (s *Service) runRepoOperation(
 			if err != nil {
 				return nil, err
 			}
-			return &operationContext{appPath, signature}, nil
+			return &operationContext{appPath, signature, nil}, nil
 		})
 	}
 }
@@ -366,8 +429,16 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	var res *apiclient.ManifestResponse
 	var err error
 
+	// Sources referenced by Helm value files are resolved upfront, so that a change of their revision is reflected
+	// in the cache key of the generated manifests.
+	refSources, err := s.resolveReferencedSources(q)
+	if err != nil {
+		return nil, err
+	}
+	refSourcesCacheKey := referencedSourcesCacheKey(refSources)
+
 	cacheFn := func(cacheKey string, firstInvocation bool) (bool, error) {
-		ok, resp, err := s.getManifestCacheEntry(cacheKey, q, firstInvocation)
+		ok, resp, err := s.getManifestCacheEntry(cacheKey+refSourcesCacheKey, q, firstInvocation)
 		res = resp
 		return ok, err
 	}
@@ -376,7 +447,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	var promise *ManifestResponsePromise
 
 	operation := func(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) error {
-		promise = s.runManifestGen(ctx, repoRoot, commitSHA, cacheKey, ctxSrc, q)
+		promise = s.runManifestGen(ctx, repoRoot, commitSHA, cacheKey+refSourcesCacheKey, ctxSrc, q, referencedSourcePaths(refSources))
 		// The fist channel to send the message will resume this operation.
 		// The main purpose for using channels here is to be able to unlock
 		// the repository as soon as the lock in not required anymore. In
@@ -393,8 +464,10 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
-	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings)
+	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing(), verifyChartSignature: q.VerifyChartSignature, refSources: refSources}
+	// sources which are only referenced by other sources produce no manifests, so their signature is not verified
+	verifySignature := q.VerifySignature && !(q.HasMultipleSources && q.ApplicationSource.IsRef())
+	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, verifySignature, cacheFn, operation, settings)
 
 	// if the tarDoneCh message is sent it means that the manifest
 	// generation is being managed by the cmp-server. In this case
@@ -412,6 +485,138 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	return res, err
 }
 
+// referencedSource is a Git source of an application which is referenced from the Helm value files of another
+// source of the same application
+type referencedSource struct {
+	ref       string
+	gitClient git.Client
+	commitSHA string
+}
+
+// resolveReferencedSources resolves the revisions of the sources referenced from the Helm value files of the
+// requested source. Value files reference another source with a path starting with "$" followed by the name of the ref.
+func (s *Service) resolveReferencedSources(q *apiclient.ManifestRequest) ([]referencedSource, error) {
+	if q.ApplicationSource == nil || q.ApplicationSource.Helm == nil {
+		return nil, nil
+	}
+	var refSources []referencedSource
+	seen := map[string]bool{}
+	for _, valueFile := range q.ApplicationSource.Helm.ValueFiles {
+		ref, _, isRef := splitReferencedValueFile(valueFile)
+		if !isRef || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		refTarget, ok := q.RefSources[ref]
+		if !ok || refTarget == nil {
+			return nil, fmt.Errorf("source referenced by value file %q not found", valueFile)
+		}
+		if refTarget.Chart != "" {
+			return nil, fmt.Errorf("source referenced by value file %q is a Helm chart, only Git sources can be referenced", valueFile)
+		}
+		gitClient, commitSHA, err := s.newClientResolveRevision(&refTarget.Repo, refTarget.TargetRevision, git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache))
+		if err != nil {
+			return nil, fmt.Errorf("error resolving revision of source referenced by value file %q: %v", valueFile, err)
+		}
+		refSources = append(refSources, referencedSource{ref: ref, gitClient: gitClient, commitSHA: commitSHA})
+	}
+	return refSources, nil
+}
+
+// referencedSourcesCacheKey returns the suffix which is appended to the manifest cache key when other sources are
+// referenced, so that cached manifests are invalidated when a referenced source changes.
+func referencedSourcesCacheKey(refSources []referencedSource) string {
+	if len(refSources) == 0 {
+		return ""
+	}
+	keys := make([]string, len(refSources))
+	for i, refSource := range refSources {
+		keys[i] = refSource.ref + "=" + refSource.commitSHA
+	}
+	sort.Strings(keys)
+	return "|" + strings.Join(keys, ",")
+}
+
+// referencedSourcePaths returns the paths of the checked out referenced sources keyed by ref
+func referencedSourcePaths(refSources []referencedSource) map[string]string {
+	paths := map[string]string{}
+	for _, refSource := range refSources {
+		paths[refSource.ref] = refSource.gitClient.Root()
+	}
+	return paths
+}
+
+// repositoryCheckout is a repository to be checked out at a revision by checkoutRepositories
+type repositoryCheckout struct {
+	gitClient       git.Client
+	revision        string
+	allowConcurrent bool
+	// ref is the ref of the referenced source the checkout belongs to, or empty for the repository of the source
+	ref string
+}
+
+// checkoutRepositories checks out the repository of gitClient at the given revision, unless gitClient is nil, together
+// with the referenced sources at their resolved revisions. The repositories are locked in the order of their root
+// directories, so that concurrent operations checking out each other's repositories cannot deadlock. The returned
+// closer releases the locks held on all repositories.
+func (s *Service) checkoutRepositories(gitClient git.Client, revision string, allowConcurrent bool, refSources []referencedSource) (goio.Closer, error) {
+	checkouts := map[string]repositoryCheckout{}
+	if gitClient != nil {
+		checkouts[gitClient.Root()] = repositoryCheckout{gitClient: gitClient, revision: revision, allowConcurrent: allowConcurrent}
+	}
+	for _, refSource := range refSources {
+		root := refSource.gitClient.Root()
+		if checkout, ok := checkouts[root]; ok {
+			if checkout.revision != refSource.commitSHA {
+				return nil, fmt.Errorf("cannot reference a different revision of the same repository (%s references %s while %s is checked out)", refSource.ref, refSource.commitSHA, checkout.revision)
+			}
+			continue
+		}
+		checkouts[root] = repositoryCheckout{gitClient: refSource.gitClient, revision: refSource.commitSHA, allowConcurrent: true, ref: refSource.ref}
+	}
+	roots := make([]string, 0, len(checkouts))
+	for root := range checkouts {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+
+	var closers []goio.Closer
+	closer := io.NewCloser(func() error {
+		for i := len(closers) - 1; i >= 0; i-- {
+			io.Close(closers[i])
+		}
+		return nil
+	})
+	for _, root := range roots {
+		checkout := checkouts[root]
+		c, err := s.repoLock.Lock(root, checkout.revision, checkout.allowConcurrent, func() (goio.Closer, error) {
+			return s.checkoutRevision(checkout.gitClient, checkout.revision, s.initConstants.SubmoduleEnabled)
+		})
+		if err != nil {
+			io.Close(closer)
+			if checkout.ref != "" {
+				return nil, fmt.Errorf("error checking out source %s: %v", checkout.ref, err)
+			}
+			return nil, err
+		}
+		closers = append(closers, c)
+	}
+	return closer, nil
+}
+
+// splitReferencedValueFile splits a value file referencing another source, e.g. "$values/path/to/values.yaml", into
+// the ref ("$values") and the path within the referenced source ("path/to/values.yaml")
+func splitReferencedValueFile(valueFile string) (string, string, bool) {
+	if !strings.HasPrefix(valueFile, "$") {
+		return "", "", false
+	}
+	parts := strings.SplitN(valueFile, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], "", true
+	}
+	return parts[0], parts[1], true
+}
+
 type ManifestResponsePromise struct {
 	responseCh <-chan *apiclient.ManifestResponse
 	tarDoneCh  <-chan bool
@@ -437,7 +642,7 @@ type generateManifestCh struct {
 // - or, the cache does contain a value for this key, but it is an expired manifest generation entry
 // - or, NoCache is true
 // Returns a ManifestResponse, or an error, but not both
-func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest) *ManifestResponsePromise {
+func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, refSourcePaths map[string]string) *ManifestResponsePromise {
 
 	responseCh := make(chan *apiclient.ManifestResponse)
 	tarDoneCh := make(chan bool)
@@ -449,11 +654,11 @@ func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, cache
 		tarDoneCh:  tarDoneCh,
 		errCh:      errCh,
 	}
-	go s.runManifestGenAsync(ctx, repoRoot, commitSHA, cacheKey, opContextSrc, q, channels)
+	go s.runManifestGenAsync(ctx, repoRoot, commitSHA, cacheKey, opContextSrc, q, refSourcePaths, channels)
 	return responsePromise
 }
 
-func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, ch *generateManifestCh) {
+func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, refSourcePaths map[string]string, ch *generateManifestCh) {
 	defer func() {
 		close(ch.errCh)
 		close(ch.responseCh)
@@ -466,7 +671,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	var manifestGenResult *apiclient.ManifestResponse
 	opContext, err := opContextSrc()
 	if err == nil {
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithRefSourcePaths(refSourcePaths))
 	}
 	if err != nil {
 		// If manifest generation error caching is enabled
@@ -518,6 +723,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
+	manifestGenResult.ChartSignature = opContext.chartSignature
 	err = s.cache.SetManifests(cacheKey, appSourceCopy, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
@@ -608,6 +814,13 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 			return false, res.ManifestResponse, nil
 		}
 
+		// Responses which have been cached without verifying the chart signature cannot be used once the verification
+		// is requested
+		if q.VerifyChartSignature && res.ManifestResponse != nil && res.ManifestResponse.ChartSignature == nil {
+			log.Infof("manifest cache hit without chart signature: %s/%s", q.ApplicationSource.String(), cacheKey)
+			return false, nil, nil
+		}
+
 		log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), cacheKey)
 		return true, res.ManifestResponse, nil
 	}
@@ -711,7 +924,7 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 	return ioutil.WriteFile(markerFile, []byte("marker"), 0644)
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool) ([]*unstructured.Unstructured, error) {
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, refSourcePaths map[string]string) ([]*unstructured.Unstructured, error) {
 	concurrencyAllowed := isConcurrencyAllowed(appPath)
 	if !concurrencyAllowed {
 		manifestGenerateLock.Lock(appPath)
@@ -741,8 +954,20 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 		for _, val := range appHelm.ValueFiles {
 
-			// This will resolve val to an absolute path (or an URL)
-			path, isRemote, err := pathutil.ResolveFilePath(appPath, repoRoot, val, q.GetValuesFileSchemes())
+			var path pathutil.ResolvedFilePath
+			var isRemote bool
+			var err error
+			if ref, refPath, isRef := splitReferencedValueFile(val); isRef {
+				// The value file is located in another source of the application
+				refRoot, ok := refSourcePaths[ref]
+				if !ok {
+					return nil, fmt.Errorf("source referenced by value file %q not found", val)
+				}
+				path, isRemote, err = pathutil.ResolveFilePath(refRoot, refRoot, refPath, nil)
+			} else {
+				// This will resolve val to an absolute path (or an URL)
+				path, isRemote, err = pathutil.ResolveFilePath(appPath, repoRoot, val, q.GetValuesFileSchemes())
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -861,6 +1086,99 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	return kube.SplitYAML([]byte(out))
 }
 
+// postRenderHelm patches the manifests rendered by Helm with the Kustomize overlay and the inline patches of the
+// given post renderer
+func postRenderHelm(appPath, repoRoot string, objs []*unstructured.Unstructured, postRenderer *v1alpha1.HelmPostRenderer, q *apiclient.ManifestRequest, env *v1alpha1.Env, gitCredsStore git.CredsStore) ([]*unstructured.Unstructured, error) {
+	if postRenderer.IsZero() {
+		return objs, nil
+	}
+	repoURL := ""
+	if q.Repo != nil {
+		repoURL = q.Repo.Repo
+	}
+	kustomizeBinary := ""
+	if q.KustomizeOptions != nil {
+		kustomizeBinary = q.KustomizeOptions.BinaryPath
+	}
+	build := func(dir string, objs []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+		manifests, err := marshalManifests(objs)
+		if err != nil {
+			return nil, err
+		}
+		input := filepath.Join(dir, helmPostRendererInputFile)
+		if err := os.WriteFile(input, manifests, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write rendered manifests: %w", err)
+		}
+		// the overlay is part of the shared checkout of the repository, which must not keep the rendered manifests
+		defer func() { _ = os.Remove(input) }()
+		k := kustomize.NewKustomizeApp(dir, q.Repo.GetGitCreds(gitCredsStore), repoURL, kustomizeBinary)
+		objs, _, err = k.Build(nil, q.KustomizeOptions, env)
+		return objs, err
+	}
+
+	if postRenderer.Path != "" {
+		rel, err := filepath.Rel(repoRoot, filepath.Join(appPath, postRenderer.Path))
+		if err != nil {
+			return nil, err
+		}
+		overlayPath, err := argopath.Path(repoRoot, rel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid post renderer path: %w", err)
+		}
+		// the rendered manifests are written into the overlay, which is shared by concurrent requests of the same revision
+		manifestGenerateLock.Lock(overlayPath)
+		objs, err = build(overlayPath, objs)
+		manifestGenerateLock.Unlock(overlayPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to post render with overlay %s: %w", postRenderer.Path, err)
+		}
+	}
+
+	if len(postRenderer.Patches) > 0 {
+		dir, err := os.MkdirTemp("", "helm-post-renderer")
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = os.RemoveAll(dir) }()
+		kustomization, err := postRendererKustomization(postRenderer.Patches)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "kustomization.yaml"), kustomization, 0644); err != nil {
+			return nil, err
+		}
+		objs, err = build(dir, objs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to post render with patches: %w", err)
+		}
+	}
+	return objs, nil
+}
+
+// postRendererKustomization returns a kustomization applying the given patches to the manifests rendered by Helm
+func postRendererKustomization(patches []v1alpha1.KustomizePatch) ([]byte, error) {
+	return yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  []string{helmPostRendererInputFile},
+		"patches":    patches,
+	})
+}
+
+// marshalManifests returns the given objects as a multi-document YAML stream
+func marshalManifests(objs []*unstructured.Unstructured) ([]byte, error) {
+	var out bytes.Buffer
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString("---\n")
+		out.Write(data)
+	}
+	return out.Bytes(), nil
+}
+
 func getRepoCredential(repoCredentials []*v1alpha1.RepoCreds, repoURL string) *v1alpha1.RepoCreds {
 	for _, cred := range repoCredentials {
 		url := strings.TrimPrefix(repoURL, ociPrefix)
@@ -875,6 +1193,7 @@ type GenerateManifestOpt func(*generateManifestOpt)
 type generateManifestOpt struct {
 	cmpTarDoneCh        chan<- bool
 	cmpTarExcludedGlobs []string
+	refSourcePaths      map[string]string
 }
 
 func newGenerateManifestOpt(opts ...GenerateManifestOpt) *generateManifestOpt {
@@ -902,12 +1221,26 @@ func WithCMPTarExcludedGlobs(excludedGlobs []string) GenerateManifestOpt {
 	}
 }
 
+// WithRefSourcePaths defines the paths of the checked out sources which can be
+// referenced from Helm value files, keyed by ref.
+func WithRefSourcePaths(refSourcePaths map[string]string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.refSourcePaths = refSourcePaths
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
 	var targetObjs []*unstructured.Unstructured
 	var dest *v1alpha1.ApplicationDestination
 
+	// Sources of an application with multiple sources which only serve as a reference for other
+	// sources do not contain any manifests.
+	if q.HasMultipleSources && q.ApplicationSource.IsRef() {
+		return &apiclient.ManifestResponse{Manifests: []string{}}, nil
+	}
+
 	resourceTracking := argo.NewResourceTracking()
 
 	appSourceType, err := GetAppSourceType(ctx, q.ApplicationSource, appPath, q.AppName, q.EnabledSourceTypes, opt.cmpTarExcludedGlobs)
@@ -922,7 +1255,10 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
-		targetObjs, err = helmTemplate(appPath, repoRoot, env, q, isLocal)
+		targetObjs, err = helmTemplate(appPath, repoRoot, env, q, isLocal, opt.refSourcePaths)
+		if err == nil && q.ApplicationSource.Helm != nil {
+			targetObjs, err = postRenderHelm(appPath, repoRoot, targetObjs, q.ApplicationSource.Helm.PostRenderer, q, env, gitCredsStore)
+		}
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		kustomizeBinary := ""
 		if q.KustomizeOptions != nil {
@@ -1470,6 +1806,11 @@ func getPluginEnvs(envVars *v1alpha1.Env, q *apiclient.ManifestRequest, creds gi
 	env = append(env, "KUBE_VERSION="+text.SemVer(q.KubeVersion))
 	env = append(env, "KUBE_API_VERSIONS="+strings.Join(q.ApiVersions, ","))
 
+	return getPluginParamEnvs(env, q.ApplicationSource.Plugin)
+}
+
+// getPluginParamEnvs appends the env entries and parameters of the given plugin source to the given environment
+func getPluginParamEnvs(env []string, plugin *v1alpha1.ApplicationSourcePlugin) ([]string, error) {
 	parsedEnv := make(v1alpha1.Env, len(env))
 	for i, v := range env {
 		parsedVar, err := v1alpha1.NewEnvEntry(v)
@@ -1479,12 +1820,17 @@ func getPluginEnvs(envVars *v1alpha1.Env, q *apiclient.ManifestRequest, creds gi
 		parsedEnv[i] = parsedVar
 	}
 
-	if q.ApplicationSource.Plugin != nil {
-		pluginEnv := q.ApplicationSource.Plugin.Env
+	if plugin != nil {
+		pluginEnv := plugin.Env
 		for _, entry := range pluginEnv {
 			newValue := parsedEnv.Envsubst(entry.Value)
 			env = append(env, fmt.Sprintf("ARGOCD_ENV_%s=%s", entry.Name, newValue))
 		}
+		paramEnv, err := plugin.Parameters.Environ()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse plugin parameters: %w", err)
+		}
+		env = append(env, paramEnv...)
 	}
 	return env, nil
 }
@@ -1564,6 +1910,10 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 			if err := populateKustomizeAppDetails(res, q, opContext.appPath, commitSHA, s.gitCredsStore); err != nil {
 				return err
 			}
+		case v1alpha1.ApplicationSourceTypePlugin:
+			if err := populatePluginAppDetails(ctx, res, opContext.appPath, repoRoot, revision, q, s.initConstants.CMPTarExcludedGlobs); err != nil {
+				return fmt.Errorf("failed to populate plugin app details: %w", err)
+			}
 		}
 		_ = s.cache.SetAppDetails(revision, q.Source, res, v1alpha1.TrackingMethod(q.TrackingMethod))
 		return nil
@@ -1673,6 +2023,48 @@ func loadFileIntoIfExists(path pathutil.ResolvedFilePath, destination *string) e
 	return nil
 }
 
+// populatePluginAppDetails sets the parameters announced by the config management plugin sidecar which handles the
+// application. Plugins configured in argocd-cm don't announce any parameters.
+func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetailsResponse, appPath, repoPath, revision string, q *apiclient.RepoServerAppDetailsQuery, tarExcludedGlobs []string) error {
+	res.Plugin = &apiclient.PluginAppSpec{}
+	if q.Source.Plugin != nil && q.Source.Plugin.Name != "" {
+		return nil
+	}
+
+	envVars := v1alpha1.Env{
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_NAME", Value: q.AppName},
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_REVISION", Value: revision},
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_SOURCE_REPO_URL", Value: q.Repo.Repo},
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_SOURCE_PATH", Value: q.Source.Path},
+		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_SOURCE_TARGET_REVISION", Value: q.Source.TargetRevision},
+	}
+	env, err := getPluginParamEnvs(envVars.Environ(), q.Source.Plugin)
+	if err != nil {
+		return err
+	}
+
+	conn, cmpClient, err := discovery.DetectConfigManagementPlugin(ctx, appPath, env, tarExcludedGlobs)
+	if err != nil {
+		return err
+	}
+	defer io.Close(conn)
+
+	parametersAnnouncementStream, err := cmpClient.GetParametersAnnouncement(ctx, grpc_retry.Disable())
+	if err != nil {
+		return fmt.Errorf("error getting parametersAnnouncementStream: %w", err)
+	}
+	err = cmp.SendRepoStream(parametersAnnouncementStream.Context(), appPath, repoPath, parametersAnnouncementStream, env, tarExcludedGlobs)
+	if err != nil {
+		return fmt.Errorf("error sending file to cmp-server: %w", err)
+	}
+	announcement, err := parametersAnnouncementStream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("failed to get parameter announcement: %w", err)
+	}
+	res.Plugin.ParametersAnnouncement = announcement.ParameterAnnouncements
+	return nil
+}
+
 func findHelmValueFilesInPath(path string) ([]string, error) {
 	var result []string
 
@@ -1718,6 +2110,9 @@ func populateKustomizeAppDetails(res *apiclient.RepoAppDetailsResponse, q *apicl
 }
 
 func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+	if oci.IsOCIRepo(q.Repo.Repo) {
+		return s.getOCIRevisionMetadata(ctx, q)
+	}
 	if !(git.IsCommitSHA(q.Revision) || git.IsTruncatedCommitSHA(q.Revision)) {
 		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
 	}
@@ -1794,6 +2189,44 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	return metadata, nil
 }
 
+// getOCIRevisionMetadata returns the metadata of the OCI artifact with the given digest, which is read from the
+// annotations of its manifest
+func (s *Service) getOCIRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+	metadata, err := s.cache.GetRevisionMetadata(q.Repo.Repo, q.Revision)
+	if err == nil {
+		log.Infof("revision metadata cache hit: %s/%s", q.Repo.Repo, q.Revision)
+		return metadata, nil
+	} else if err != reposervercache.ErrCacheMiss {
+		log.Warnf("revision metadata cache error %s/%s: %v", q.Repo.Repo, q.Revision, err)
+	} else {
+		log.Infof("revision metadata cache miss: %s/%s", q.Repo.Repo, q.Revision)
+	}
+
+	ociClient, err := s.newOCIClientForRepo(q.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
+	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+
+	manifest, err := ociClient.GetManifest(ctx, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	metadata = &v1alpha1.RevisionMetadata{Author: manifest.Authors(), Message: manifest.Description()}
+	if created := manifest.Created(); created != "" {
+		date, err := time.Parse(time.RFC3339, created)
+		if err != nil {
+			log.Warnf("invalid creation time of artifact %s/%s: %v", q.Repo.Repo, q.Revision, err)
+		} else {
+			metadata.Date = metav1.Time{Time: date}
+		}
+	}
+	_ = s.cache.SetRevisionMetadata(q.Repo.Repo, q.Revision, metadata)
+	return metadata, nil
+}
+
 func fileParameters(q *apiclient.RepoServerAppDetailsQuery) []v1alpha1.HelmFileParameter {
 	if q.Source.Helm == nil {
 		return nil
@@ -1850,6 +2283,32 @@ func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revisi
 	return helmClient, version.String(), nil
 }
 
+// newOCIClientResolveRevision is a helper to instantiate an OCI client and resolve the given tag to the digest of the
+// artifact manifest
+func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string) (oci.Client, string, error) {
+	ociClient, err := s.newOCIClientForRepo(repo)
+	if err != nil {
+		return nil, "", err
+	}
+	digest, err := ociClient.ResolveRevision(ctx, revision)
+	if err != nil {
+		return nil, "", err
+	}
+	return ociClient, digest, nil
+}
+
+func (s *Service) newOCIClientForRepo(repo *v1alpha1.Repository) (oci.Client, error) {
+	opts := []oci.ClientOpts{
+		oci.WithBlobCache(s.ociBlobCache),
+		oci.WithTempDir(s.rootDir),
+		oci.WithMaxExtractedSize(s.initConstants.OCIMaxExtractedSize.Value()),
+	}
+	if len(s.initConstants.OCILayerMediaTypes) > 0 {
+		opts = append(opts, oci.WithLayerMediaTypes(s.initConstants.OCILayerMediaTypes))
+	}
+	return s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, opts...)
+}
+
 // directoryPermissionInitializer ensures the directory has read/write/execute permissions and returns
 // a function that can be used to remove all permissions.
 func directoryPermissionInitializer(rootPath string) goio.Closer {
@@ -1930,6 +2389,97 @@ func (s *Service) GetHelmCharts(ctx context.Context, q *apiclient.HelmChartsRequ
 	return &res, nil
 }
 
+// HydrateManifests commits the rendered manifests of an application to the target branch of its repository, and
+// returns the revision of the target branch holding the manifests. Nothing is committed if the manifests are
+// unchanged, and the repository is not cloned again if the same manifests have already been committed for the dry
+// revision.
+func (s *Service) HydrateManifests(ctx context.Context, q *apiclient.HydrateManifestsRequest) (*apiclient.HydrateManifestsResponse, error) {
+	if q.TargetBranch == "" {
+		return nil, status.Error(codes.InvalidArgument, "target branch is required")
+	}
+	if filepath.IsAbs(q.Path) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: hydration path is absolute", q.Path)
+	}
+
+	if hydratedSHA, err := s.cache.GetHydratedRevision(q.Repo.Repo, q.TargetBranch, q.Path, q.DrySHA, q.Manifests); err == nil {
+		return &apiclient.HydrateManifestsResponse{HydratedSHA: hydratedSHA}, nil
+	}
+
+	// commits of the same branch must not race against each other
+	lockKey := git.NormalizeGitURL(q.Repo.Repo) + "|" + q.TargetBranch
+	s.hydrationLock.Lock(lockKey)
+	defer s.hydrationLock.Unlock(lockKey)
+
+	// the shared checkout of the repository must not be modified, so the target branch is checked out separately
+	root, err := os.MkdirTemp(s.rootDir, "hydrate")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(root) }()
+	gitClient, err := s.newGitClient(q.Repo.Repo, root, q.Repo.GetGitCreds(s.gitCredsStore), q.Repo.IsInsecure(), false, q.Repo.Proxy)
+	if err != nil {
+		return nil, err
+	}
+	if err := gitClient.Init(); err != nil {
+		return nil, err
+	}
+	// committing to the branch of the dry revision would change the dry revision, and hydrate it again in turn
+	var dryRevision string
+	if q.DrySource != nil {
+		dryRevision = q.DrySource.TargetRevision
+	}
+	dryBranch, err := gitClient.ResolveBranch(dryRevision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve revision %s: %w", dryRevision, err)
+	}
+	if dryBranch == q.TargetBranch {
+		return nil, status.Errorf(codes.InvalidArgument, "target branch %s is the branch of the source revision", q.TargetBranch)
+	}
+	if err := gitClient.CheckoutOrOrphan(q.TargetBranch); err != nil {
+		return nil, fmt.Errorf("failed to check out branch %s: %w", q.TargetBranch, err)
+	}
+
+	hydratedPath := filepath.Join(gitClient.Root(), q.Path)
+	if hydratedPath != filepath.Clean(gitClient.Root()) && !files.Inbound(hydratedPath, gitClient.Root()) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: hydration path outside root", q.Path)
+	}
+	if err := os.MkdirAll(hydratedPath, 0755); err != nil {
+		return nil, err
+	}
+	manifests := make([]string, len(q.Manifests))
+	for i, manifest := range q.Manifests {
+		data, err := yaml.JSONToYAML([]byte(manifest))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert manifest to YAML: %w", err)
+		}
+		manifests[i] = string(data)
+	}
+	if err := os.WriteFile(filepath.Join(hydratedPath, hydratedManifestFile), []byte(strings.Join(manifests, "---\n")), 0644); err != nil {
+		return nil, err
+	}
+
+	hydratedSHA, err := gitClient.CommitAndPush(q.TargetBranch, hydrationCommitMessage(q))
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit manifests to branch %s: %w", q.TargetBranch, err)
+	}
+	log.WithFields(map[string]interface{}{"application": q.AppName, "drySHA": q.DrySHA, "hydratedSHA": hydratedSHA}).Info("Hydrated manifests")
+	if err := s.cache.SetHydratedRevision(q.Repo.Repo, q.TargetBranch, q.Path, q.DrySHA, q.Manifests, hydratedSHA); err != nil {
+		log.Warnf("hydrated revision set error %s/%s: %v", q.TargetBranch, q.DrySHA, err)
+	}
+	return &apiclient.HydrateManifestsResponse{HydratedSHA: hydratedSHA}, nil
+}
+
+// hydrationCommitMessage returns the message of the commit of hydrated manifests, which links the commit to the
+// revision of the source the manifests have been rendered from
+func hydrationCommitMessage(q *apiclient.HydrateManifestsRequest) string {
+	var source v1alpha1.ApplicationSource
+	if q.DrySource != nil {
+		source = *q.DrySource
+	}
+	return fmt.Sprintf("Hydrate %s from %s\n\nArgocd-dry-repo-url: %s\nArgocd-dry-path: %s\nArgocd-dry-sha: %s\n",
+		q.AppName, q.DrySHA, source.RepoURL, source.Path, q.DrySHA)
+}
+
 func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
 	repo := q.Repo
 	// per Type doc, "git" should be assumed if empty or absent
@@ -1940,6 +2490,14 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 		"git": func() error {
 			return git.TestRepo(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy)
 		},
+		"oci": func() error {
+			ociClient, err := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy)
+			if err != nil {
+				return err
+			}
+			_, err = ociClient.TestRepo(ctx)
+			return err
+		},
 		"helm": func() error {
 			if repo.EnableOCI {
 				if !helm.IsHelmOciRepo(repo.Repo) {
@@ -1969,17 +2527,22 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 	app := q.App
 	ambiguousRevision := q.AmbiguousRevision
 	var revision string
-	if app.Spec.Source.IsHelm() {
+	sources := app.Spec.GetSources()
+	if q.SourceIndex < 0 || q.SourceIndex >= int64(len(sources)) {
+		return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, fmt.Errorf("source index %d is out of range for %d sources", q.SourceIndex, len(sources))
+	}
+	source := sources[q.SourceIndex]
+	if source.IsHelm() {
 
 		if helm.IsVersion(ambiguousRevision) {
 			return &apiclient.ResolveRevisionResponse{Revision: ambiguousRevision, AmbiguousRevision: ambiguousRevision}, nil
 		}
-		client := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI || app.Spec.Source.IsHelmOci(), repo.Proxy, helm.WithChartPaths(s.chartPaths))
+		client := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI || source.IsHelmOci(), repo.Proxy, helm.WithChartPaths(s.chartPaths))
 		index, err := client.GetIndex(false)
 		if err != nil {
 			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
 		}
-		entries, err := index.GetEntries(app.Spec.Source.Chart)
+		entries, err := index.GetEntries(source.Chart)
 		if err != nil {
 			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
 		}
@@ -1995,6 +2558,15 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 			Revision:          version.String(),
 			AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, version.String()),
 		}, nil
+	} else if source.IsOCI() {
+		_, digest, err := s.newOCIClientResolveRevision(ctx, repo, ambiguousRevision)
+		if err != nil {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
+		}
+		return &apiclient.ResolveRevisionResponse{
+			Revision:          digest,
+			AmbiguousRevision: fmt.Sprintf("%s (%s)", ambiguousRevision, digest),
+		}, nil
 	} else {
 		gitClient, err := git.NewClient(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy)
 		if err != nil {
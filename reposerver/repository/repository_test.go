@@ -40,6 +40,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	helmmocks "github.com/argoproj/argo-cd/v2/util/helm/mocks"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 )
 
 const testSignature = `gpg: Signature made Wed Feb 26 23:22:34 2020 CET
@@ -289,6 +290,35 @@ func TestGenerateManifests_EmptyCache(t *testing.T) {
 	assert.True(t, len(res.Manifests) > 0)
 }
 
+func TestGetManifestCacheEntry_ChartSignature(t *testing.T) {
+	service := newService(".")
+	src := argoappv1.ApplicationSource{Chart: "my-chart", TargetRevision: "1.1.0"}
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src}
+	err := service.cache.SetManifests("1.1.0", &src, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{Manifests: []string{"{}"}}})
+	require.NoError(t, err)
+
+	ok, res, err := service.getManifestCacheEntry("1.1.0", &q, true)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.NotNil(t, res)
+
+	q.VerifyChartSignature = true
+	ok, res, err = service.getManifestCacheEntry("1.1.0", &q, true)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, res)
+
+	err = service.cache.SetManifests("1.1.0", &src, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{
+		Manifests:      []string{"{}"},
+		ChartSignature: &apiclient.ChartSignatureVerification{Type: helm.SignatureTypeProvenance},
+	}})
+	require.NoError(t, err)
+	ok, res, err = service.getManifestCacheEntry("1.1.0", &q, true)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, helm.SignatureTypeProvenance, res.ChartSignature.Type)
+}
+
 // ensure we can use a semver constraint range (>= 1.0.0) and get back the correct chart (1.0.0)
 func TestHelmManifestFromChartRepo(t *testing.T) {
 	service := newService(".")
@@ -306,6 +336,127 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	}, response)
 }
 
+// fakeOCIClient serves a single artifact, which is extracted from the given directory
+type fakeOCIClient struct {
+	digest   string
+	path     string
+	manifest *oci.Manifest
+}
+
+func (c *fakeOCIClient) ResolveRevision(_ context.Context, revision string) (string, error) {
+	if revision != "v1.0.0" && revision != c.digest {
+		return "", fmt.Errorf("unknown revision %s", revision)
+	}
+	return c.digest, nil
+}
+
+func (c *fakeOCIClient) GetManifest(_ context.Context, digest string) (*oci.Manifest, error) {
+	if digest != c.digest {
+		return nil, fmt.Errorf("unknown digest %s", digest)
+	}
+	return c.manifest, nil
+}
+
+func (c *fakeOCIClient) Extract(_ context.Context, digest string) (string, io.Closer, error) {
+	if digest != c.digest {
+		return "", nil, fmt.Errorf("unknown digest %s", digest)
+	}
+	return c.path, io.NopCloser, nil
+}
+
+func (c *fakeOCIClient) GetBlob(_ context.Context, blob oci.Descriptor) ([]byte, error) {
+	return nil, fmt.Errorf("unknown blob %s", blob.Digest)
+}
+
+func (c *fakeOCIClient) TestRepo(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+func newServiceWithOCIClient(t *testing.T) (*Service, *fakeOCIClient) {
+	service := newService(".")
+	path, err := filepath.Abs("./testdata")
+	require.NoError(t, err)
+	ociClient := &fakeOCIClient{
+		digest: "sha256:6d3d5e4c2bb53b5c5c8f6b1e0d6a2f1f7f5b5e0b8b3f1e1c4d4a3b2a1f0e9d8c",
+		path:   path,
+		manifest: &oci.Manifest{Annotations: map[string]string{
+			"org.opencontainers.image.created":     "2023-05-01T10:00:00Z",
+			"org.opencontainers.image.authors":     "argo",
+			"org.opencontainers.image.description": "guestbook manifests",
+		}},
+	}
+	service.newOCIClient = func(repoURL string, creds oci.Creds, proxy string, opts ...oci.ClientOpts) (oci.Client, error) {
+		return ociClient, nil
+	}
+	return service, ociClient
+}
+
+func TestManifestFromOCIRepo(t *testing.T) {
+	service, ociClient := newServiceWithOCIClient(t)
+	source := &argoappv1.ApplicationSource{RepoURL: "oci://example.com/org/manifests", Path: "recurse", TargetRevision: "v1.0.0", Directory: &argoappv1.ApplicationSourceDirectory{Recurse: true}}
+	request := &apiclient.ManifestRequest{Repo: &argoappv1.Repository{Repo: "oci://example.com/org/manifests"}, ApplicationSource: source, NoCache: true}
+	response, err := service.GenerateManifest(context.Background(), request)
+	require.NoError(t, err)
+	assert.Len(t, response.Manifests, 2)
+	assert.Equal(t, ociClient.digest, response.Revision)
+	assert.Equal(t, "Directory", response.SourceType)
+
+	source.Path = "../.."
+	_, err = service.GenerateManifest(context.Background(), request)
+	assert.Error(t, err)
+}
+
+func TestResolveRevision_OCI(t *testing.T) {
+	service, ociClient := newServiceWithOCIClient(t)
+	repo := &argoappv1.Repository{Repo: "oci://example.com/org/manifests"}
+	app := &argoappv1.Application{Spec: argoappv1.ApplicationSpec{Source: argoappv1.ApplicationSource{RepoURL: repo.Repo}}}
+	resolveRevisionResponse, err := service.ResolveRevision(context.Background(), &apiclient.ResolveRevisionRequest{
+		Repo:              repo,
+		App:               app,
+		AmbiguousRevision: "v1.0.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ociClient.digest, resolveRevisionResponse.Revision)
+	assert.Equal(t, fmt.Sprintf("v1.0.0 (%s)", ociClient.digest), resolveRevisionResponse.AmbiguousRevision)
+}
+
+func TestResolveRevision_OCIMultipleSources(t *testing.T) {
+	service, ociClient := newServiceWithOCIClient(t)
+	repo := &argoappv1.Repository{Repo: "oci://example.com/org/manifests"}
+	app := &argoappv1.Application{Spec: argoappv1.ApplicationSpec{Sources: []argoappv1.ApplicationSource{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+		{RepoURL: repo.Repo},
+	}}}
+	resolveRevisionResponse, err := service.ResolveRevision(context.Background(), &apiclient.ResolveRevisionRequest{
+		Repo:              repo,
+		App:               app,
+		AmbiguousRevision: "v1.0.0",
+		SourceIndex:       1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ociClient.digest, resolveRevisionResponse.Revision)
+
+	_, err = service.ResolveRevision(context.Background(), &apiclient.ResolveRevisionRequest{
+		Repo:              repo,
+		App:               app,
+		AmbiguousRevision: "v1.0.0",
+		SourceIndex:       2,
+	})
+	assert.ErrorContains(t, err, "source index 2 is out of range")
+}
+
+func TestGetRevisionMetadata_OCI(t *testing.T) {
+	service, ociClient := newServiceWithOCIClient(t)
+	res, err := service.GetRevisionMetadata(context.Background(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:     &argoappv1.Repository{Repo: "oci://example.com/org/manifests"},
+		Revision: ociClient.digest,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "argo", res.Author)
+	assert.Equal(t, "guestbook manifests", res.Message)
+	assert.Equal(t, time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC), res.Date.Time.UTC())
+}
+
 func TestGenerateManifestsUseExactRevision(t *testing.T) {
 	service, gitClient := newServiceWithMocks(".", false)
 
@@ -740,6 +891,72 @@ func TestGenerateHelmWithValues(t *testing.T) {
 
 }
 
+func TestGenerateHelmWithReferencedValueFiles(t *testing.T) {
+	service := newService("../..")
+
+	req := &apiclient.ManifestRequest{
+		Repo:    &argoappv1.Repository{},
+		AppName: "test",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Path: "./util/helm/testdata/redis",
+			Helm: &argoappv1.ApplicationSourceHelm{
+				ValueFiles: []string{"$values/util/helm/testdata/redis/values-production.yaml"},
+				Values:     `cluster: {slaveCount: 2}`,
+			},
+		},
+		RefSources: map[string]*argoappv1.RefTarget{
+			"$values": {Repo: argoappv1.Repository{}, TargetRevision: "HEAD"},
+		},
+		HasMultipleSources: true,
+	}
+	_, err := service.GenerateManifest(context.Background(), req)
+	assert.NoError(t, err)
+
+	// Should fail if the referenced source is not part of the request
+	req.RefSources = nil
+	_, err = service.GenerateManifest(context.Background(), req)
+	assert.EqualError(t, err, `source referenced by value file "$values/util/helm/testdata/redis/values-production.yaml" not found`)
+
+	// Should fail if the referenced source is a Helm chart
+	req.RefSources = map[string]*argoappv1.RefTarget{
+		"$values": {Repo: argoappv1.Repository{}, TargetRevision: "1.0.0", Chart: "redis"},
+	}
+	_, err = service.GenerateManifest(context.Background(), req)
+	assert.EqualError(t, err, `source referenced by value file "$values/util/helm/testdata/redis/values-production.yaml" is a Helm chart, only Git sources can be referenced`)
+}
+
+func TestGenerateManifestsForRefSource(t *testing.T) {
+	res, err := GenerateManifests(context.Background(), "./testdata/my-chart", "/", "", &apiclient.ManifestRequest{
+		ApplicationSource:  &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+		HasMultipleSources: true,
+	}, false, &git.NoopCredsStore{}, resource.MustParse("0"))
+	assert.NoError(t, err)
+	assert.Empty(t, res.Manifests)
+}
+
+func TestSplitReferencedValueFile(t *testing.T) {
+	ref, path, isRef := splitReferencedValueFile("$values/envs/prod/values.yaml")
+	assert.True(t, isRef)
+	assert.Equal(t, "$values", ref)
+	assert.Equal(t, "envs/prod/values.yaml", path)
+
+	ref, path, isRef = splitReferencedValueFile("$values")
+	assert.True(t, isRef)
+	assert.Equal(t, "$values", ref)
+	assert.Equal(t, "", path)
+
+	_, _, isRef = splitReferencedValueFile("values.yaml")
+	assert.False(t, isRef)
+}
+
+func TestReferencedSourcesCacheKey(t *testing.T) {
+	assert.Equal(t, "", referencedSourcesCacheKey(nil))
+	assert.Equal(t, "|$a=123,$b=456", referencedSourcesCacheKey([]referencedSource{
+		{ref: "$b", commitSHA: "456"},
+		{ref: "$a", commitSHA: "123"},
+	}))
+}
+
 func TestHelmWithMissingValueFiles(t *testing.T) {
 	service := newService("../..")
 	missingValuesFile := "values-prod-overrides.yaml"
@@ -1227,6 +1444,56 @@ func TestGetHelmCharts(t *testing.T) {
 	assert.EqualValues(t, []string{"1.0.0", "1.1.0"}, item.Versions)
 }
 
+func TestHydrateManifests(t *testing.T) {
+	root := t.TempDir()
+	service, gitClient := newServiceWithOpt(func(gitClient *gitmocks.Client) {
+		gitClient.On("Init").Return(nil)
+		gitClient.On("Root").Return(root)
+		gitClient.On("ResolveBranch", "").Return("main", nil)
+		gitClient.On("ResolveBranch", "env/prod").Return("env/prod", nil)
+		gitClient.On("CheckoutOrOrphan", "env/prod").Return(nil)
+		gitClient.On("CommitAndPush", "env/prod", mock.Anything).Return("bbcc", nil)
+	})
+	q := &apiclient.HydrateManifestsRequest{
+		Repo:         &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"},
+		AppName:      "guestbook",
+		DrySource:    &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+		DrySHA:       "aabb",
+		TargetBranch: "env/prod",
+		Path:         "guestbook",
+		Manifests:    []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`, `{"apiVersion":"v1","kind":"Service","metadata":{"name":"b"}}`},
+	}
+
+	res, err := service.HydrateManifests(context.Background(), q)
+	require.NoError(t, err)
+	assert.Equal(t, "bbcc", res.HydratedSHA)
+	data, err := os.ReadFile(filepath.Join(root, "guestbook", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: b\n", string(data))
+	gitClient.AssertCalled(t, "CommitAndPush", "env/prod", "Hydrate guestbook from aabb\n\n"+
+		"Argocd-dry-repo-url: https://github.com/argoproj/argocd-example-apps\nArgocd-dry-path: guestbook\nArgocd-dry-sha: aabb\n")
+
+	// the manifests of the dry revision have been committed already
+	res, err = service.HydrateManifests(context.Background(), q)
+	require.NoError(t, err)
+	assert.Equal(t, "bbcc", res.HydratedSHA)
+	gitClient.AssertNumberOfCalls(t, "Init", 1)
+
+	q.DrySHA = "ddee"
+	q.Path = "../outside"
+	_, err = service.HydrateManifests(context.Background(), q)
+	assert.ErrorContains(t, err, "hydration path outside root")
+
+	q.Path = "../" + filepath.Base(root) + "-sibling"
+	_, err = service.HydrateManifests(context.Background(), q)
+	assert.ErrorContains(t, err, "hydration path outside root")
+
+	q.Path = "guestbook"
+	q.DrySource.TargetRevision = "env/prod"
+	_, err = service.HydrateManifests(context.Background(), q)
+	assert.ErrorContains(t, err, "target branch env/prod is the branch of the source revision")
+}
+
 func TestGetRevisionMetadata(t *testing.T) {
 	service, gitClient := newServiceWithMocks("../..", false)
 	now := time.Now()
@@ -2478,3 +2745,83 @@ func Test_populateHelmAppDetails_values_symlinks(t *testing.T) {
 		assert.Empty(t, res.Helm.Parameters)
 	})
 }
+
+func TestPostRendererKustomization(t *testing.T) {
+	kustomization, err := postRendererKustomization([]argoappv1.KustomizePatch{
+		{Patch: "- op: replace\n  path: /spec/replicas\n  value: 2\n", Target: &argoappv1.KustomizeSelector{Kind: "Deployment", Name: "guestbook"}},
+		{Patch: "apiVersion: v1\nkind: Service\nmetadata:\n  name: guestbook\n"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+patches:
+- patch: |
+    - op: replace
+      path: /spec/replicas
+      value: 2
+  target:
+    kind: Deployment
+    name: guestbook
+- patch: |
+    apiVersion: v1
+    kind: Service
+    metadata:
+      name: guestbook
+resources:
+- helm-output.yaml
+`, string(kustomization))
+}
+
+func TestPostRenderHelm_NoPostRenderer(t *testing.T) {
+	objs := []*unstructured.Unstructured{{Object: map[string]interface{}{"kind": "ConfigMap"}}}
+	res, err := postRenderHelm("./testdata/my-chart", "./testdata", objs, nil, &apiclient.ManifestRequest{}, &argoappv1.Env{}, &git.NoopCredsStore{})
+	require.NoError(t, err)
+	assert.Equal(t, objs, res)
+}
+
+func TestPostRenderHelm_PathOutsideRoot(t *testing.T) {
+	_, err := postRenderHelm("./testdata/my-chart", "./testdata", nil, &argoappv1.HelmPostRenderer{Path: "../../.."}, &apiclient.ManifestRequest{Repo: &argoappv1.Repository{}}, &argoappv1.Env{}, &git.NoopCredsStore{})
+	assert.ErrorContains(t, err, "app path outside root")
+}
+
+func TestGenerateManifests_HelmPostRenderer(t *testing.T) {
+	for _, binary := range []string{"helm", "kustomize"} {
+		if _, err := exec.LookPath(binary); err != nil {
+			t.Skipf("%s is not installed", binary)
+		}
+	}
+	// the overlay is written to during the build, so the chart is copied to not modify the test data
+	repoRoot := t.TempDir()
+	appPath := filepath.Join(repoRoot, "helm-post-renderer")
+	require.NoError(t, os.MkdirAll(appPath, 0755))
+	require.NoError(t, fileutil.CopyDir("./testdata/helm-post-renderer", appPath))
+
+	q := &apiclient.ManifestRequest{
+		Repo:    &argoappv1.Repository{},
+		AppName: "guestbook",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Path: "helm-post-renderer",
+			Helm: &argoappv1.ApplicationSourceHelm{PostRenderer: &argoappv1.HelmPostRenderer{
+				Path: "overlay",
+				Patches: []argoappv1.KustomizePatch{{
+					Patch:  "- op: replace\n  path: /spec/replicas\n  value: 3\n",
+					Target: &argoappv1.KustomizeSelector{Kind: "Deployment", Name: "guestbook"},
+				}},
+			}},
+		},
+	}
+	res, err := GenerateManifests(context.Background(), appPath, repoRoot, "", q, false, &git.NoopCredsStore{}, resource.MustParse("0"))
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+
+	var deployment v1.Deployment
+	require.NoError(t, json.Unmarshal([]byte(res.Manifests[0]), &deployment))
+	assert.Equal(t, "guestbook", deployment.Name)
+	// the overlay is applied before the inline patch
+	assert.Equal(t, "true", deployment.Annotations["post-rendered"])
+	require.NotNil(t, deployment.Spec.Replicas)
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+
+	// the rendered manifests are not left in the overlay
+	assert.NoFileExists(t, filepath.Join(appPath, "overlay", helmPostRendererInputFile))
+}
@@ -72,21 +72,22 @@ var (
 
 // Server provides an Application service
 type Server struct {
-	ns             string
-	kubeclientset  kubernetes.Interface
-	appclientset   appclientset.Interface
-	appLister      applisters.ApplicationNamespaceLister
-	appInformer    cache.SharedIndexInformer
-	appBroadcaster *broadcasterHandler
-	repoClientset  apiclient.Clientset
-	kubectl        kube.Kubectl
-	db             db.ArgoDB
-	enf            *rbac.Enforcer
-	projectLock    sync.KeyLock
-	auditLogger    *argo.AuditLogger
-	settingsMgr    *settings.SettingsManager
-	cache          *servercache.Cache
-	projInformer   cache.SharedIndexInformer
+	ns                string
+	enabledNamespaces []string
+	kubeclientset     kubernetes.Interface
+	appclientset      appclientset.Interface
+	appLister         applisters.ApplicationLister
+	appInformer       cache.SharedIndexInformer
+	appBroadcaster    *broadcasterHandler
+	repoClientset     apiclient.Clientset
+	kubectl           kube.Kubectl
+	db                db.ArgoDB
+	enf               *rbac.Enforcer
+	projectLock       sync.KeyLock
+	auditLogger       *argo.AuditLogger
+	settingsMgr       *settings.SettingsManager
+	cache             *servercache.Cache
+	projInformer      cache.SharedIndexInformer
 }
 
 // NewServer returns a new instance of the Application service
@@ -94,7 +95,7 @@ func NewServer(
 	namespace string,
 	kubeclientset kubernetes.Interface,
 	appclientset appclientset.Interface,
-	appLister applisters.ApplicationNamespaceLister,
+	appLister applisters.ApplicationLister,
 	appInformer cache.SharedIndexInformer,
 	repoClientset apiclient.Clientset,
 	cache *servercache.Cache,
@@ -104,47 +105,122 @@ func NewServer(
 	projectLock sync.KeyLock,
 	settingsMgr *settings.SettingsManager,
 	projInformer cache.SharedIndexInformer,
+	enabledNamespaces []string,
 ) (application.ApplicationServiceServer, AppResourceTreeFn) {
 	appBroadcaster := &broadcasterHandler{}
 	appInformer.AddEventHandler(appBroadcaster)
 	s := &Server{
-		ns:             namespace,
-		appclientset:   appclientset,
-		appLister:      appLister,
-		appInformer:    appInformer,
-		appBroadcaster: appBroadcaster,
-		kubeclientset:  kubeclientset,
-		cache:          cache,
-		db:             db,
-		repoClientset:  repoClientset,
-		kubectl:        kubectl,
-		enf:            enf,
-		projectLock:    projectLock,
-		auditLogger:    argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
-		settingsMgr:    settingsMgr,
-		projInformer:   projInformer,
+		ns:                namespace,
+		appclientset:      appclientset,
+		appLister:         appLister,
+		appInformer:       appInformer,
+		appBroadcaster:    appBroadcaster,
+		kubeclientset:     kubeclientset,
+		cache:             cache,
+		db:                db,
+		repoClientset:     repoClientset,
+		kubectl:           kubectl,
+		enf:               enf,
+		projectLock:       projectLock,
+		auditLogger:       argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		settingsMgr:       settingsMgr,
+		projInformer:      projInformer,
+		enabledNamespaces: enabledNamespaces,
 	}
 	return s, s.GetAppResources
 }
 
+// resolveAppName returns the namespace and the name of a requested application. The name may be qualified with the
+// namespace of the application (i.e. "namespace/name"), while an explicitly requested namespace takes precedence. If no
+// namespace is given at all, the control plane's namespace is assumed. Requests for applications in namespaces that
+// are not enabled are denied.
+func (s *Server) resolveAppName(name string, appNs string) (string, string, error) {
+	namespace, name := apputil.ParseAppQualifiedName(name, s.ns)
+	if appNs != "" {
+		namespace = appNs
+	}
+	if !s.isNamespaceEnabled(namespace) {
+		return "", "", namespaceNotPermittedError(namespace)
+	}
+	return namespace, name, nil
+}
+
+// isNamespaceEnabled returns whether applications are allowed to be managed in the given namespace
+func (s *Server) isNamespaceEnabled(namespace string) bool {
+	return apputil.IsAppNamespaceEnabled(namespace, s.ns, s.enabledNamespaces)
+}
+
+// getAppFromLister resolves the requested application and retrieves it from the informer cache
+func (s *Server) getAppFromLister(name string, appNs string) (*appv1.Application, error) {
+	namespace, name, err := s.resolveAppName(name, appNs)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.appLister.Applications(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// checkAppNamespacePermitted returns an error if the project of the application does not permit applications in the
+// namespace of the application, in which case the application controller does not reconcile it either
+func (s *Server) checkAppNamespacePermitted(a *appv1.Application) error {
+	if a.Namespace == "" || a.Namespace == s.ns {
+		return nil
+	}
+	proj, err := applisters.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns).Get(a.Spec.GetProject())
+	if err != nil && !apierr.IsNotFound(err) {
+		return fmt.Errorf("error getting application's project: %w", err)
+	}
+	if err != nil || !proj.IsAppNamespacePermitted(a, s.ns) {
+		return status.Error(codes.PermissionDenied, argo.ErrProjectNotPermitted(a.Name, a.Namespace, a.Spec.GetProject()).Error())
+	}
+	return nil
+}
+
+func namespaceNotPermittedError(namespace string) error {
+	return status.Errorf(codes.PermissionDenied, "namespace '%s' is not permitted", namespace)
+}
+
 // List returns list of applications
 func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*appv1.ApplicationList, error) {
 	labelsMap, err := labels.ConvertSelectorToLabelsMap(q.GetSelector())
 	if err != nil {
 		return nil, fmt.Errorf("error converting selector to labels map: %w", err)
 	}
-	apps, err := s.appLister.List(labelsMap.AsSelector())
+	appNs, appName := q.GetAppNamespace(), q.GetName()
+	if appName != "" {
+		if appNs, appName, err = s.resolveAppName(appName, appNs); err != nil {
+			return nil, err
+		}
+	}
+	var apps []*appv1.Application
+	if appNs != "" {
+		if !s.isNamespaceEnabled(appNs) {
+			return nil, namespaceNotPermittedError(appNs)
+		}
+		apps, err = s.appLister.Applications(appNs).List(labelsMap.AsSelector())
+	} else {
+		apps, err = s.appLister.List(labelsMap.AsSelector())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
 	}
 	newItems := make([]appv1.Application, 0)
 	for _, a := range apps {
-		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)) {
+		if !s.isNamespaceEnabled(a.Namespace) {
+			continue
+		}
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)) {
 			newItems = append(newItems, *a)
 		}
 	}
 	if q.Name != nil {
-		newItems, err = argoutil.FilterByName(newItems, *q.Name)
+		newItems, err = argoutil.FilterByName(newItems, appName)
 		if err != nil {
 			return nil, fmt.Errorf("error filtering applications by name: %w", err)
 		}
@@ -158,6 +234,9 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*ap
 
 	// Sort found applications by name
 	sort.Slice(newItems, func(i, j int) bool {
+		if newItems[i].Name == newItems[j].Name {
+			return newItems[i].Namespace < newItems[j].Namespace
+		}
 		return newItems[i].Name < newItems[j].Name
 	})
 
@@ -175,14 +254,20 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if q.GetApplication() == nil {
 		return nil, fmt.Errorf("error creating application: application is nil in request")
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, apputil.AppRBACName(*q.Application)); err != nil {
+	a := q.GetApplication()
+	if a.Namespace == "" {
+		a.Namespace = s.ns
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, namespaceNotPermittedError(a.Namespace)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 
 	s.projectLock.RLock(q.Application.Spec.Project)
 	defer s.projectLock.RUnlock(q.Application.Spec.Project)
 
-	a := q.GetApplication()
 	validate := true
 	if q.Validate != nil {
 		validate = *q.Validate
@@ -191,7 +276,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if err != nil {
 		return nil, fmt.Errorf("error while validating and normalizing app: %w", err)
 	}
-	created, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Create(ctx, a, metav1.CreateOptions{})
+	created, err := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Create(ctx, a, metav1.CreateOptions{})
 	if err == nil {
 		s.logAppEvent(created, ctx, argo.EventReasonResourceCreated, "created application")
 		s.waitSync(created)
@@ -201,7 +286,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		return nil, fmt.Errorf("error creating application: %w", err)
 	}
 	// act idempotent if existing spec matches new spec
-	existing, err := s.appLister.Get(a.Name)
+	existing, err := s.appLister.Applications(a.Namespace).Get(a.Name)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to check existing application details: %v", err)
 	}
@@ -216,7 +301,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if q.Upsert == nil || !*q.Upsert {
 		return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	updated, err := s.updateApp(existing, a, ctx, true)
@@ -226,13 +311,15 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	return updated, nil
 }
 
+// queryRepoServer calls the given action with a repo server client and the settings required to query it. The
+// repositories and Kustomize options are passed for each of the application's sources, in the order of the sources.
 func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, action func(
 	client apiclient.RepoServerServiceClient,
-	repo *appv1.Repository,
+	repos []*appv1.Repository,
 	helmRepos []*appv1.Repository,
 	helmCreds []*v1alpha1.RepoCreds,
 	helmOptions *v1alpha1.HelmOptions,
-	kustomizeOptions *v1alpha1.KustomizeOptions,
+	kustomizeOptions []*v1alpha1.KustomizeOptions,
 	enabledSourceTypes map[string]bool,
 ) error) error {
 
@@ -241,17 +328,22 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 		return fmt.Errorf("error creating repo server client: %w", err)
 	}
 	defer ioutil.Close(closer)
-	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
-	if err != nil {
-		return fmt.Errorf("error getting repository: %w", err)
-	}
 	kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
 	if err != nil {
 		return fmt.Errorf("error getting kustomize settings: %w", err)
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(a.Spec.Source)
-	if err != nil {
-		return fmt.Errorf("error getting kustomize settings options: %w", err)
+	sources := a.Spec.GetSources()
+	repos := make([]*appv1.Repository, len(sources))
+	kustomizeOptions := make([]*appv1.KustomizeOptions, len(sources))
+	for i, source := range sources {
+		repos[i], err = s.db.GetRepository(ctx, source.RepoURL)
+		if err != nil {
+			return fmt.Errorf("error getting repository: %w", err)
+		}
+		kustomizeOptions[i], err = kustomizeSettings.GetOptions(source)
+		if err != nil {
+			return fmt.Errorf("error getting kustomize settings options: %w", err)
+		}
 	}
 	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
 	if err != nil {
@@ -286,22 +378,22 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 	if err != nil {
 		return fmt.Errorf("error getting settings enabled source types: %w", err)
 	}
-	return action(client, repo, permittedHelmRepos, permittedHelmCredentials, helmOptions, kustomizeOptions, enabledSourceTypes)
+	return action(client, repos, permittedHelmRepos, permittedHelmCredentials, helmOptions, kustomizeOptions, enabledSourceTypes)
 }
 
 // GetManifests returns application manifests
 func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationManifestQuery) (*apiclient.ManifestResponse, error) {
-	a, err := s.appLister.Get(*q.Name)
+	a, err := s.getAppFromLister(q.GetName(), q.GetAppNamespace())
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 
 	var manifestInfo *apiclient.ManifestResponse
 	err = s.queryRepoServer(ctx, a, func(
-		client apiclient.RepoServerServiceClient, repo *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, kustomizeOptions *appv1.KustomizeOptions, enableGenerateManifests map[string]bool) error {
+		client apiclient.RepoServerServiceClient, repos []*appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, kustomizeOptions []*appv1.KustomizeOptions, enableGenerateManifests map[string]bool) error {
 		revision := a.Spec.Source.TargetRevision
 		if q.GetRevision() != "" {
 			revision = q.GetRevision()
@@ -330,25 +422,64 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
-		manifestInfo, err = client.GenerateManifest(ctx, &apiclient.ManifestRequest{
-			Repo:               repo,
-			Revision:           revision,
-			AppLabelKey:        appInstanceLabelKey,
-			AppName:            a.Name,
-			Namespace:          a.Spec.Destination.Namespace,
-			ApplicationSource:  &a.Spec.Source,
-			Repos:              helmRepos,
-			Plugins:            plugins,
-			KustomizeOptions:   kustomizeOptions,
-			KubeVersion:        serverVersion,
-			ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
-			HelmRepoCreds:      helmCreds,
-			HelmOptions:        helmOptions,
-			TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
-			EnabledSourceTypes: enableGenerateManifests,
-		})
-		if err != nil {
-			return fmt.Errorf("error generating manifests: %w", err)
+		if !a.Spec.HasMultipleSources() {
+			manifestInfo, err = client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+				Repo:               repos[0],
+				Revision:           revision,
+				AppLabelKey:        appInstanceLabelKey,
+				AppName:            a.InstanceName(s.ns),
+				Namespace:          a.Spec.Destination.Namespace,
+				ApplicationSource:  &a.Spec.Source,
+				Repos:              helmRepos,
+				Plugins:            plugins,
+				KustomizeOptions:   kustomizeOptions[0],
+				KubeVersion:        serverVersion,
+				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+				HelmRepoCreds:      helmCreds,
+				HelmOptions:        helmOptions,
+				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
+				EnabledSourceTypes: enableGenerateManifests,
+			})
+			if err != nil {
+				return fmt.Errorf("error generating manifests: %w", err)
+			}
+			return nil
+		}
+
+		// The manifests of applications with multiple sources are generated for each source
+		// at its target revision and merged into a single response.
+		refSources := map[string]*appv1.RefTarget{}
+		for i, source := range a.Spec.Sources {
+			if source.Ref != "" {
+				refSources["$"+source.Ref] = &appv1.RefTarget{Repo: *repos[i], TargetRevision: source.TargetRevision, Chart: source.Chart}
+			}
+		}
+		manifestInfo = &apiclient.ManifestResponse{Manifests: []string{}}
+		for i := range a.Spec.Sources {
+			source := a.Spec.Sources[i]
+			res, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+				Repo:               repos[i],
+				Revision:           source.TargetRevision,
+				AppLabelKey:        appInstanceLabelKey,
+				AppName:            a.InstanceName(s.ns),
+				Namespace:          a.Spec.Destination.Namespace,
+				ApplicationSource:  &source,
+				Repos:              helmRepos,
+				Plugins:            plugins,
+				KustomizeOptions:   kustomizeOptions[i],
+				KubeVersion:        serverVersion,
+				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+				HelmRepoCreds:      helmCreds,
+				HelmOptions:        helmOptions,
+				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
+				EnabledSourceTypes: enableGenerateManifests,
+				RefSources:         refSources,
+				HasMultipleSources: true,
+			})
+			if err != nil {
+				return fmt.Errorf("error generating manifests for source %d: %w", i+1, err)
+			}
+			manifestInfo.Manifests = append(manifestInfo.Manifests, res.Manifests...)
 		}
 		return nil
 	})
@@ -384,14 +515,21 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 	// We must use a client Get instead of an informer Get, because it's common to call Get immediately
 	// following a Watch (which is not yet powered by an informer), and the Get must reflect what was
 	// previously seen by the client.
-	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, q.GetName(), metav1.GetOptions{
+	appNs, appName, err := s.resolveAppName(q.GetName(), q.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{
 		ResourceVersion: q.GetResourceVersion(),
 	})
 
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	if q.Refresh == nil {
@@ -402,16 +540,16 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 	if *q.Refresh == string(appv1.RefreshTypeHard) {
 		refreshType = appv1.RefreshTypeHard
 	}
-	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
 
 	// subscribe early with buffered channel to ensure we don't miss events
 	events := make(chan *appv1.ApplicationWatchEvent, watchAPIBufferSize)
 	unsubscribe := s.appBroadcaster.Subscribe(events, func(event *appv1.ApplicationWatchEvent) bool {
-		return event.Application.Name == q.GetName()
+		return event.Application.Name == appName && event.Application.Namespace == appNs
 	})
 	defer unsubscribe()
 
-	app, err := argoutil.RefreshApp(appIf, *q.Name, refreshType)
+	app, err := argoutil.RefreshApp(appIf, appName, refreshType)
 	if err != nil {
 		return nil, fmt.Errorf("error refreshing the app: %w", err)
 	}
@@ -420,25 +558,35 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 		// force refresh cached application details
 		if err := s.queryRepoServer(ctx, a, func(
 			client apiclient.RepoServerServiceClient,
-			repo *appv1.Repository,
+			repos []*appv1.Repository,
 			helmRepos []*appv1.Repository,
 			_ []*appv1.RepoCreds,
 			helmOptions *appv1.HelmOptions,
-			kustomizeOptions *appv1.KustomizeOptions,
+			kustomizeOptions []*appv1.KustomizeOptions,
 			enabledSourceTypes map[string]bool,
 		) error {
-			_, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-				Repo:               repo,
-				Source:             &app.Spec.Source,
-				AppName:            app.Name,
-				KustomizeOptions:   kustomizeOptions,
-				Repos:              helmRepos,
-				NoCache:            true,
-				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
-				EnabledSourceTypes: enabledSourceTypes,
-				HelmOptions:        helmOptions,
-			})
-			return err
+			for i, source := range a.Spec.GetSources() {
+				// sources which are only referenced by other sources have no application details
+				if a.Spec.HasMultipleSources() && source.IsRef() {
+					continue
+				}
+				source := source
+				_, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
+					Repo:               repos[i],
+					Source:             &source,
+					AppName:            app.InstanceName(s.ns),
+					KustomizeOptions:   kustomizeOptions[i],
+					Repos:              helmRepos,
+					NoCache:            true,
+					TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
+					EnabledSourceTypes: enabledSourceTypes,
+					HelmOptions:        helmOptions,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
 		}); err != nil {
 			log.Warnf("Failed to force refresh application details: %v", err)
 		}
@@ -469,11 +617,11 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 
 // ListResourceEvents returns a list of event resources
 func (s *Server) ListResourceEvents(ctx context.Context, q *application.ApplicationResourceEventsQuery) (*v1.EventList, error) {
-	a, err := s.appLister.Get(*q.Name)
+	a, err := s.getAppFromLister(q.GetName(), q.GetAppNamespace())
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	var (
@@ -537,7 +685,7 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *appv1.Applica
 	s.projectLock.RLock(newApp.Spec.GetProject())
 	defer s.projectLock.RUnlock(newApp.Spec.GetProject())
 
-	app, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, newApp.Name, metav1.GetOptions{})
+	app, err := s.appclientset.ArgoprojV1alpha1().Applications(newApp.Namespace).Get(ctx, newApp.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
@@ -586,7 +734,7 @@ func (s *Server) waitSync(app *appv1.Application) {
 		return
 	}
 	for {
-		if currApp, err := s.appLister.Get(app.Name); err == nil {
+		if currApp, err := s.appLister.Applications(app.Namespace).Get(app.Name); err == nil {
 			currVersion, err := strconv.Atoi(currApp.ResourceVersion)
 			if err == nil && currVersion >= minVersion {
 				return
@@ -613,7 +761,7 @@ func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ct
 
 		app.Finalizers = newApp.Finalizers
 
-		res, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(ctx, app, metav1.UpdateOptions{})
+		res, err := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(ctx, app, metav1.UpdateOptions{})
 		if err == nil {
 			s.logAppEvent(app, ctx, argo.EventReasonResourceUpdated, "updated application spec")
 			s.waitSync(res)
@@ -623,7 +771,7 @@ func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ct
 			return nil, err
 		}
 
-		app, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, newApp.Name, metav1.GetOptions{})
+		app, err = s.appclientset.ArgoprojV1alpha1().Applications(newApp.Namespace).Get(ctx, newApp.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("error getting application: %w", err)
 		}
@@ -633,7 +781,16 @@ func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ct
 
 // Update updates an application
 func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateRequest) (*appv1.Application, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(*q.Application)); err != nil {
+	if q.GetApplication() == nil {
+		return nil, fmt.Errorf("error updating application: application is nil in request")
+	}
+	if q.Application.Namespace == "" {
+		q.Application.Namespace = s.ns
+	}
+	if !s.isNamespaceEnabled(q.Application.Namespace) {
+		return nil, namespaceNotPermittedError(q.Application.Namespace)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(s.ns, *q.Application)); err != nil {
 		return nil, err
 	}
 
@@ -649,11 +806,18 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	if q.GetSpec() == nil {
 		return nil, fmt.Errorf("error updating application spec: spec is nil in request")
 	}
-	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, *q.Name, metav1.GetOptions{})
+	appNs, appName, err := s.resolveAppName(q.GetName(), q.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(*a)); err != nil {
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	a.Spec = *q.GetSpec()
@@ -670,13 +834,19 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 
 // Patch patches an application
 func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchRequest) (*appv1.Application, error) {
-
-	app, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, *q.Name, metav1.GetOptions{})
+	appNs, appName, err := s.resolveAppName(q.GetName(), q.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	app, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
+	if err := s.checkAppNamespacePermitted(app); err != nil {
+		return nil, err
+	}
 
-	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(*app)); err != nil {
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(s.ns, *app)); err != nil {
 		return nil, err
 	}
 
@@ -711,20 +881,30 @@ func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchReque
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshaling patched app: %w", err)
 	}
+	if newApp.Name != app.Name || newApp.Namespace != app.Namespace {
+		return nil, status.Error(codes.InvalidArgument, "the name and namespace of an application cannot be patched")
+	}
 	return s.validateAndUpdateApp(ctx, newApp, false, true)
 }
 
 // Delete removes an application and all associated resources
 func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteRequest) (*application.ApplicationResponse, error) {
-	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, *q.Name, metav1.GetOptions{})
+	appNs, appName, err := s.resolveAppName(q.GetName(), q.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
 
 	s.projectLock.RLock(a.Spec.Project)
 	defer s.projectLock.RUnlock(a.Spec.Project)
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 
@@ -768,7 +948,7 @@ func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteReq
 		}
 	}
 
-	err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Delete(ctx, *q.Name, metav1.DeleteOptions{})
+	err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Delete(ctx, appName, metav1.DeleteOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error deleting application: %w", err)
 	}
@@ -790,6 +970,14 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 	if err != nil {
 		return fmt.Errorf("error parsing labels with selectors: %w", err)
 	}
+	appNs, appName := q.GetAppNamespace(), q.GetName()
+	if appName != "" {
+		if appNs, appName, err = s.resolveAppName(appName, appNs); err != nil {
+			return err
+		}
+	} else if appNs != "" && !s.isNamespaceEnabled(appNs) {
+		return namespaceNotPermittedError(appNs)
+	}
 	minVersion := 0
 	if q.GetResourceVersion() != "" {
 		if minVersion, err = strconv.Atoi(q.GetResourceVersion()); err != nil {
@@ -807,12 +995,15 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 		if appVersion, err := strconv.Atoi(a.ResourceVersion); err == nil && appVersion < minVersion {
 			return
 		}
-		matchedEvent := (q.GetName() == "" || a.Name == q.GetName()) && selector.Matches(labels.Set(a.Labels))
+		if !s.isNamespaceEnabled(a.Namespace) {
+			return
+		}
+		matchedEvent := (appName == "" || a.Name == appName) && (appNs == "" || a.Namespace == appNs) && selector.Matches(labels.Set(a.Labels))
 		if !matchedEvent {
 			return
 		}
 
-		if !s.enf.Enforce(claims, rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(a)) {
+		if !s.enf.Enforce(claims, rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, a)) {
 			// do not emit apps user does not have accessing
 			return
 		}
@@ -863,7 +1054,10 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		}
 		return fmt.Errorf("error getting application's project: %w", err)
 	}
-	currApp, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, app.Name, metav1.GetOptions{})
+	if !proj.IsAppNamespacePermitted(app, s.ns) {
+		return status.Error(codes.InvalidArgument, argo.ErrProjectNotPermitted(app.Name, app.Namespace, proj.Name).Error())
+	}
+	currApp, err := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(ctx, app.Name, metav1.GetOptions{})
 	if err != nil {
 		if !apierr.IsNotFound(err) {
 			return fmt.Errorf("error getting application by name: %w", err)
@@ -875,11 +1069,11 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 	if currApp != nil && currApp.Spec.GetProject() != app.Spec.GetProject() {
 		// When changing projects, caller must have application create & update privileges in new project
 		// NOTE: the update check was already verified in the caller to this function
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, apputil.AppRBACName(*app)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, apputil.AppRBACName(s.ns, *app)); err != nil {
 			return err
 		}
 		// They also need 'update' privileges in the old project
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(*currApp)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(s.ns, *currApp)); err != nil {
 			return err
 		}
 	}
@@ -949,8 +1143,9 @@ func (s *Server) getCachedAppState(ctx context.Context, a *appv1.Application, ge
 			return errors.New(argoutil.FormatAppConditions(conditions))
 		}
 		_, err = s.Get(ctx, &application.ApplicationQuery{
-			Name:    pointer.StringPtr(a.Name),
-			Refresh: pointer.StringPtr(string(appv1.RefreshTypeNormal)),
+			Name:         pointer.StringPtr(a.Name),
+			AppNamespace: pointer.StringPtr(a.Namespace),
+			Refresh:      pointer.StringPtr(string(appv1.RefreshTypeNormal)),
 		})
 		if err != nil {
 			return fmt.Errorf("error getting application by query: %w", err)
@@ -963,7 +1158,7 @@ func (s *Server) getCachedAppState(ctx context.Context, a *appv1.Application, ge
 func (s *Server) GetAppResources(ctx context.Context, a *appv1.Application) (*appv1.ApplicationTree, error) {
 	var tree appv1.ApplicationTree
 	err := s.getCachedAppState(ctx, a, func() error {
-		return s.cache.GetAppResourcesTree(a.Name, &tree)
+		return s.cache.GetAppResourcesTree(a.InstanceName(s.ns), &tree)
 	})
 	if err != nil {
 		return &tree, fmt.Errorf("error getting cached app state: %w", err)
@@ -972,11 +1167,11 @@ func (s *Server) GetAppResources(ctx context.Context, a *appv1.Application) (*ap
 }
 
 func (s *Server) getAppLiveResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*appv1.ResourceNode, *rest.Config, *appv1.Application, error) {
-	a, err := s.appLister.Get(*q.Name)
+	a, err := s.getAppFromLister(q.GetName(), q.GetAppNamespace())
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("error getting app by name: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, nil, nil, err
 	}
 
@@ -1037,6 +1232,7 @@ func replaceSecretValues(obj *unstructured.Unstructured) (*unstructured.Unstruct
 func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationResourcePatchRequest) (*application.ApplicationResourceResponse, error) {
 	resourceRequest := &application.ApplicationResourceRequest{
 		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
 		Namespace:    q.Namespace,
 		ResourceName: q.ResourceName,
 		Kind:         q.Kind,
@@ -1047,7 +1243,7 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 	if err != nil {
 		return nil, fmt.Errorf("error getting app live resource: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 
@@ -1078,6 +1274,7 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationResourceDeleteRequest) (*application.ApplicationResponse, error) {
 	resourceRequest := &application.ApplicationResourceRequest{
 		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
 		Namespace:    q.Namespace,
 		ResourceName: q.ResourceName,
 		Kind:         q.Kind,
@@ -1088,7 +1285,7 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 	if err != nil {
 		return nil, fmt.Errorf("error getting live resource for delete: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	var deleteOption metav1.DeleteOptions
@@ -1112,29 +1309,29 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 }
 
 func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery) (*appv1.ApplicationTree, error) {
-	a, err := s.appLister.Get(q.GetApplicationName())
+	a, err := s.getAppFromLister(q.GetApplicationName(), q.GetAppNamespace())
 	if err != nil {
 		return nil, fmt.Errorf("error getting application by name: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	return s.GetAppResources(ctx, a)
 }
 
 func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeServer) error {
-	a, err := s.appLister.Get(q.GetApplicationName())
+	a, err := s.getAppFromLister(q.GetApplicationName(), q.GetAppNamespace())
 	if err != nil {
 		return fmt.Errorf("error getting application by name: %w", err)
 	}
 
-	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return err
 	}
 
-	return s.cache.OnAppResourcesTreeChanged(ws.Context(), q.GetApplicationName(), func() error {
+	return s.cache.OnAppResourcesTreeChanged(ws.Context(), a.InstanceName(s.ns), func() error {
 		var tree appv1.ApplicationTree
-		err := s.cache.GetAppResourcesTree(q.GetApplicationName(), &tree)
+		err := s.cache.GetAppResourcesTree(a.InstanceName(s.ns), &tree)
 		if err != nil {
 			return fmt.Errorf("error getting app resource tree: %w", err)
 		}
@@ -1143,20 +1340,28 @@ func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application
 }
 
 func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
-	a, err := s.appLister.Get(q.GetName())
+	a, err := s.getAppFromLister(q.GetName(), q.GetAppNamespace())
 	if err != nil {
 		return nil, fmt.Errorf("error getting app by name: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
-	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
+	source := a.Spec.GetSource()
+	if a.Spec.HasMultipleSources() {
+		sourceIndex := int(q.GetSourceIndex())
+		if sourceIndex < 0 || sourceIndex >= len(a.Spec.Sources) {
+			return nil, status.Errorf(codes.InvalidArgument, "source index %d is out of range", sourceIndex)
+		}
+		source = a.Spec.Sources[sourceIndex]
+	}
+	repo, err := s.db.GetRepository(ctx, source.RepoURL)
 	if err != nil {
 		return nil, fmt.Errorf("error getting repository by URL: %w", err)
 	}
 	// We need to get some information with the project associated to the app,
 	// so we'll know whether GPG signatures are enforced.
-	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), a.Namespace, s.settingsMgr, s.db, ctx)
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting app project: %w", err)
 	}
@@ -1180,16 +1385,16 @@ func isMatchingResource(q *application.ResourcesQuery, key kube.ResourceKey) boo
 }
 
 func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQuery) (*application.ManagedResourcesResponse, error) {
-	a, err := s.appLister.Get(*q.ApplicationName)
+	a, err := s.getAppFromLister(q.GetApplicationName(), q.GetAppNamespace())
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, fmt.Errorf("error verifying rbac: %w", err)
 	}
 	items := make([]*appv1.ResourceDiff, 0)
 	err = s.getCachedAppState(ctx, a, func() error {
-		return s.cache.GetAppManagedResources(a.Name, &items)
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error getting cached app state: %w", err)
@@ -1239,12 +1444,12 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		}
 	}
 
-	a, err := s.appLister.Get(q.GetName())
+	a, err := s.getAppFromLister(q.GetName(), q.GetAppNamespace())
 	if err != nil {
 		return fmt.Errorf("error getting application by name: %w", err)
 	}
 
-	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return err
 	}
 
@@ -1259,7 +1464,7 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 	}
 
 	if serverRBACLogEnforceEnable {
-		if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceLogs, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+		if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceLogs, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 			return err
 		}
 	}
@@ -1436,13 +1641,20 @@ func isTheSelectedOne(currentNode *appv1.ResourceNode, q *application.Applicatio
 
 // Sync syncs an application to its target state
 func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*appv1.Application, error) {
-	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
-	a, err := appIf.Get(ctx, *syncReq.Name, metav1.GetOptions{})
+	appNs, appName, err := s.resolveAppName(syncReq.GetName(), syncReq.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	a, err := appIf.Get(ctx, appName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application by name: %w", err)
 	}
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
 
-	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), a.Namespace, s.settingsMgr, s.db, ctx)
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
 	if err != nil {
 		if apierr.IsNotFound(err) {
 			return a, status.Errorf(codes.InvalidArgument, "application references project %s which does not exist", a.Spec.Project)
@@ -1454,11 +1666,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
 	}
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	if syncReq.Manifests != nil {
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, apputil.AppRBACName(*a)); err != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, apputil.AppRBACName(s.ns, *a)); err != nil {
 			return nil, err
 		}
 		if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil && !syncReq.GetDryRun() {
@@ -1468,6 +1680,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if a.Spec.HasMultipleSources() && syncReq.GetRevision() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot sync to a specific revision: application has multiple sources")
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		if syncReq.GetRevision() != "" && syncReq.GetRevision() != text.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.GetRevision(), a.Spec.Source.TargetRevision)
@@ -1492,7 +1707,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	}
 
 	// We cannot use local manifests if we're only allowed to sync to signed commits
-	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+	if syncReq.Manifests != nil && (len(proj.Spec.SignatureKeys) > 0 || proj.RequiresChartSignature()) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
 	}
 
@@ -1521,7 +1736,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		op.Retry = *retry
 	}
 
-	a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
+	a, err = argo.SetAppOperation(appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -1530,6 +1745,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		partial = "partial "
 	}
 	reason := fmt.Sprintf("initiated %ssync to %s", partial, displayRevision)
+	if a.Spec.HasMultipleSources() {
+		reason = fmt.Sprintf("initiated %ssync to target revisions", partial)
+	}
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
@@ -1538,12 +1756,19 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 }
 
 func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*appv1.Application, error) {
-	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
-	a, err := appIf.Get(ctx, *rollbackReq.Name, metav1.GetOptions{})
+	appNs, appName, err := s.resolveAppName(rollbackReq.GetName(), rollbackReq.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	a, err := appIf.Get(ctx, appName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application by name: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, apputil.AppRBACName(*a)); err != nil {
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 	if a.DeletionTimestamp != nil {
@@ -1563,7 +1788,7 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if deploymentInfo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, rollbackReq.GetId())
 	}
-	if deploymentInfo.Source.IsZero() {
+	if len(deploymentInfo.Sources) == 0 && deploymentInfo.Source.IsZero() {
 		// Since source type was introduced to history starting with v0.12, and is now required for
 		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
 		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
@@ -1582,11 +1807,16 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 			Prune:        rollbackReq.GetPrune(),
 			SyncOptions:  syncOptions,
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
-	a, err = argo.SetAppOperation(appIf, *rollbackReq.Name, &op)
+	if len(deploymentInfo.Sources) > 0 {
+		op.Sync.Sources = deploymentInfo.Sources
+		op.Sync.Revisions = deploymentInfo.Revisions
+	} else {
+		op.Sync.Source = &deploymentInfo.Source
+	}
+	a, err = argo.SetAppOperation(appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -1597,7 +1827,8 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 // resolveRevision resolves the revision specified either in the sync request, or the
 // application source, into a concrete revision that will be used for a sync operation.
 func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, syncReq *application.ApplicationSyncRequest) (string, string, error) {
-	if syncReq.Manifests != nil {
+	if syncReq.Manifests != nil || app.Spec.HasMultipleSources() {
+		// revisions of applications with multiple sources are resolved per source by the controller
 		return "", "", nil
 	}
 	ambiguousRevision := syncReq.GetRevision()
@@ -1633,11 +1864,18 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 }
 
 func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.OperationTerminateRequest) (*application.OperationTerminateResponse, error) {
-	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, *termOpReq.Name, metav1.GetOptions{})
+	appNs, appName, err := s.resolveAppName(termOpReq.GetName(), termOpReq.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application by name: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, apputil.AppRBACName(*a)); err != nil {
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 
@@ -1646,7 +1884,7 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
 		}
 		a.Status.OperationState.Phase = common.OperationTerminating
-		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(ctx, a, metav1.UpdateOptions{})
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
 		if err == nil {
 			s.waitSync(updated)
 			s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, "terminated running operation")
@@ -1655,9 +1893,9 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 		if !apierr.IsConflict(err) {
 			return nil, fmt.Errorf("error updating application: %w", err)
 		}
-		log.Warnf("failed to set operation for app %q due to update conflict. retrying again...", *termOpReq.Name)
+		log.Warnf("failed to set operation for app %q due to update conflict. retrying again...", termOpReq.GetName())
 		time.Sleep(100 * time.Millisecond)
-		a, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(ctx, *termOpReq.Name, metav1.GetOptions{})
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("error getting application by name: %w", err)
 		}
@@ -1734,6 +1972,7 @@ func (s *Server) getAvailableActions(resourceOverrides map[string]appv1.Resource
 func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceActionRunRequest) (*application.ApplicationResponse, error) {
 	resourceRequest := &application.ApplicationResourceRequest{
 		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
 		Namespace:    q.Namespace,
 		ResourceName: q.ResourceName,
 		Kind:         q.Kind,
@@ -1870,17 +2109,24 @@ func (s *Server) plugins() ([]*v1alpha1.ConfigManagementPlugin, error) {
 }
 
 func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.ApplicationSyncWindowsQuery) (*application.ApplicationSyncWindowsResponse, error) {
-	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
-	a, err := appIf.Get(ctx, *q.Name, metav1.GetOptions{})
+	appNs, appName, err := s.resolveAppName(q.GetName(), q.GetAppNamespace())
+	if err != nil {
+		return nil, err
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	a, err := appIf.Get(ctx, appName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting application by name: %w", err)
 	}
+	if err := s.checkAppNamespacePermitted(a); err != nil {
+		return nil, err
+	}
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(s.ns, *a)); err != nil {
 		return nil, err
 	}
 
-	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), a.Namespace, s.settingsMgr, s.db, ctx)
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting app project: %w", err)
 	}
@@ -47,8 +47,9 @@ import (
 )
 
 const (
-	testNamespace = "default"
-	fakeRepoURL   = "https://git.com/repo.git"
+	testNamespace    = "default"
+	testAppNamespace = "apps"
+	fakeRepoURL      = "https://git.com/repo.git"
 )
 
 func fakeRepo() *appsv1.Repository {
@@ -201,7 +202,7 @@ func newTestAppServerWithEnforcerConfigure(f func(*rbac.Enforcer), objects ...ru
 		testNamespace,
 		kubeclientset,
 		fakeAppsClientset,
-		factory.Argoproj().V1alpha1().Applications().Lister().Applications(testNamespace),
+		factory.Argoproj().V1alpha1().Applications().Lister(),
 		appInformer,
 		mockRepoClient,
 		nil,
@@ -211,6 +212,7 @@ func newTestAppServerWithEnforcerConfigure(f func(*rbac.Enforcer), objects ...ru
 		sync.NewKeyLock(),
 		settingsMgr,
 		projInformer,
+		[]string{testAppNamespace},
 	)
 	return server.(*Server)
 }
@@ -382,6 +384,113 @@ func TestCreateAppWithDestName(t *testing.T) {
 	assert.Equal(t, app.Spec.Destination.Server, "https://cluster-api.com")
 }
 
+func TestCreateAppInOtherNamespace(t *testing.T) {
+	appsProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps-proj", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:      []string{"*"},
+			Destinations:     []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SourceNamespaces: []string{testAppNamespace},
+		},
+	}
+	appServer := newTestAppServer(appsProj)
+
+	t.Run("Permitted by project", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Namespace = testAppNamespace
+			app.Spec.Project = "apps-proj"
+		})
+		app, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, testAppNamespace, app.Namespace)
+
+		app, err = appServer.Get(context.Background(), &application.ApplicationQuery{Name: pointer.String(testAppNamespace + "/" + testApp.Name)})
+		require.NoError(t, err)
+		assert.Equal(t, testAppNamespace, app.Namespace)
+		assert.Equal(t, "apps-proj", app.Spec.Project)
+
+		app, err = appServer.Get(context.Background(), &application.ApplicationQuery{Name: &testApp.Name, AppNamespace: pointer.String(testAppNamespace)})
+		require.NoError(t, err)
+		assert.Equal(t, testAppNamespace, app.Namespace)
+	})
+
+	t.Run("Not permitted by project", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Name = "other-app"
+			app.Namespace = testAppNamespace
+		})
+		_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not permitted to use project 'default'")
+	})
+
+	t.Run("Namespace not enabled", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Namespace = "forbidden"
+			app.Spec.Project = "apps-proj"
+		})
+		_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestGetAppInNamespaceNotEnabled(t *testing.T) {
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Namespace = "forbidden"
+	})
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.Get(context.Background(), &application.ApplicationQuery{Name: pointer.String("forbidden/" + testApp.Name)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	res, err := appServer.List(context.Background(), &application.ApplicationQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, res.Items)
+}
+
+func TestGetAppInNamespaceNotPermittedByProject(t *testing.T) {
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Namespace = testAppNamespace
+	})
+	appServer := newTestAppServer(testApp)
+	name := pointer.String(testAppNamespace + "/" + testApp.Name)
+
+	_, err := appServer.Get(context.Background(), &application.ApplicationQuery{Name: name})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "is not permitted to use project 'default'")
+
+	_, err = appServer.GetManifests(context.Background(), &application.ApplicationManifestQuery{Name: name})
+	assert.ErrorContains(t, err, "is not permitted to use project 'default'")
+
+	_, err = appServer.Sync(context.Background(), &application.ApplicationSyncRequest{Name: name})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestListAppsInNamespace(t *testing.T) {
+	appServer := newTestAppServer(newTestApp(func(app *appsv1.Application) {
+		app.Name = "abc"
+	}), newTestApp(func(app *appsv1.Application) {
+		app.Name = "abc"
+		app.Namespace = testAppNamespace
+	}), newTestApp(func(app *appsv1.Application) {
+		app.Name = "bcd"
+		app.Namespace = testAppNamespace
+	}))
+
+	res, err := appServer.List(context.Background(), &application.ApplicationQuery{})
+	require.NoError(t, err)
+	var names []string
+	for i := range res.Items {
+		names = append(names, res.Items[i].QualifiedName())
+	}
+	assert.Equal(t, []string{"apps/abc", "default/abc", "apps/bcd"}, names)
+
+	res, err = appServer.List(context.Background(), &application.ApplicationQuery{AppNamespace: pointer.String(testAppNamespace)})
+	require.NoError(t, err)
+	assert.Len(t, res.Items, 2)
+}
+
 func TestUpdateApp(t *testing.T) {
 	testApp := newTestApp()
 	appServer := newTestAppServer(testApp)
@@ -901,7 +1010,7 @@ func TestLogsGetSelectedPod(t *testing.T) {
 // refreshAnnotationRemover runs an infinite loop until it detects and removes refresh annotation or given context is done
 func refreshAnnotationRemover(t *testing.T, ctx context.Context, patched *int32, appServer *Server, appName string, ch chan string) {
 	for ctx.Err() == nil {
-		a, err := appServer.appLister.Get(appName)
+		a, err := appServer.appLister.Applications(testNamespace).Get(appName)
 		require.NoError(t, err)
 		a = a.DeepCopy()
 		if a.GetAnnotations() != nil && a.GetAnnotations()[appsv1.AnnotationKeyRefresh] != "" {
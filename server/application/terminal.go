@@ -21,6 +21,7 @@ import (
 	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	apputil "github.com/argoproj/argo-cd/v2/util/app"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -28,7 +29,10 @@ import (
 )
 
 type terminalHandler struct {
-	appLister         applisters.ApplicationNamespaceLister
+	namespace         string
+	enabledNamespaces []string
+	appLister         applisters.ApplicationLister
+	projLister        applisters.AppProjectNamespaceLister
 	db                db.ArgoDB
 	enf               *rbac.Enforcer
 	cache             *servercache.Cache
@@ -37,10 +41,13 @@ type terminalHandler struct {
 }
 
 // NewHandler returns a new terminal handler.
-func NewHandler(appLister applisters.ApplicationNamespaceLister, db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache,
+func NewHandler(namespace string, enabledNamespaces []string, appLister applisters.ApplicationLister, projLister applisters.AppProjectNamespaceLister, db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache,
 	appResourceTree AppResourceTreeFn, allowedShells []string) *terminalHandler {
 	return &terminalHandler{
+		namespace:         namespace,
+		enabledNamespaces: enabledNamespaces,
 		appLister:         appLister,
+		projLister:        projLister,
 		db:                db,
 		enf:               enf,
 		cache:             cache,
@@ -99,6 +106,10 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	app := q.Get("appName")
 	project := q.Get("projectName")
 	namespace := q.Get("namespace")
+	appNamespace := q.Get("appNamespace")
+	if appNamespace == "" {
+		appNamespace = s.namespace
+	}
 
 	if podName == "" || container == "" || app == "" || project == "" || namespace == "" {
 		http.Error(w, "Missing required parameters", http.StatusBadRequest)
@@ -125,11 +136,22 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Namespace name is not valid", http.StatusBadRequest)
 		return
 	}
+	if !isValidNamespaceName(appNamespace) {
+		http.Error(w, "App namespace name is not valid", http.StatusBadRequest)
+		return
+	}
+	if !apputil.IsAppNamespaceEnabled(appNamespace, s.namespace, s.enabledNamespaces) {
+		http.Error(w, "App namespace is not permitted", http.StatusForbidden)
+		return
+	}
 	shell := q.Get("shell") // No need to validate. Will only be used if it's in the allow-list.
 
 	ctx := r.Context()
 
 	appRBACName := fmt.Sprintf("%s/%s", project, app)
+	if appNamespace != s.namespace {
+		appRBACName = fmt.Sprintf("%s/%s/%s", project, appNamespace, app)
+	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName); err != nil {
 		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
@@ -140,10 +162,10 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	fieldLog := log.WithFields(log.Fields{"application": app, "userName": sessionmgr.Username(ctx), "container": container,
+	fieldLog := log.WithFields(log.Fields{"application": app, "appNamespace": appNamespace, "userName": sessionmgr.Username(ctx), "container": container,
 		"podName": podName, "namespace": namespace, "cluster": project})
 
-	a, err := s.appLister.Get(app)
+	a, err := s.appLister.Applications(appNamespace).Get(app)
 	if err != nil {
 		if apierr.IsNotFound(err) {
 			http.Error(w, "App not found", http.StatusNotFound)
@@ -160,6 +182,14 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if appNamespace != s.namespace {
+		proj, err := s.projLister.Get(project)
+		if err != nil || !proj.IsAppNamespacePermitted(a, s.namespace) {
+			http.Error(w, "App namespace is not permitted by the project", http.StatusForbidden)
+			return
+		}
+	}
+
 	config, err := s.getApplicationClusterRawConfig(ctx, a)
 	if err != nil {
 		http.Error(w, "Cannot get raw cluster config", http.StatusBadRequest)
@@ -0,0 +1,563 @@
+package applicationset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/pkg/sync"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/controllers"
+	"github.com/argoproj/argo-cd/v2/applicationset/generators"
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/session"
+)
+
+const (
+	// ActionCreate means that the application would be created by the application set controller
+	ActionCreate = "create"
+	// ActionUpdate means that the existing application would be updated by the application set controller
+	ActionUpdate = "update"
+	// ActionDelete means that the existing application would be deleted by the application set controller
+	ActionDelete = "delete"
+	// ActionUnchanged means that the existing application already matches the generated application
+	ActionUnchanged = "unchanged"
+)
+
+var (
+	watchAPIBufferSize = env.ParseNumFromEnv(common.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+)
+
+// Server provides an ApplicationSet service
+type Server struct {
+	ns                string
+	kubeclientset     kubernetes.Interface
+	appsetclientset   dynamic.NamespaceableResourceInterface
+	appsetInformer    cache.SharedIndexInformer
+	appsetLister      cache.GenericNamespaceLister
+	appsetBroadcaster *broadcasterHandler
+	appLister         applisters.ApplicationNamespaceLister
+	projLister        applisters.AppProjectNamespaceLister
+	generators        map[string]generators.Generator
+	renderer          utils.Renderer
+	enf               *rbac.Enforcer
+	projectLock       sync.KeyLock
+	auditLogger       *argo.AuditLogger
+}
+
+// NewServer returns a new instance of the ApplicationSet service
+func NewServer(
+	namespace string,
+	kubeclientset kubernetes.Interface,
+	dynamicClientset dynamic.Interface,
+	appsetInformer cache.SharedIndexInformer,
+	appsetLister cache.GenericNamespaceLister,
+	appLister applisters.ApplicationNamespaceLister,
+	projLister applisters.AppProjectNamespaceLister,
+	generators map[string]generators.Generator,
+	enf *rbac.Enforcer,
+	projectLock sync.KeyLock,
+) applicationset.ApplicationSetServiceServer {
+	appsetBroadcaster := &broadcasterHandler{}
+	appsetInformer.AddEventHandler(appsetBroadcaster)
+	return &Server{
+		ns:                namespace,
+		kubeclientset:     kubeclientset,
+		appsetclientset:   dynamicClientset.Resource(v1alpha1.ApplicationSetResource),
+		appsetInformer:    appsetInformer,
+		appsetLister:      appsetLister,
+		appsetBroadcaster: appsetBroadcaster,
+		appLister:         appLister,
+		projLister:        projLister,
+		generators:        generators,
+		renderer:          &utils.Render{},
+		enf:               enf,
+		projectLock:       projectLock,
+		auditLogger:       argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+	}
+}
+
+// waitForCacheSync waits for the initial sync of the application set informer, so that requests received right after
+// the start of the API server are not served from an empty cache
+func (s *Server) waitForCacheSync(ctx context.Context) error {
+	if !cache.WaitForCacheSync(ctx.Done(), s.appsetInformer.HasSynced) {
+		return status.Error(codes.Unavailable, "application set cache is not synced yet")
+	}
+	return nil
+}
+
+// Get returns an application set by name
+func (s *Server) Get(ctx context.Context, q *applicationset.ApplicationSetGetQuery) (*v1alpha1.ApplicationSet, error) {
+	if err := s.waitForCacheSync(ctx); err != nil {
+		return nil, err
+	}
+	obj, err := s.appsetLister.Get(q.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	a, err := toApplicationSet(obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, a.RBACName()); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// List returns list of application sets
+func (s *Server) List(ctx context.Context, q *applicationset.ApplicationSetListQuery) (*v1alpha1.ApplicationSetList, error) {
+	selector, err := labels.Parse(q.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the selector: %w", err)
+	}
+	if err := s.waitForCacheSync(ctx); err != nil {
+		return nil, err
+	}
+	objs, err := s.appsetLister.List(selector)
+	if err != nil {
+		return nil, fmt.Errorf("error listing ApplicationSets with selectors: %w", err)
+	}
+	projects := map[string]bool{}
+	for i := range q.Projects {
+		projects[q.Projects[i]] = true
+	}
+
+	newItems := make([]v1alpha1.ApplicationSet, 0)
+	for _, obj := range objs {
+		a, err := toApplicationSet(obj)
+		if err != nil {
+			return nil, err
+		}
+		if len(projects) > 0 && !projects[a.Spec.Template.Spec.GetProject()] {
+			continue
+		}
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, a.RBACName()) {
+			newItems = append(newItems, *a)
+		}
+	}
+
+	// Sort found application sets by name
+	sort.Slice(newItems, func(i, j int) bool {
+		return newItems[i].Name < newItems[j].Name
+	})
+
+	appsetList := &v1alpha1.ApplicationSetList{
+		ListMeta: metav1.ListMeta{
+			ResourceVersion: s.appsetInformer.LastSyncResourceVersion(),
+		},
+		Items: newItems,
+	}
+	return appsetList, nil
+}
+
+// Create creates an application set
+func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCreateRequest) (*v1alpha1.ApplicationSet, error) {
+	appset := q.GetApplicationset()
+	if appset == nil {
+		return nil, fmt.Errorf("error creating ApplicationSet: ApplicationSet is nil in request")
+	}
+
+	projectName, err := s.validateAppSet(appset)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSet: %w", err)
+	}
+
+	s.projectLock.RLock(projectName)
+	defer s.projectLock.RUnlock(projectName)
+
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionCreate, appset.RBACName()); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.projLister.Get(projectName); err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "ApplicationSet references project %s which does not exist", projectName)
+		}
+		return nil, fmt.Errorf("error getting ApplicationSet's project %q: %w", projectName, err)
+	}
+
+	un, err := toUnstructured(appset)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.appsetclientset.Namespace(s.ns).Create(ctx, un, metav1.CreateOptions{})
+	if err == nil {
+		created, err := toApplicationSet(res)
+		if err != nil {
+			return nil, err
+		}
+		s.logAppSetEvent(created, ctx, argo.EventReasonResourceCreated, "created ApplicationSet")
+		return created, nil
+	}
+	if !apierr.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("error creating ApplicationSet: %w", err)
+	}
+
+	// act idempotent if existing spec matches new spec
+	res, err = s.appsetclientset.Namespace(s.ns).Get(ctx, appset.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to check existing ApplicationSet details: %v", err)
+	}
+	existing, err := toApplicationSet(res)
+	if err != nil {
+		return nil, err
+	}
+	equalSpecs := reflect.DeepEqual(existing.Spec, appset.Spec) &&
+		reflect.DeepEqual(existing.Labels, appset.Labels) &&
+		reflect.DeepEqual(existing.Annotations, appset.Annotations) &&
+		reflect.DeepEqual(existing.Finalizers, appset.Finalizers)
+
+	if equalSpecs {
+		return existing, nil
+	}
+	if !q.Upsert {
+		return nil, status.Errorf(codes.InvalidArgument, "existing ApplicationSet spec is different, use upsert flag to force update")
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, existing.RBACName()); err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionUpdate, appset.RBACName()); err != nil {
+		return nil, err
+	}
+
+	existing.Spec = appset.Spec
+	existing.Labels = appset.Labels
+	existing.Annotations = appset.Annotations
+	existing.Finalizers = appset.Finalizers
+	un, err = toUnstructured(existing)
+	if err != nil {
+		return nil, err
+	}
+	res, err = s.appsetclientset.Namespace(s.ns).Update(ctx, un, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error updating ApplicationSet: %w", err)
+	}
+	updated, err := toApplicationSet(res)
+	if err != nil {
+		return nil, err
+	}
+	s.logAppSetEvent(updated, ctx, argo.EventReasonResourceUpdated, "updated ApplicationSet spec")
+	return updated, nil
+}
+
+// Delete deletes an application set
+func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDeleteRequest) (*applicationset.ApplicationSetResponse, error) {
+	res, err := s.appsetclientset.Namespace(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	appset, err := toApplicationSet(res)
+	if err != nil {
+		return nil, err
+	}
+
+	s.projectLock.RLock(appset.Spec.Template.Spec.GetProject())
+	defer s.projectLock.RUnlock(appset.Spec.Template.Spec.GetProject())
+
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionDelete, appset.RBACName()); err != nil {
+		return nil, err
+	}
+
+	err = s.appsetclientset.Namespace(s.ns).Delete(ctx, q.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error deleting ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(appset, ctx, argo.EventReasonResourceDeleted, "deleted ApplicationSet")
+	return &applicationset.ApplicationSetResponse{}, nil
+}
+
+// Generate renders the applications of an application set and computes the changes which the application set
+// controller would make to the existing applications, without writing anything
+func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetGenerateRequest) (*applicationset.ApplicationSetGenerateResponse, error) {
+	appset := q.GetApplicationSet()
+	if appset == nil {
+		return nil, fmt.Errorf("error generating Applications: ApplicationSet is nil in request")
+	}
+	if _, err := s.validateAppSet(appset); err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSet: %w", err)
+	}
+	// previewing the applications requires the same privileges as creating the application set, since the generators
+	// read the same cluster secrets and repositories
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionCreate, appset.RBACName()); err != nil {
+		return nil, err
+	}
+	appset.Namespace = s.ns
+
+	apps, _, err := controllers.GenerateApplications(*appset, s.generators, s.renderer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error generating Applications: %v", err)
+	}
+	generated := make([]*appv1.Application, len(apps))
+	for i := range apps {
+		apps[i].Namespace = s.ns
+		generated[i] = &apps[i]
+	}
+
+	current, err := s.getOwnedApplications(appset)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planApplications(appset, apps, current)
+	if err != nil {
+		return nil, err
+	}
+	return &applicationset.ApplicationSetGenerateResponse{
+		Applications: generated,
+		Plan:         plan,
+	}, nil
+}
+
+// getOwnedApplications returns the applications which are controlled by the application set with the given name
+func (s *Server) getOwnedApplications(appset *v1alpha1.ApplicationSet) ([]*appv1.Application, error) {
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing Applications: %w", err)
+	}
+	var owned []*appv1.Application
+	for _, app := range apps {
+		owner := metav1.GetControllerOf(app)
+		if owner != nil && owner.APIVersion == v1alpha1.GroupVersion.String() && owner.Kind == "ApplicationSet" && owner.Name == appset.Name {
+			owned = append(owned, app)
+		}
+	}
+	return owned, nil
+}
+
+// planApplications compares the desired applications with the applications currently owned by the application set,
+// in the same way as the application set controller: the fields matched by the ignoreApplicationDifferences of the
+// application set keep their current value, and the changes which its applications sync policy does not allow are not
+// planned. The policy of the controller itself is not known to the API server, and is assumed to allow all changes.
+func planApplications(appset *v1alpha1.ApplicationSet, desired []appv1.Application, current []*appv1.Application) ([]*applicationset.ApplicationSetPlanItem, error) {
+	policy, err := utils.ApplicationSetPolicy(utils.Policies["sync"], appset)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	currentByName := map[string]*appv1.Application{}
+	for _, app := range current {
+		currentByName[app.Name] = app
+	}
+	desiredNames := map[string]bool{}
+	plan := make([]*applicationset.ApplicationSetPlanItem, 0, len(desired))
+	for i := range desired {
+		app := desired[i]
+		desiredNames[app.Name] = true
+		action := ActionCreate
+		if existing, ok := currentByName[app.Name]; ok {
+			action = ActionUnchanged
+			changed, err := applicationChanged(appset, existing, &app)
+			if err != nil {
+				return nil, err
+			}
+			if changed && policy.Update() {
+				action = ActionUpdate
+			}
+		}
+		plan = append(plan, &applicationset.ApplicationSetPlanItem{Name: app.Name, Action: action})
+	}
+	for _, app := range current {
+		if !desiredNames[app.Name] {
+			action := ActionUnchanged
+			if policy.Delete() {
+				action = ActionDelete
+			}
+			plan = append(plan, &applicationset.ApplicationSetPlanItem{Name: app.Name, Action: action})
+		}
+	}
+	sort.SliceStable(plan, func(i, j int) bool {
+		return plan[i].Name < plan[j].Name
+	})
+	return plan, nil
+}
+
+// applicationChanged returns true if the application set controller would update the existing application to the
+// desired one. It copies the same fields as the controller does.
+func applicationChanged(appset *v1alpha1.ApplicationSet, existing, desired *appv1.Application) (bool, error) {
+	updated := existing.DeepCopy()
+	updated.Spec = desired.Spec
+	annotations := map[string]string{}
+	for k, v := range desired.Annotations {
+		annotations[k] = v
+	}
+	// the controller preserves the notifications state of the existing application
+	if state, ok := existing.Annotations[controllers.NotifiedAnnotationKey]; ok {
+		annotations[controllers.NotifiedAnnotationKey] = state
+	}
+	updated.Annotations = annotations
+	updated.Labels = desired.Labels
+	updated.Finalizers = desired.Finalizers
+	if err := utils.ApplyIgnoreDifferences(appset.Spec.IgnoreApplicationDifferences, existing, updated); err != nil {
+		return false, fmt.Errorf("error applying the ignored differences of Application %s: %w", existing.Name, err)
+	}
+	return !utils.EqualApplications(existing, updated), nil
+}
+
+// Watch returns stream of application set change events
+func (s *Server) Watch(q *applicationset.ApplicationSetWatchQuery, ws applicationset.ApplicationSetService_WatchServer) error {
+	logCtx := log.NewEntry(log.New())
+	if q.Name != "" {
+		logCtx = logCtx.WithField("applicationset", q.Name)
+	}
+	projects := map[string]bool{}
+	for i := range q.Projects {
+		projects[q.Projects[i]] = true
+	}
+	claims := ws.Context().Value("claims")
+	selector, err := labels.Parse(q.Selector)
+	if err != nil {
+		return fmt.Errorf("error parsing labels with selectors: %w", err)
+	}
+	minVersion := 0
+	if q.ResourceVersion != "" {
+		if minVersion, err = strconv.Atoi(q.ResourceVersion); err != nil {
+			minVersion = 0
+		}
+	}
+
+	// sendIfPermitted is a helper to send the application set to the client's streaming channel if the
+	// caller has RBAC privileges permissions to view it
+	sendIfPermitted := func(a v1alpha1.ApplicationSet, eventType watch.EventType) {
+		if len(projects) > 0 && !projects[a.Spec.Template.Spec.GetProject()] {
+			return
+		}
+
+		if appsetVersion, err := strconv.Atoi(a.ResourceVersion); err == nil && appsetVersion < minVersion {
+			return
+		}
+		matchedEvent := (q.Name == "" || a.Name == q.Name) && selector.Matches(labels.Set(a.Labels))
+		if !matchedEvent {
+			return
+		}
+
+		if !s.enf.Enforce(claims, rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, a.RBACName()) {
+			// do not emit application sets user does not have accessing
+			return
+		}
+		err := ws.Send(&v1alpha1.ApplicationSetWatchEvent{
+			Type:           eventType,
+			ApplicationSet: a,
+		})
+		if err != nil {
+			logCtx.Warnf("Unable to send stream message: %v", err)
+			return
+		}
+	}
+
+	events := make(chan *v1alpha1.ApplicationSetWatchEvent, watchAPIBufferSize)
+	// Mimic watch API behavior: send ADDED events if no resource version provided
+	if q.ResourceVersion == "" || q.Name != "" {
+		if err := s.waitForCacheSync(ws.Context()); err != nil {
+			return err
+		}
+		objs, err := s.appsetLister.List(selector)
+		if err != nil {
+			return fmt.Errorf("error listing ApplicationSets with selector: %w", err)
+		}
+		appsets := make([]*v1alpha1.ApplicationSet, 0, len(objs))
+		for _, obj := range objs {
+			a, err := toApplicationSet(obj)
+			if err != nil {
+				return err
+			}
+			appsets = append(appsets, a)
+		}
+		sort.Slice(appsets, func(i, j int) bool {
+			return appsets[i].Name < appsets[j].Name
+		})
+		for i := range appsets {
+			sendIfPermitted(*appsets[i], watch.Added)
+		}
+	}
+	unsubscribe := s.appsetBroadcaster.Subscribe(events)
+	defer unsubscribe()
+	for {
+		select {
+		case event := <-events:
+			sendIfPermitted(event.ApplicationSet, event.Type)
+		case <-ws.Context().Done():
+			return nil
+		}
+	}
+}
+
+// validateAppSet checks that the application set can be created through the API and returns the name of its project
+func (s *Server) validateAppSet(appset *v1alpha1.ApplicationSet) (string, error) {
+	if appset.Name == "" {
+		return "", status.Errorf(codes.InvalidArgument, "ApplicationSet name is required")
+	}
+	projectName := appset.Spec.Template.Spec.Project
+	if strings.Contains(projectName, "{{") {
+		return "", status.Errorf(codes.InvalidArgument, "the Argo CD API does not support creating ApplicationSets with a templated project field")
+	}
+	return appset.Spec.Template.Spec.GetProject(), nil
+}
+
+func (s *Server) logAppSetEvent(a *v1alpha1.ApplicationSet, ctx context.Context, reason string, action string) {
+	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	message := fmt.Sprintf("%s %s", user, action)
+	s.auditLogger.LogAppSetEvent(a, eventInfo, message)
+}
+
+// toApplicationSet converts an object returned by the dynamic client or informer to an application set
+func toApplicationSet(obj interface{}) (*v1alpha1.ApplicationSet, error) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+	data, err := un.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet %s: %w", un.GetName(), err)
+	}
+	var appset v1alpha1.ApplicationSet
+	if err := json.Unmarshal(data, &appset); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ApplicationSet %s: %w", un.GetName(), err)
+	}
+	return &appset, nil
+}
+
+// toUnstructured converts an application set to the unstructured object expected by the dynamic client
+func toUnstructured(appset *v1alpha1.ApplicationSet) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(appset)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet %s: %w", appset.Name, err)
+	}
+	un := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, &un.Object); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ApplicationSet %s: %w", appset.Name, err)
+	}
+	un.SetGroupVersionKind(v1alpha1.GroupVersion.WithKind("ApplicationSet"))
+	return un, nil
+}
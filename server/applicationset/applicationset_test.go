@@ -0,0 +1,505 @@
+package applicationset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/pkg/sync"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8scache "k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/generators"
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+const testNamespace = "default"
+
+func newTestAppSet(opts ...func(appset *v1alpha1.ApplicationSet)) *v1alpha1.ApplicationSet {
+	appset := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-appset",
+			Namespace: testNamespace,
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{},
+			}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{cluster}}-guestbook",
+				},
+				Spec: appsv1.ApplicationSpec{
+					Project: "default",
+					Source: appsv1.ApplicationSource{
+						RepoURL: "https://github.com/argoproj/argocd-example-apps.git",
+						Path:    "guestbook",
+					},
+					Destination: appsv1.ApplicationDestination{
+						Server:    "{{url}}",
+						Namespace: "guestbook",
+					},
+				},
+			},
+		},
+	}
+	for i := range opts {
+		opts[i](appset)
+	}
+	return appset
+}
+
+func newTestAppSetServer(appsets ...*v1alpha1.ApplicationSet) *Server {
+	return newTestAppSetServerWithApps(appsets, nil)
+}
+
+func newTestAppSetServerWithApps(appsets []*v1alpha1.ApplicationSet, apps []*appsv1.Application) *Server {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	return newTestAppSetServerWithEnforcerConfigure(f, appsets, apps...)
+}
+
+func newTestAppSetServerWithEnforcerConfigure(f func(*rbac.Enforcer), appsets []*v1alpha1.ApplicationSet, apps ...*appsv1.Application) *Server {
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	})
+	ctx := context.Background()
+
+	defaultProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	myProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	objects := []runtime.Object{defaultProj, myProj}
+	for i := range apps {
+		objects = append(objects, apps[i])
+	}
+	fakeAppsClientset := appclientset.NewSimpleClientset(objects...)
+	factory := appinformer.NewSharedInformerFactoryWithOptions(fakeAppsClientset, 0, appinformer.WithNamespace(""))
+	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	appInformer := factory.Argoproj().V1alpha1().Applications().Informer()
+	go projInformer.Run(ctx.Done())
+	go appInformer.Run(ctx.Done())
+	if !k8scache.WaitForCacheSync(ctx.Done(), projInformer.HasSynced, appInformer.HasSynced) {
+		panic("Timed out waiting for caches to sync")
+	}
+	fakeProjLister := factory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(testNamespace)
+	fakeAppLister := factory.Argoproj().V1alpha1().Applications().Lister().Applications(testNamespace)
+
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	f(enforcer)
+	enforcer.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(enforcer, fakeProjLister).EnforceClaims)
+
+	var appsetObjects []runtime.Object
+	for i := range appsets {
+		un, err := toUnstructured(appsets[i])
+		if err != nil {
+			panic(err)
+		}
+		appsetObjects = append(appsetObjects, un)
+	}
+	dynamicClientset := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		v1alpha1.ApplicationSetResource: "ApplicationSetList",
+	}, appsetObjects...)
+	dynamicFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClientset, 0, testNamespace, nil)
+	appsetInformer := dynamicFactory.ForResource(v1alpha1.ApplicationSetResource).Informer()
+	appsetLister := dynamicFactory.ForResource(v1alpha1.ApplicationSetResource).Lister().ByNamespace(testNamespace)
+	go appsetInformer.Run(ctx.Done())
+	if !k8scache.WaitForCacheSync(ctx.Done(), appsetInformer.HasSynced) {
+		panic("Timed out waiting for caches to sync")
+	}
+
+	server := NewServer(
+		testNamespace,
+		kubeclientset,
+		dynamicClientset,
+		appsetInformer,
+		appsetLister,
+		fakeAppLister,
+		fakeProjLister,
+		map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		enforcer,
+		sync.NewKeyLock(),
+	)
+	return server.(*Server)
+}
+
+func TestListAppSets(t *testing.T) {
+	appset1 := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+		appset.Name = "appset1"
+		appset.Labels = map[string]string{"key1": "value1"}
+	})
+	appset2 := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+		appset.Name = "appset2"
+		appset.Spec.Template.Spec.Project = "my-proj"
+	})
+	appset3 := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+		appset.Name = "appset3"
+		appset.Labels = map[string]string{"key1": "value2"}
+	})
+	appSetServer := newTestAppSetServer(appset1, appset2, appset3)
+
+	t.Run("List all", func(t *testing.T) {
+		res, err := appSetServer.List(context.Background(), &applicationset.ApplicationSetListQuery{})
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		assert.Equal(t, []string{"appset1", "appset2", "appset3"}, names)
+	})
+
+	t.Run("List by project", func(t *testing.T) {
+		res, err := appSetServer.List(context.Background(), &applicationset.ApplicationSetListQuery{Projects: []string{"my-proj"}})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "appset2", res.Items[0].Name)
+	})
+
+	t.Run("List by selector", func(t *testing.T) {
+		res, err := appSetServer.List(context.Background(), &applicationset.ApplicationSetListQuery{Selector: "key1=value2"})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "appset3", res.Items[0].Name)
+	})
+}
+
+func TestListAppSetsFiltersByRBAC(t *testing.T) {
+	appset1 := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+		appset.Name = "appset1"
+	})
+	appset2 := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+		appset.Name = "appset2"
+		appset.Spec.Template.Spec.Project = "my-proj"
+	})
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetUserPolicy(`
+p, role:test, applicationsets, get, my-proj/*, allow
+g, test-group, role:test
+`)
+	}
+	appSetServer := newTestAppSetServerWithEnforcerConfigure(f, []*v1alpha1.ApplicationSet{appset1, appset2})
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"test-group"}})
+
+	res, err := appSetServer.List(ctx, &applicationset.ApplicationSetListQuery{})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, "appset2", res.Items[0].Name)
+}
+
+func TestGetAppSet(t *testing.T) {
+	appset := newTestAppSet()
+
+	t.Run("Get existing", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appset)
+		res, err := appSetServer.Get(context.Background(), &applicationset.ApplicationSetGetQuery{Name: "test-appset"})
+		require.NoError(t, err)
+		assert.Equal(t, "test-appset", res.Name)
+		assert.Equal(t, "default", res.Spec.Template.Spec.Project)
+	})
+
+	t.Run("Get missing", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appset)
+		_, err := appSetServer.Get(context.Background(), &applicationset.ApplicationSetGetQuery{Name: "missing"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Get without permission", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appset)
+		appSetServer.enf.SetDefaultRole("")
+		_ = appSetServer.enf.SetBuiltinPolicy(`p, admin, applicationsets, get, my-proj/*, allow`)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: "admin"})
+		_, err := appSetServer.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: "test-appset"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Get before the cache is synced", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(appset)
+		dynamicClientset := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			v1alpha1.ApplicationSetResource: "ApplicationSetList",
+		})
+		// the informer is never started, so its cache is never synced
+		appSetServer.appsetInformer = dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClientset, 0, testNamespace, nil).ForResource(v1alpha1.ApplicationSetResource).Informer()
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		_, err := appSetServer.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: "test-appset"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+func TestCreateAppSet(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		res, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: newTestAppSet()})
+		require.NoError(t, err)
+		assert.Equal(t, "test-appset", res.Name)
+		assert.Equal(t, "default", res.Spec.Template.Spec.Project)
+	})
+
+	t.Run("Create is idempotent for identical spec", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(newTestAppSet())
+		res, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: newTestAppSet()})
+		require.NoError(t, err)
+		assert.Equal(t, "test-appset", res.Name)
+	})
+
+	t.Run("Create with different spec requires upsert", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(newTestAppSet())
+		updated := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+			appset.Spec.Template.Spec.Source.Path = "helm-guestbook"
+		})
+		_, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: updated})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		res, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: updated, Upsert: true})
+		require.NoError(t, err)
+		assert.Equal(t, "helm-guestbook", res.Spec.Template.Spec.Source.Path)
+	})
+
+	t.Run("Create with missing project", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		appset := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+			appset.Spec.Template.Spec.Project = "missing"
+		})
+		_, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: appset})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Create with templated project", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		appset := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+			appset.Spec.Template.Spec.Project = "{{project}}"
+		})
+		_, err := appSetServer.Create(context.Background(), &applicationset.ApplicationSetCreateRequest{Applicationset: appset})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "templated project")
+	})
+
+	t.Run("Create without permission", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		appSetServer.enf.SetDefaultRole("")
+		_ = appSetServer.enf.SetBuiltinPolicy(`p, admin, applicationsets, create, my-proj/*, allow`)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: "admin"})
+		_, err := appSetServer.Create(ctx, &applicationset.ApplicationSetCreateRequest{Applicationset: newTestAppSet()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestDeleteAppSet(t *testing.T) {
+	t.Run("Delete", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(newTestAppSet())
+		_, err := appSetServer.Delete(context.Background(), &applicationset.ApplicationSetDeleteRequest{Name: "test-appset"})
+		require.NoError(t, err)
+
+		_, err = appSetServer.appsetclientset.Namespace(testNamespace).Get(context.Background(), "test-appset", metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Delete without permission", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(newTestAppSet())
+		appSetServer.enf.SetDefaultRole("")
+		_ = appSetServer.enf.SetBuiltinPolicy(`p, admin, applicationsets, get, default/*, allow`)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: "admin"})
+		_, err := appSetServer.Delete(ctx, &applicationset.ApplicationSetDeleteRequest{Name: "test-appset"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func newTestOwnedApp(name string, opts ...func(app *appsv1.Application)) *appsv1.Application {
+	controller := true
+	app := &appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: v1alpha1.GroupVersion.String(),
+				Kind:       "ApplicationSet",
+				Name:       "test-appset",
+				Controller: &controller,
+			}},
+			Finalizers: []string{"resources-finalizer.argocd.argoproj.io"},
+		},
+		Spec: appsv1.ApplicationSpec{
+			Project: "default",
+			Source: appsv1.ApplicationSource{
+				RepoURL: "https://github.com/argoproj/argocd-example-apps.git",
+				Path:    "guestbook",
+			},
+			Destination: appsv1.ApplicationDestination{
+				Server:    "https://kubernetes.default.svc",
+				Namespace: "guestbook",
+			},
+		},
+	}
+	for i := range opts {
+		opts[i](app)
+	}
+	return app
+}
+
+func TestGenerateAppSet(t *testing.T) {
+	appset := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+		appset.Spec.Generators = []v1alpha1.ApplicationSetGenerator{{
+			List: &v1alpha1.ListGenerator{
+				Elements: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"cluster": "in-cluster", "url": "https://kubernetes.default.svc"}`)},
+					{Raw: []byte(`{"cluster": "staging", "url": "https://kubernetes.default.svc"}`)},
+					{Raw: []byte(`{"cluster": "production", "url": "https://kubernetes.default.svc"}`)},
+				},
+			},
+		}}
+	})
+
+	t.Run("Generate new ApplicationSet", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		res, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset.DeepCopy()})
+		require.NoError(t, err)
+		require.Len(t, res.Applications, 3)
+		assert.Equal(t, "in-cluster-guestbook", res.Applications[0].Name)
+		assert.Equal(t, testNamespace, res.Applications[0].Namespace)
+		assert.Equal(t, "https://kubernetes.default.svc", res.Applications[0].Spec.Destination.Server)
+		assert.Equal(t, []*applicationset.ApplicationSetPlanItem{
+			{Name: "in-cluster-guestbook", Action: ActionCreate},
+			{Name: "production-guestbook", Action: ActionCreate},
+			{Name: "staging-guestbook", Action: ActionCreate},
+		}, res.Plan)
+
+		// nothing is written to the cluster
+		_, err = appSetServer.appsetclientset.Namespace(testNamespace).Get(context.Background(), appset.Name, metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Generate existing ApplicationSet", func(t *testing.T) {
+		apps := []*appsv1.Application{
+			newTestOwnedApp("in-cluster-guestbook"),
+			newTestOwnedApp("staging-guestbook", func(app *appsv1.Application) {
+				app.Spec.Source.Path = "helm-guestbook"
+			}),
+			newTestOwnedApp("removed-guestbook"),
+			newTestOwnedApp("unowned-guestbook", func(app *appsv1.Application) {
+				app.OwnerReferences = nil
+			}),
+		}
+		appSetServer := newTestAppSetServerWithApps([]*v1alpha1.ApplicationSet{appset}, apps)
+		res, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset.DeepCopy()})
+		require.NoError(t, err)
+		assert.Len(t, res.Applications, 3)
+		assert.Equal(t, []*applicationset.ApplicationSetPlanItem{
+			{Name: "in-cluster-guestbook", Action: ActionUnchanged},
+			{Name: "production-guestbook", Action: ActionCreate},
+			{Name: "removed-guestbook", Action: ActionDelete},
+			{Name: "staging-guestbook", Action: ActionUpdate},
+		}, res.Plan)
+	})
+
+	t.Run("Generate existing ApplicationSet with create-only policy", func(t *testing.T) {
+		createOnly := appset.DeepCopy()
+		policy := v1alpha1.ApplicationsSyncPolicyCreateOnly
+		createOnly.Spec.SyncPolicy = &v1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &policy}
+		apps := []*appsv1.Application{
+			newTestOwnedApp("staging-guestbook", func(app *appsv1.Application) {
+				app.Spec.Source.Path = "helm-guestbook"
+			}),
+			newTestOwnedApp("removed-guestbook"),
+		}
+		appSetServer := newTestAppSetServerWithApps([]*v1alpha1.ApplicationSet{createOnly}, apps)
+		res, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: createOnly.DeepCopy()})
+		require.NoError(t, err)
+		assert.Equal(t, []*applicationset.ApplicationSetPlanItem{
+			{Name: "in-cluster-guestbook", Action: ActionCreate},
+			{Name: "production-guestbook", Action: ActionCreate},
+			{Name: "removed-guestbook", Action: ActionUnchanged},
+			{Name: "staging-guestbook", Action: ActionUnchanged},
+		}, res.Plan)
+	})
+
+	t.Run("Generate existing ApplicationSet with ignored differences", func(t *testing.T) {
+		ignoring := appset.DeepCopy()
+		ignoring.Spec.IgnoreApplicationDifferences = []v1alpha1.ApplicationSetResourceIgnoreDifferences{
+			{JSONPointers: []string{"/spec/source/path"}},
+		}
+		apps := []*appsv1.Application{
+			newTestOwnedApp("in-cluster-guestbook"),
+			newTestOwnedApp("staging-guestbook", func(app *appsv1.Application) {
+				app.Spec.Source.Path = "helm-guestbook"
+			}),
+			newTestOwnedApp("production-guestbook", func(app *appsv1.Application) {
+				app.Spec.Source.TargetRevision = "v1.0.0"
+			}),
+		}
+		appSetServer := newTestAppSetServerWithApps([]*v1alpha1.ApplicationSet{ignoring}, apps)
+		res, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: ignoring.DeepCopy()})
+		require.NoError(t, err)
+		assert.Equal(t, []*applicationset.ApplicationSetPlanItem{
+			{Name: "in-cluster-guestbook", Action: ActionUnchanged},
+			{Name: "production-guestbook", Action: ActionUpdate},
+			{Name: "staging-guestbook", Action: ActionUnchanged},
+		}, res.Plan)
+	})
+
+	t.Run("Generate without permission", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		appSetServer.enf.SetDefaultRole("")
+		_ = appSetServer.enf.SetBuiltinPolicy(`p, admin, applicationsets, get, default/*, allow`)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: "admin"})
+		_, err := appSetServer.Generate(ctx, &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset.DeepCopy()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Generate with invalid generator", func(t *testing.T) {
+		appSetServer := newTestAppSetServer()
+		invalid := newTestAppSet(func(appset *v1alpha1.ApplicationSet) {
+			appset.Spec.Generators = []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{
+					Elements: []apiextensionsv1.JSON{{Raw: []byte(`invalid`)}},
+				},
+			}}
+		})
+		_, err := appSetServer.Generate(context.Background(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: invalid})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,92 @@
+package applicationset
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
+)
+
+type subscriber struct {
+	ch      chan *v1alpha1.ApplicationSetWatchEvent
+	filters []func(*v1alpha1.ApplicationSetWatchEvent) bool
+}
+
+func (s *subscriber) matches(event *v1alpha1.ApplicationSetWatchEvent) bool {
+	for i := range s.filters {
+		if !s.filters[i](event) {
+			return false
+		}
+	}
+	return true
+}
+
+type broadcasterHandler struct {
+	lock        sync.Mutex
+	subscribers []*subscriber
+}
+
+func (b *broadcasterHandler) notify(event *v1alpha1.ApplicationSetWatchEvent) {
+	// Make a local copy of b.subscribers, then send channel events outside the lock,
+	// to avoid data race on b.subscribers changes
+	subscribers := []*subscriber{}
+	b.lock.Lock()
+	subscribers = append(subscribers, b.subscribers...)
+	b.lock.Unlock()
+
+	for _, s := range subscribers {
+		if s.matches(event) {
+			select {
+			case s.ch <- event:
+			default:
+				// drop event if cannot send right away
+				log.WithField("applicationset", event.ApplicationSet.Name).Warn("unable to send event notification")
+			}
+		}
+	}
+}
+
+// Subscribe forward application set informer watch events to the provided channel.
+// The watch events are dropped if no receives are reading events from the channel so the channel must have
+// buffer if dropping events is not acceptable.
+func (b *broadcasterHandler) Subscribe(ch chan *v1alpha1.ApplicationSetWatchEvent, filters ...func(event *v1alpha1.ApplicationSetWatchEvent) bool) func() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	subscriber := &subscriber{ch, filters}
+	b.subscribers = append(b.subscribers, subscriber)
+	return func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		for i := range b.subscribers {
+			if b.subscribers[i] == subscriber {
+				b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func (b *broadcasterHandler) OnAdd(obj interface{}) {
+	b.notifyObject(obj, watch.Added)
+}
+
+func (b *broadcasterHandler) OnUpdate(_, newObj interface{}) {
+	b.notifyObject(newObj, watch.Modified)
+}
+
+func (b *broadcasterHandler) OnDelete(obj interface{}) {
+	b.notifyObject(obj, watch.Deleted)
+}
+
+// notifyObject converts the unstructured object of the dynamic informer to an application set and notifies
+// the subscribers
+func (b *broadcasterHandler) notifyObject(obj interface{}, eventType watch.EventType) {
+	appset, err := toApplicationSet(obj)
+	if err != nil {
+		log.Warnf("unable to convert informer object to application set: %v", err)
+		return
+	}
+	b.notify(&v1alpha1.ApplicationSetWatchEvent{ApplicationSet: *appset, Type: eventType})
+}
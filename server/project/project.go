@@ -26,6 +26,7 @@ import (
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	listersv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	apputil "github.com/argoproj/argo-cd/v2/util/app"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -51,14 +52,39 @@ type Server struct {
 	projInformer  cache.SharedIndexInformer
 	settingsMgr   *settings.SettingsManager
 	db            db.ArgoDB
+	// enabledNamespaces are the namespaces other than ns in which applications are allowed
+	enabledNamespaces []string
 }
 
 // NewServer returns a new instance of the Project service
 func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, enf *rbac.Enforcer, projectLock sync.KeyLock, sessionMgr *session.SessionManager, policyEnf *rbacpolicy.RBACPolicyEnforcer,
-	projInformer cache.SharedIndexInformer, settingsMgr *settings.SettingsManager, db db.ArgoDB) *Server {
+	projInformer cache.SharedIndexInformer, settingsMgr *settings.SettingsManager, db db.ArgoDB, enabledNamespaces []string) *Server {
 	auditLogger := argo.NewAuditLogger(ns, kubeclientset, "argocd-server")
 	return &Server{enf: enf, policyEnf: policyEnf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr,
-		projInformer: projInformer, settingsMgr: settingsMgr, db: db}
+		projInformer: projInformer, settingsMgr: settingsMgr, db: db, enabledNamespaces: enabledNamespaces}
+}
+
+// listApplications lists the applications in the control plane namespace and in all other namespaces in which
+// applications are allowed
+func (s *Server) listApplications(ctx context.Context) ([]v1alpha1.Application, error) {
+	if len(s.enabledNamespaces) == 0 {
+		appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return appsList.Items, nil
+	}
+	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var apps []v1alpha1.Application
+	for _, a := range appsList.Items {
+		if apputil.IsAppNamespaceEnabled(a.Namespace, s.ns, s.enabledNamespaces) {
+			apps = append(apps, a)
+		}
+	}
+	return apps, nil
 }
 
 func validateProject(proj *v1alpha1.AppProject) error {
@@ -329,7 +355,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		}
 	}
 
-	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
+	appsList, err := s.listApplications(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -337,8 +363,8 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	var srcValidatedApps []v1alpha1.Application
 	var dstValidatedApps []v1alpha1.Application
 
-	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Project.Name}) {
-		if oldProj.IsSourcePermitted(a.Spec.Source) {
+	for _, a := range argo.FilterByProjects(appsList, []string{q.Project.Name}) {
+		if isAppSourcePermitted(oldProj, a) {
 			srcValidatedApps = append(srcValidatedApps, a)
 		}
 		if oldProj.IsDestinationPermitted(a.Spec.Destination) {
@@ -350,7 +376,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	invalidDstCount := 0
 
 	for _, a := range srcValidatedApps {
-		if !q.Project.IsSourcePermitted(a.Spec.Source) {
+		if !isAppSourcePermitted(q.Project, a) {
 			invalidSrcCount++
 		}
 	}
@@ -378,6 +404,16 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	return res, err
 }
 
+// isAppSourcePermitted returns true if all sources of the application are permitted in the project
+func isAppSourcePermitted(proj *v1alpha1.AppProject, a v1alpha1.Application) bool {
+	for _, source := range a.Spec.GetSources() {
+		if !proj.IsSourcePermitted(source) {
+			return false
+		}
+	}
+	return true
+}
+
 // Delete deletes a project
 func (s *Server) Delete(ctx context.Context, q *project.ProjectQuery) (*project.EmptyResponse, error) {
 	if q.Name == v1alpha1.DefaultAppProjectName {
@@ -395,11 +431,11 @@ func (s *Server) Delete(ctx context.Context, q *project.ProjectQuery) (*project.
 		return nil, err
 	}
 
-	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
+	appsList, err := s.listApplications(ctx)
 	if err != nil {
 		return nil, err
 	}
-	apps := argo.FilterByProjects(appsList.Items, []string{q.Name})
+	apps := argo.FilterByProjects(appsList, []string{q.Name})
 	if len(apps) > 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "project is referenced by %d applications", len(apps))
 	}
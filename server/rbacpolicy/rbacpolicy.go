@@ -14,15 +14,16 @@ import (
 
 const (
 	// please add new items to Resources
-	ResourceClusters     = "clusters"
-	ResourceProjects     = "projects"
-	ResourceApplications = "applications"
-	ResourceRepositories = "repositories"
-	ResourceCertificates = "certificates"
-	ResourceAccounts     = "accounts"
-	ResourceGPGKeys      = "gpgkeys"
-	ResourceLogs         = "logs"
-	ResourceExec         = "exec"
+	ResourceClusters        = "clusters"
+	ResourceProjects        = "projects"
+	ResourceApplications    = "applications"
+	ResourceApplicationSets = "applicationsets"
+	ResourceRepositories    = "repositories"
+	ResourceCertificates    = "certificates"
+	ResourceAccounts        = "accounts"
+	ResourceGPGKeys         = "gpgkeys"
+	ResourceLogs            = "logs"
+	ResourceExec            = "exec"
 
 	// please add new items to Actions
 	ActionGet      = "get"
@@ -40,6 +41,7 @@ var (
 		ResourceClusters,
 		ResourceProjects,
 		ResourceApplications,
+		ResourceApplicationSets,
 		ResourceRepositories,
 		ResourceCertificates,
 		ResourceLogs,
@@ -171,7 +173,7 @@ func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...interface{}) *v1alph
 	if res, ok := rvals[1].(string); ok {
 		if obj, ok := rvals[3].(string); ok {
 			switch res {
-			case ResourceApplications, ResourceRepositories, ResourceClusters, ResourceLogs, ResourceExec:
+			case ResourceApplications, ResourceApplicationSets, ResourceRepositories, ResourceClusters, ResourceLogs, ResourceExec:
 				if objSplit := strings.Split(obj, "/"); len(objSplit) >= 2 {
 					return getProjectByName(objSplit[0])
 				}
@@ -552,8 +552,10 @@ func (s *Server) isRepoPermittedInProject(repo string, projName string) error {
 // isSourceInHistory checks if the supplied application source is either our current application
 // source, or was something which we synced to previously.
 func isSourceInHistory(app *v1alpha1.Application, source v1alpha1.ApplicationSource) bool {
-	if source.Equals(app.Spec.Source) {
-		return true
+	for _, appSource := range app.Spec.GetSources() {
+		if source.Equals(appSource) {
+			return true
+		}
 	}
 	// Iterate history. When comparing items in our history, use the actual synced revision to
 	// compare with the supplied source.targetRevision in the request. This is because
@@ -564,6 +566,14 @@ func isSourceInHistory(app *v1alpha1.Application, source v1alpha1.ApplicationSou
 		if source.Equals(h.Source) {
 			return true
 		}
+		for i, historySource := range h.Sources {
+			if i < len(h.Revisions) {
+				historySource.TargetRevision = h.Revisions[i]
+			}
+			if source.Equals(historySource) {
+				return true
+			}
+		}
 	}
 	return false
 }
@@ -2,10 +2,41 @@ package app
 
 import (
 	"fmt"
+	"strings"
+
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 )
 
-// AppRBACName formats fully qualified application name for RBAC check
-func AppRBACName(app appv1.Application) string {
+// AppRBACName formats fully qualified application name for RBAC check. Applications outside of the control plane
+// namespace are qualified with their namespace, i.e. <project>/<namespace>/<name>
+func AppRBACName(controlPlaneNamespace string, app appv1.Application) string {
+	if app.Namespace != "" && app.Namespace != controlPlaneNamespace {
+		return fmt.Sprintf("%s/%s/%s", app.Spec.GetProject(), app.Namespace, app.Name)
+	}
 	return fmt.Sprintf("%s/%s", app.Spec.GetProject(), app.Name)
 }
+
+// IsAppNamespaceEnabled returns whether applications in the given namespace are handled, which is the case for the
+// control plane namespace and namespaces matching one of the enabled namespace patterns
+func IsAppNamespaceEnabled(namespace, controlPlaneNamespace string, enabledNamespaces []string) bool {
+	if namespace == controlPlaneNamespace {
+		return true
+	}
+	for _, pattern := range enabledNamespaces {
+		if glob.Match(pattern, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseAppQualifiedName parses an application name which is optionally qualified with its namespace, i.e.
+// <namespace>/<name>, and returns the namespace and the name of the application. The default namespace is returned if
+// the name is not qualified.
+func ParseAppQualifiedName(qualifiedName, defaultNamespace string) (string, string) {
+	if parts := strings.SplitN(qualifiedName, "/", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return defaultNamespace, qualifiedName
+}
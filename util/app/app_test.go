@@ -0,0 +1,42 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestAppRBACName(t *testing.T) {
+	app := appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       appv1.ApplicationSpec{Project: "team"},
+	}
+	assert.Equal(t, "team/guestbook", AppRBACName("argocd", app))
+
+	app.Namespace = "team-apps"
+	assert.Equal(t, "team/team-apps/guestbook", AppRBACName("argocd", app))
+
+	app.Spec.Project = ""
+	assert.Equal(t, "default/team-apps/guestbook", AppRBACName("argocd", app))
+}
+
+func TestIsAppNamespaceEnabled(t *testing.T) {
+	assert.True(t, IsAppNamespaceEnabled("argocd", "argocd", nil))
+	assert.False(t, IsAppNamespaceEnabled("team-apps", "argocd", nil))
+	assert.True(t, IsAppNamespaceEnabled("team-apps", "argocd", []string{"team-apps"}))
+	assert.True(t, IsAppNamespaceEnabled("team-apps", "argocd", []string{"other", "team-*"}))
+	assert.False(t, IsAppNamespaceEnabled("other-apps", "argocd", []string{"team-*"}))
+}
+
+func TestParseAppQualifiedName(t *testing.T) {
+	namespace, name := ParseAppQualifiedName("guestbook", "argocd")
+	assert.Equal(t, "argocd", namespace)
+	assert.Equal(t, "guestbook", name)
+
+	namespace, name = ParseAppQualifiedName("team-apps/guestbook", "argocd")
+	assert.Equal(t, "team-apps", namespace)
+	assert.Equal(t, "guestbook", name)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
@@ -68,8 +69,11 @@ func FilterByRepo(apps []argoappv1.Application, repo string) []argoappv1.Applica
 	}
 	items := make([]argoappv1.Application, 0)
 	for i := 0; i < len(apps); i++ {
-		if apps[i].Spec.Source.RepoURL == repo {
-			items = append(items, apps[i])
+		for _, source := range apps[i].Spec.GetSources() {
+			if source.RepoURL == repo {
+				items = append(items, apps[i])
+				break
+			}
 		}
 	}
 	return items
@@ -119,10 +123,12 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType ar
 	return nil, err
 }
 
-func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool) error {
+func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool, isOCI bool) error {
 	repo = repo.DeepCopy()
 	if isHelm {
 		repo.Type = "helm"
+	} else if isOCI {
+		repo.Type = "oci"
 	} else {
 		repo.Type = "git"
 	}
@@ -159,27 +165,31 @@ func ValidateRepo(
 		return nil, err
 	}
 	defer io.Close(conn)
-	repo, err := db.GetRepository(ctx, spec.Source.RepoURL)
-	if err != nil {
-		return nil, err
-	}
 
-	repoAccessible := false
+	sources := spec.GetSources()
+	repos := make([]*argoappv1.Repository, len(sources))
+	repoAccessible := true
+	for i, source := range sources {
+		repo, err := db.GetRepository(ctx, source.RepoURL)
+		if err != nil {
+			return nil, err
+		}
+		repos[i] = repo
 
-	err = TestRepoWithKnownType(ctx, repoClient, repo, app.Spec.Source.IsHelm(), app.Spec.Source.IsHelmOci())
-	if err != nil {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("repository not accessible: %v", err),
-		})
-	} else {
-		repoAccessible = true
-	}
+		err = TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci(), source.IsOCI())
+		if err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("repository not accessible: %v", err),
+			})
+			repoAccessible = false
+		}
 
-	// Verify only one source type is defined
-	_, err = spec.Source.ExplicitType()
-	if err != nil {
-		return nil, err
+		// Verify only one source type is defined
+		_, err = source.ExplicitType()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// is the repo inaccessible - abort now
@@ -232,7 +242,7 @@ func ValidateRepo(
 	}
 	conditions = append(conditions, verifyGenerateManifests(
 		ctx,
-		repo,
+		repos,
 		permittedHelmRepos,
 		helmOptions,
 		app,
@@ -277,29 +287,95 @@ func ValidateDestination(ctx context.Context, dest *argoappv1.ApplicationDestina
 	return nil
 }
 
+// validateHydration returns why the hydration settings of the given application spec are invalid or not permitted in
+// the given project, if they are. Whether the target branch is the branch of the source revision can only be decided
+// after resolving the revision, which the repo server does before committing the manifests.
+func validateHydration(spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject) string {
+	hydration := spec.Hydration
+	switch {
+	case spec.HasMultipleSources():
+		return "spec.hydration is not supported for applications with multiple sources"
+	case spec.Source.IsHelm() || spec.Source.IsOCI():
+		return "spec.hydration requires the application source to be a Git repository"
+	case hydration.TargetBranch == "":
+		return "spec.hydration.targetBranch is required"
+	case hydration.TargetBranch == strings.TrimPrefix(spec.Source.TargetRevision, "refs/heads/"):
+		return "spec.hydration.targetBranch must differ from spec.source.targetRevision"
+	case !proj.IsHydrationTargetBranchPermitted(hydration.TargetBranch):
+		return fmt.Sprintf("hydration to branch %s is not permitted in project '%s'", hydration.TargetBranch, spec.Project)
+	}
+	path := hydration.GetPath(spec.Source)
+	if filepath.IsAbs(path) || strings.HasPrefix(filepath.Clean(path), "..") {
+		return fmt.Sprintf("spec.hydration.path %s must be a relative path within the repository", path)
+	}
+	return ""
+}
+
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
-	if spec.Source.RepoURL == "" || (spec.Source.Path == "" && spec.Source.Chart == "") {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.repoURL and spec.source.path either spec.source.chart are required",
-		})
-		return conditions, nil
+	if spec.HasMultipleSources() {
+		refs := map[string]bool{}
+		for i, source := range spec.Sources {
+			if source.RepoURL == "" || (source.Path == "" && source.Chart == "" && source.Ref == "") {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.sources[%d].repoURL and either spec.sources[%d].path, spec.sources[%d].chart or spec.sources[%d].ref are required", i, i, i, i),
+				})
+				return conditions, nil
+			}
+			if source.Chart != "" && source.TargetRevision == "" {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.sources[%d].targetRevision is required if the manifest source is a helm chart", i),
+				})
+				return conditions, nil
+			}
+			if source.Ref != "" {
+				if refs[source.Ref] {
+					conditions = append(conditions, argoappv1.ApplicationCondition{
+						Type:    argoappv1.ApplicationConditionInvalidSpecError,
+						Message: fmt.Sprintf("spec.sources[%d].ref %s is used by more than one source", i, source.Ref),
+					})
+					return conditions, nil
+				}
+				refs[source.Ref] = true
+			}
+		}
+	} else {
+		if spec.Source.RepoURL == "" || (spec.Source.Path == "" && spec.Source.Chart == "") {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.source.repoURL and spec.source.path either spec.source.chart are required",
+			})
+			return conditions, nil
+		}
+		if spec.Source.Chart != "" && spec.Source.TargetRevision == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.source.targetRevision is required if the manifest source is a helm chart",
+			})
+			return conditions, nil
+		}
 	}
-	if spec.Source.Chart != "" && spec.Source.TargetRevision == "" {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.targetRevision is required if the manifest source is a helm chart",
-		})
-		return conditions, nil
+
+	if spec.Hydration != nil {
+		if msg := validateHydration(spec, proj); msg != "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: msg,
+			})
+			return conditions, nil
+		}
 	}
 
-	if !proj.IsSourcePermitted(spec.Source) {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.Source.RepoURL, spec.Project),
-		})
+	for _, source := range spec.GetSources() {
+		if !proj.IsSourcePermitted(source) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, spec.Project),
+			})
+		}
 	}
 
 	// ValidateDestination will resolve the destination's server address from its name for us, if possible
@@ -412,13 +488,19 @@ func GetAppProjectByName(name string, projLister applicationsv1.AppProjectLister
 	return GetAppVirtualProject(project, projLister, settingsManager)
 }
 
+// ErrProjectNotPermitted returns an error indicating that the application is not permitted to use the project, because
+// the project does not allow applications in the namespace of the application
+func ErrProjectNotPermitted(appName, appNamespace, projName string) error {
+	return fmt.Errorf("application '%s' in namespace '%s' is not permitted to use project '%s'", appName, appNamespace, projName)
+}
+
 // GetAppProject returns a project from an application
 func GetAppProject(spec *argoappv1.ApplicationSpec, projLister applicationsv1.AppProjectLister, ns string, settingsManager *settings.SettingsManager, db db.ArgoDB, ctx context.Context) (*argoappv1.AppProject, error) {
 	return GetAppProjectByName(spec.GetProject(), projLister, ns, settingsManager, db, ctx)
 }
 
 // verifyGenerateManifests verifies a repo path can generate manifests
-func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository, helmRepos argoappv1.Repositories, helmOptions *argoappv1.HelmOptions, app *argoappv1.Application, repoClient apiclient.RepoServerServiceClient, kustomizeOptions *argoappv1.KustomizeOptions, plugins []*argoappv1.ConfigManagementPlugin, kubeVersion string, apiVersions []string, repositoryCredentials []*argoappv1.RepoCreds, enableGenerateManifests map[string]bool, settingsMgr *settings.SettingsManager) []argoappv1.ApplicationCondition {
+func verifyGenerateManifests(ctx context.Context, repos []*argoappv1.Repository, helmRepos argoappv1.Repositories, helmOptions *argoappv1.HelmOptions, app *argoappv1.Application, repoClient apiclient.RepoServerServiceClient, kustomizeOptions *argoappv1.KustomizeOptions, plugins []*argoappv1.ConfigManagementPlugin, kubeVersion string, apiVersions []string, repositoryCredentials []*argoappv1.RepoCreds, enableGenerateManifests map[string]bool, settingsMgr *settings.SettingsManager) []argoappv1.ApplicationCondition {
 	spec := &app.Spec
 	var conditions []argoappv1.ApplicationCondition
 	if spec.Destination.Server == "" {
@@ -428,39 +510,55 @@ func verifyGenerateManifests(ctx context.Context, repoRes *argoappv1.Repository,
 		})
 	}
 
-	req := apiclient.ManifestRequest{
-		Repo: &argoappv1.Repository{
-			Repo:  spec.Source.RepoURL,
-			Type:  repoRes.Type,
-			Name:  repoRes.Name,
-			Proxy: repoRes.Proxy,
-		},
-		Repos:              helmRepos,
-		Revision:           spec.Source.TargetRevision,
-		AppName:            app.Name,
-		Namespace:          spec.Destination.Namespace,
-		ApplicationSource:  &spec.Source,
-		Plugins:            plugins,
-		KustomizeOptions:   kustomizeOptions,
-		KubeVersion:        kubeVersion,
-		ApiVersions:        apiVersions,
-		HelmOptions:        helmOptions,
-		HelmRepoCreds:      repositoryCredentials,
-		TrackingMethod:     string(GetTrackingMethod(settingsMgr)),
-		EnabledSourceTypes: enableGenerateManifests,
-		NoRevisionCache:    true,
-	}
-	req.Repo.CopyCredentialsFromRepo(repoRes)
-	req.Repo.CopySettingsFrom(repoRes)
-
-	// Only check whether we can access the application's path,
-	// and not whether it actually contains any manifests.
-	_, err := repoClient.GenerateManifest(ctx, &req)
-	if err != nil {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("Unable to generate manifests in %s: %v", spec.Source.Path, err),
-		})
+	sources := spec.GetSources()
+	refSources := map[string]*argoappv1.RefTarget{}
+	if spec.HasMultipleSources() {
+		for i, source := range sources {
+			if source.Ref != "" {
+				refSources["$"+source.Ref] = &argoappv1.RefTarget{Repo: *repos[i], TargetRevision: source.TargetRevision, Chart: source.Chart}
+			}
+		}
+	}
+
+	for i := range sources {
+		source := sources[i]
+		repoRes := repos[i]
+		req := apiclient.ManifestRequest{
+			Repo: &argoappv1.Repository{
+				Repo:  source.RepoURL,
+				Type:  repoRes.Type,
+				Name:  repoRes.Name,
+				Proxy: repoRes.Proxy,
+			},
+			Repos:              helmRepos,
+			Revision:           source.TargetRevision,
+			AppName:            app.Name,
+			Namespace:          spec.Destination.Namespace,
+			ApplicationSource:  &source,
+			Plugins:            plugins,
+			KustomizeOptions:   kustomizeOptions,
+			KubeVersion:        kubeVersion,
+			ApiVersions:        apiVersions,
+			HelmOptions:        helmOptions,
+			HelmRepoCreds:      repositoryCredentials,
+			TrackingMethod:     string(GetTrackingMethod(settingsMgr)),
+			EnabledSourceTypes: enableGenerateManifests,
+			NoRevisionCache:    true,
+			RefSources:         refSources,
+			HasMultipleSources: spec.HasMultipleSources(),
+		}
+		req.Repo.CopyCredentialsFromRepo(repoRes)
+		req.Repo.CopySettingsFrom(repoRes)
+
+		// Only check whether we can access the application's path,
+		// and not whether it actually contains any manifests.
+		_, err := repoClient.GenerateManifest(ctx, &req)
+		if err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Unable to generate manifests in %s: %v", source.Path, err),
+			})
+		}
 	}
 
 	return conditions
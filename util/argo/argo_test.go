@@ -165,6 +165,37 @@ func TestValidateChartWithoutRevision(t *testing.T) {
 	assert.Equal(t, "spec.source.targetRevision is required if the manifest source is a helm chart", conditions[0].Message)
 }
 
+func TestValidateHydration(t *testing.T) {
+	source := argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "main"}
+	proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{HydrationTargetBranches: []string{"env/*"}}}
+	tests := []struct {
+		name    string
+		spec    argoappv1.ApplicationSpec
+		message string
+	}{
+		{"Valid", argoappv1.ApplicationSpec{Source: source, Hydration: &argoappv1.ApplicationHydration{TargetBranch: "env/prod"}}, ""},
+		{"MultipleSources", argoappv1.ApplicationSpec{Sources: []argoappv1.ApplicationSource{source}, Hydration: &argoappv1.ApplicationHydration{TargetBranch: "env/prod"}},
+			"spec.hydration is not supported for applications with multiple sources"},
+		{"HelmChart", argoappv1.ApplicationSpec{Source: argoappv1.ApplicationSource{RepoURL: "https://charts.helm.sh/stable", Chart: "redis", TargetRevision: "1.0.0"}, Hydration: &argoappv1.ApplicationHydration{TargetBranch: "env/prod"}},
+			"spec.hydration requires the application source to be a Git repository"},
+		{"NoTargetBranch", argoappv1.ApplicationSpec{Source: source, Hydration: &argoappv1.ApplicationHydration{}},
+			"spec.hydration.targetBranch is required"},
+		{"SourceBranch", argoappv1.ApplicationSpec{Source: source, Hydration: &argoappv1.ApplicationHydration{TargetBranch: "main"}},
+			"spec.hydration.targetBranch must differ from spec.source.targetRevision"},
+		{"SourceBranchRef", argoappv1.ApplicationSpec{Source: argoappv1.ApplicationSource{RepoURL: source.RepoURL, Path: source.Path, TargetRevision: "refs/heads/env/prod"}, Hydration: &argoappv1.ApplicationHydration{TargetBranch: "env/prod"}},
+			"spec.hydration.targetBranch must differ from spec.source.targetRevision"},
+		{"BranchNotPermitted", argoappv1.ApplicationSpec{Project: "default", Source: source, Hydration: &argoappv1.ApplicationHydration{TargetBranch: "release"}},
+			"hydration to branch release is not permitted in project 'default'"},
+		{"PathOutsideRepo", argoappv1.ApplicationSpec{Source: source, Hydration: &argoappv1.ApplicationHydration{TargetBranch: "env/prod", Path: "../guestbook"}},
+			"spec.hydration.path ../guestbook must be a relative path within the repository"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.message, validateHydration(&tt.spec, proj))
+		})
+	}
+}
+
 func TestAPIResourcesToStrings(t *testing.T) {
 	resources := []kube.APIResourceInfo{{
 		GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1beta1"},
@@ -386,6 +417,18 @@ func TestFilterByRepo(t *testing.T) {
 		res := FilterByRepo(apps, "git@github.com:owner/willnotmatch.git")
 		assert.Len(t, res, 0)
 	})
+
+	t.Run("Match any source", func(t *testing.T) {
+		res := FilterByRepo([]argoappv1.Application{{
+			Spec: argoappv1.ApplicationSpec{
+				Sources: []argoappv1.ApplicationSource{
+					{RepoURL: "git@github.com:owner/otherrepo.git"},
+					{RepoURL: "git@github.com:owner/repo.git"},
+				},
+			},
+		}}, "git@github.com:owner/repo.git")
+		assert.Len(t, res, 1)
+	})
 }
 
 func TestValidatePermissions(t *testing.T) {
@@ -404,6 +447,38 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "are required")
 	})
 
+	t.Run("Incomplete source of multiple sources result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Sources: []argoappv1.ApplicationSource{
+				{RepoURL: "http://some/where", Path: "guestbook"},
+				{RepoURL: "http://some/where"},
+			},
+		}
+		proj := argoappv1.AppProject{}
+		db := &dbmocks.ArgoDB{}
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "spec.sources[1].repoURL")
+	})
+
+	t.Run("Duplicate ref of multiple sources result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Sources: []argoappv1.ApplicationSource{
+				{RepoURL: "http://some/where", Ref: "values"},
+				{RepoURL: "http://some/where/else", Ref: "values"},
+			},
+		}
+		proj := argoappv1.AppProject{}
+		db := &dbmocks.ArgoDB{}
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Equal(t, "spec.sources[1].ref values is used by more than one source", conditions[0].Message)
+	})
+
 	t.Run("Incomplete Path/Chart combo result in condition", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: argoappv1.ApplicationSource{
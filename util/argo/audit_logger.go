@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appsetv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
 )
 
 type AuditLogger struct {
@@ -58,6 +59,8 @@ func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, i
 		logCtx = logCtx.WithField("application", objMeta.Name)
 	case "AppProject":
 		logCtx = logCtx.WithField("project", objMeta.Name)
+	case "ApplicationSet":
+		logCtx = logCtx.WithField("applicationset", objMeta.Name)
 	default:
 		logCtx = logCtx.WithField("name", objMeta.Name)
 	}
@@ -106,6 +109,18 @@ func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, mes
 	})
 }
 
+func (l *AuditLogger) LogAppSetEvent(appset *appsetv1alpha1.ApplicationSet, info EventInfo, message string) {
+	objectMeta := ObjectRef{
+		Name:            appset.ObjectMeta.Name,
+		Namespace:       appset.ObjectMeta.Namespace,
+		ResourceVersion: appset.ObjectMeta.ResourceVersion,
+		UID:             appset.ObjectMeta.UID,
+	}
+	l.logEvent(objectMeta, appsetv1alpha1.GroupVersion.WithKind("ApplicationSet"), info, message, map[string]string{
+		"project": appset.Spec.Template.Spec.GetProject(),
+	})
+}
+
 func (l *AuditLogger) LogResourceEvent(res *v1alpha1.ResourceNode, info EventInfo, message string) {
 	objectMeta := ObjectRef{
 		Name:            res.ResourceRef.Name,
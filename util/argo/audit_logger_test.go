@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appsetv1 "github.com/argoproj/argo-cd/v2/pkg/apis/applicationset/v1alpha1"
 )
 
 // Helper to capture log entries generated by the logger and return it as string
@@ -104,6 +105,43 @@ func TestLogAppEvent(t *testing.T) {
 
 }
 
+func TestLogAppSetEvent(t *testing.T) {
+	logger := NewAuditLogger("default", fake.NewSimpleClientset(), "somecomponent")
+	assert.NotNil(t, logger)
+
+	appset := appsetv1.ApplicationSet{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            "testappset",
+			Namespace:       "argocd",
+			ResourceVersion: "1",
+			UID:             "a-b-c-d-e",
+		},
+		Spec: appsetv1.ApplicationSetSpec{
+			Template: appsetv1.ApplicationSetTemplate{
+				Spec: argoappv1.ApplicationSpec{
+					Project: "testproj",
+				},
+			},
+		},
+	}
+
+	ei := EventInfo{
+		Reason: "test",
+		Type:   "info",
+	}
+
+	output := captureLogEntries(func() {
+		logger.LogAppSetEvent(&appset, ei, "This is a test message")
+	})
+
+	assert.Contains(t, output, "level=info")
+	assert.Contains(t, output, "applicationset=testappset")
+	assert.Contains(t, output, "project=testproj")
+	assert.Contains(t, output, "reason=test")
+	assert.Contains(t, output, "type=info")
+	assert.Contains(t, output, "msg=\"This is a test message\"")
+}
+
 func TestLogResourceEvent(t *testing.T) {
 	logger := NewAuditLogger("default", fake.NewSimpleClientset(), "somecomponent")
 	assert.NotNil(t, logger)
@@ -0,0 +1,124 @@
+package cosign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// SignatureMediaType is the media type of the layers holding cosign signatures
+	SignatureMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// SignatureAnnotation is the layer annotation holding the base64 encoded signature of the layer
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+// payload is the simple signing payload which is signed by cosign
+type payload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// PublicKeys maps the names of cosign public keys to the keys
+type PublicKeys map[string]crypto.PublicKey
+
+// SignatureTag returns the tag at which cosign stores the signatures of the artifact with the given digest
+func SignatureTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".sig"
+}
+
+// ParsePublicKey parses a PEM encoded public key, as generated by "cosign generate-key-pair"
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded public key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+	return key, nil
+}
+
+// LoadPublicKeys loads the public keys stored in the given directory, where each file holds a PEM encoded key and is
+// named like the key. Returns no keys if the directory does not exist.
+func LoadPublicKeys(path string) (PublicKeys, error) {
+	keys := PublicKeys{}
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return keys, nil
+	} else if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		// directories mounted from ConfigMaps hold hidden links to the actual data
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePublicKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cosign key %s: %w", entry.Name(), err)
+		}
+		keys[entry.Name()] = key
+	}
+	return keys, nil
+}
+
+// VerifySignature verifies that the given payload is signed for the artifact with the given digest, and returns the
+// sorted names of all keys which verify the given base64 encoded signature of the payload. Several keys verify the
+// signature if the same public key is configured under different names.
+func (k PublicKeys) VerifySignature(digest string, data []byte, signature string) ([]string, error) {
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("error unmarshaling signature payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return nil, fmt.Errorf("signature is made for %s instead of %s", p.Critical.Image.DockerManifestDigest, digest)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding signature: %w", err)
+	}
+	var names []string
+	for name, key := range k {
+		if verify(key, data, sig) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("signature could not be verified with any of the configured cosign keys")
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func verify(key crypto.PublicKey, data []byte, sig []byte) bool {
+	digest := sha256.Sum256(data)
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, data, sig)
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDigest = "sha256:0c39f6b6de4d09a8ed88e7c0b7e7c6a5e3ea1c4a8f0ad9fb89f9c1cbe1d3c7a1"
+
+func generateKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, digest string) ([]byte, string) {
+	t.Helper()
+	data := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"ghcr.io/argoproj/charts/guestbook"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, digest))
+	hash := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	return data, base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestSignatureTag(t *testing.T) {
+	assert.Equal(t, "sha256-abc.sig", SignatureTag("sha256:abc"))
+}
+
+func TestLoadPublicKeys(t *testing.T) {
+	dir := t.TempDir()
+	_, pub := generateKey(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "release"), pub, 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0755))
+
+	keys, err := LoadPublicKeys(dir)
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Contains(t, keys, "release")
+
+	keys, err = LoadPublicKeys(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid"), []byte("invalid"), 0644))
+	_, err = LoadPublicKeys(dir)
+	assert.ErrorContains(t, err, "invalid cosign key invalid")
+}
+
+func TestPublicKeys_VerifySignature(t *testing.T) {
+	release, releasePub := generateKey(t)
+	other, otherPub := generateKey(t)
+	releaseKey, err := ParsePublicKey(releasePub)
+	require.NoError(t, err)
+	otherKey, err := ParsePublicKey(otherPub)
+	require.NoError(t, err)
+	keys := PublicKeys{"release": releaseKey, "other": otherKey}
+
+	t.Run("Valid", func(t *testing.T) {
+		data, sig := sign(t, release, testDigest)
+		names, err := keys.VerifySignature(testDigest, data, sig)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"release"}, names)
+		data, sig = sign(t, other, testDigest)
+		names, err = keys.VerifySignature(testDigest, data, sig)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other"}, names)
+	})
+	t.Run("SameKeyWithSeveralNames", func(t *testing.T) {
+		keys := PublicKeys{"release": releaseKey, "a-release": releaseKey, "other": otherKey}
+		data, sig := sign(t, release, testDigest)
+		names, err := keys.VerifySignature(testDigest, data, sig)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a-release", "release"}, names)
+	})
+	t.Run("UnknownKey", func(t *testing.T) {
+		unknown, _ := generateKey(t)
+		data, sig := sign(t, unknown, testDigest)
+		_, err := keys.VerifySignature(testDigest, data, sig)
+		assert.ErrorContains(t, err, "could not be verified with any of the configured cosign keys")
+	})
+	t.Run("OtherDigest", func(t *testing.T) {
+		data, sig := sign(t, release, "sha256:other")
+		_, err := keys.VerifySignature(testDigest, data, sig)
+		assert.ErrorContains(t, err, "signature is made for sha256:other")
+	})
+	t.Run("TamperedPayload", func(t *testing.T) {
+		data, sig := sign(t, release, testDigest)
+		data = append(data, ' ')
+		_, err := keys.VerifySignature(testDigest, data, sig)
+		assert.Error(t, err)
+	})
+}
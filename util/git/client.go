@@ -2,6 +2,7 @@ package git
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -36,6 +37,13 @@ import (
 
 var ErrInvalidRepoURL = fmt.Errorf("repo URL is invalid")
 
+const (
+	// CommitAuthorName is the name of the author of the commits made by Argo CD
+	CommitAuthorName = "Argo CD"
+	// CommitAuthorEmail is the email address of the author of the commits made by Argo CD
+	CommitAuthorEmail = "argo-cd@argoproj.io"
+)
+
 type RevisionMetadata struct {
 	Author  string
 	Date    time.Time
@@ -64,11 +72,14 @@ type Client interface {
 	Checkout(revision string, submoduleEnabled bool) error
 	LsRefs() (*Refs, error)
 	LsRemote(revision string) (string, error)
+	ResolveBranch(revision string) (string, error)
 	LsFiles(path string) ([]string, error)
 	LsLargeFiles() ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
 	VerifyCommitSignature(string) (string, error)
+	CheckoutOrOrphan(branch string) error
+	CommitAndPush(branch string, message string) (string, error)
 }
 
 type EventHandlers struct {
@@ -574,6 +585,34 @@ func (m *nativeGitClient) lsRemote(revision string) (string, error) {
 	return "", fmt.Errorf("Unable to resolve '%s' to a commit SHA", revision)
 }
 
+// ResolveBranch returns the name of the branch of origin which the given revision refers to, following symbolic
+// references like HEAD. Returns an empty string if the revision is not a branch, e.g. a tag or a commit SHA.
+func (m *nativeGitClient) ResolveBranch(revision string) (string, error) {
+	if IsCommitSHA(revision) {
+		return "", nil
+	}
+	refs, err := m.getRefs()
+	if err != nil {
+		return "", err
+	}
+	if revision == "" {
+		revision = "HEAD"
+	}
+	for _, ref := range refs {
+		if ref.Name().Short() != revision && ref.Name().String() != revision {
+			continue
+		}
+		name := ref.Name()
+		if ref.Type() == plumbing.SymbolicReference {
+			name = ref.Target()
+		}
+		if name.IsBranch() {
+			return name.Short(), nil
+		}
+	}
+	return "", nil
+}
+
 // CommitSHA returns current commit sha from `git rev-parse HEAD`
 func (m *nativeGitClient) CommitSHA() (string, error) {
 	out, err := m.runCmd("rev-parse", "HEAD")
@@ -615,6 +654,60 @@ func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error)
 	return out, nil
 }
 
+// CheckoutOrOrphan checks out the given branch of origin, or creates a new orphan branch without any files if origin
+// has no such branch
+func (m *nativeGitClient) CheckoutOrOrphan(branch string) error {
+	refs, err := m.getRefs()
+	if err != nil && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return err
+	}
+	for _, ref := range refs {
+		if ref.Name() != plumbing.NewBranchReferenceName(branch) {
+			continue
+		}
+		if err := m.fetch(branch); err != nil {
+			return err
+		}
+		if _, err := m.runCmd("checkout", "--force", "-B", branch, "FETCH_HEAD"); err != nil {
+			return err
+		}
+		_, err := m.runCmd("clean", "-fdx")
+		return err
+	}
+	if _, err := m.runCmd("checkout", "--orphan", branch); err != nil {
+		return err
+	}
+	// the working tree of a new orphan branch still holds the files of the previous branch
+	_, err = m.runCmd("rm", "-r", "--force", "--quiet", "--ignore-unmatch", ".")
+	return err
+}
+
+// CommitAndPush commits all changes of the working tree and pushes the commit to the given branch of origin. Returns
+// the SHA of the pushed commit, or of the current commit if there is nothing to commit.
+func (m *nativeGitClient) CommitAndPush(branch string, message string) (string, error) {
+	if _, err := m.runCmd("add", "--all"); err != nil {
+		return "", err
+	}
+	out, err := m.runCmd("status", "--porcelain")
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(out) == "" {
+		return m.CommitSHA()
+	}
+	cmd := exec.Command("git", "commit", "--message", message)
+	cmd.Env = append(cmd.Env,
+		fmt.Sprintf("GIT_AUTHOR_NAME=%s", CommitAuthorName), fmt.Sprintf("GIT_AUTHOR_EMAIL=%s", CommitAuthorEmail),
+		fmt.Sprintf("GIT_COMMITTER_NAME=%s", CommitAuthorName), fmt.Sprintf("GIT_COMMITTER_EMAIL=%s", CommitAuthorEmail))
+	if _, err := m.runCmdOutput(cmd); err != nil {
+		return "", err
+	}
+	if err := m.runCredentialedCmd("git", "push", "origin", fmt.Sprintf("HEAD:%s", plumbing.NewBranchReferenceName(branch))); err != nil {
+		return "", err
+	}
+	return m.CommitSHA()
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
 func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (string, error) {
 	cmd := exec.Command(wrapper, args...)
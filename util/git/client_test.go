@@ -213,3 +213,75 @@ func TestNewClient_invalidSSHURL(t *testing.T) {
 	assert.Nil(t, client)
 	assert.ErrorIs(t, err, ErrInvalidRepoURL)
 }
+
+func Test_nativeGitClient_CommitAndPush(t *testing.T) {
+	remote := t.TempDir()
+	cmd := exec.Command("git", "init", "--bare")
+	cmd.Dir = remote
+	require.NoError(t, cmd.Run())
+
+	newClient := func() Client {
+		client, err := NewClientExt(fmt.Sprintf("file://%s", remote), t.TempDir(), NopCreds{}, true, false, "")
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		return client
+	}
+
+	// the branch does not exist yet, so an orphan branch is pushed
+	client := newClient()
+	require.NoError(t, client.CheckoutOrOrphan("hydrated"))
+	require.NoError(t, os.WriteFile(filepath.Join(client.Root(), "manifest.yaml"), []byte("kind: ConfigMap\n"), 0644))
+	sha, err := client.CommitAndPush("hydrated", "Hydrate manifests")
+	require.NoError(t, err)
+	assert.Len(t, sha, 40)
+	meta, err := client.RevisionMetadata(sha)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%s <%s>", CommitAuthorName, CommitAuthorEmail), meta.Author)
+	assert.Equal(t, "Hydrate manifests", meta.Message)
+
+	// committing unchanged files returns the current commit
+	client = newClient()
+	require.NoError(t, client.CheckoutOrOrphan("hydrated"))
+	data, err := os.ReadFile(filepath.Join(client.Root(), "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap\n", string(data))
+	unchangedSHA, err := client.CommitAndPush("hydrated", "Hydrate manifests")
+	require.NoError(t, err)
+	assert.Equal(t, sha, unchangedSHA)
+
+	require.NoError(t, os.WriteFile(filepath.Join(client.Root(), "manifest.yaml"), []byte("kind: Secret\n"), 0644))
+	newSHA, err := client.CommitAndPush("hydrated", "Hydrate manifests")
+	require.NoError(t, err)
+	assert.NotEqual(t, sha, newSHA)
+	lsRemoteSHA, err := newClient().LsRemote("hydrated")
+	require.NoError(t, err)
+	assert.Equal(t, newSHA, lsRemoteSHA)
+}
+
+func Test_nativeGitClient_ResolveBranch(t *testing.T) {
+	remote := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "--initial-branch", "main"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "--message", "initial"},
+		{"tag", "v1.0.0"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = remote
+		require.NoError(t, cmd.Run())
+	}
+	client, err := NewClientExt(fmt.Sprintf("file://%s", remote), t.TempDir(), NopCreds{}, true, false, "")
+	require.NoError(t, err)
+
+	for revision, branch := range map[string]string{
+		"":                "main",
+		"HEAD":            "main",
+		"main":            "main",
+		"refs/heads/main": "main",
+		"v1.0.0":          "",
+		"unknown":         "",
+	} {
+		resolved, err := client.ResolveBranch(revision)
+		require.NoError(t, err)
+		assert.Equal(t, branch, resolved, revision)
+	}
+}
@@ -52,6 +52,7 @@ type Client interface {
 	ExtractChart(chart string, version string, passCredentials bool) (string, argoio.Closer, error)
 	GetIndex(noCache bool) (*Index, error)
 	TestHelmOCI() (bool, error)
+	VerifyChartSignature(chart string, version string, passCredentials bool) (*SignatureVerification, error)
 }
 
 type ClientOpts func(c *nativeHelmChart)
@@ -283,13 +284,34 @@ func (c *nativeHelmChart) loadRepoIndex() ([]byte, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", indexURL, nil)
+	resp, err := c.get(indexURL, false)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != 200 {
+		return nil, errors.New("failed to get index: " + resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// get requests the given URL. The credentials are only passed to hosts other than the host of the repository if
+// passCredentials is true.
+func (c *nativeHelmChart) get(rawURL string, passCredentials bool) (*http.Response, error) {
+	req, err := http.NewRequest("GET", rawURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	if c.creds.Username != "" || c.creds.Password != "" {
-		// only basic supported
-		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+		repoURL, err := url.Parse(c.repoURL)
+		if err != nil {
+			return nil, err
+		}
+		if passCredentials || req.URL.Host == repoURL.Host {
+			// only basic supported
+			req.SetBasicAuth(c.creds.Username, c.creds.Password)
+		}
 	}
 
 	tlsConf, err := newTLSConfig(c.creds)
@@ -302,16 +324,7 @@ func (c *nativeHelmChart) loadRepoIndex() ([]byte, error) {
 		TLSClientConfig: tlsConf,
 	}
 	client := http.Client{Transport: tr}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != 200 {
-		return nil, errors.New("failed to get index: " + resp.Status)
-	}
-	return io.ReadAll(resp.Body)
+	return client.Do(req)
 }
 
 func newTLSConfig(creds Creds) (*tls.Config, error) {
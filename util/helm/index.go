@@ -12,6 +12,7 @@ import (
 type Entry struct {
 	Version string
 	Created time.Time
+	URLs    []string
 }
 
 type Index struct {
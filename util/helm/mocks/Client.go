@@ -101,3 +101,26 @@ func (_m *Client) TestHelmOCI() (bool, error) {
 
 	return r0, r1
 }
+
+// VerifyChartSignature provides a mock function with given fields: chart, version, passCredentials
+func (_m *Client) VerifyChartSignature(chart string, version string, passCredentials bool) (*helm.SignatureVerification, error) {
+	ret := _m.Called(chart, version, passCredentials)
+
+	var r0 *helm.SignatureVerification
+	if rf, ok := ret.Get(0).(func(string, string, bool) *helm.SignatureVerification); ok {
+		r0 = rf(chart, version, passCredentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helm.SignatureVerification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(chart, version, passCredentials)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
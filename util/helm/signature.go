@@ -0,0 +1,284 @@
+package helm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/openpgp/clearsign"
+	"gopkg.in/yaml.v2"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/cosign"
+	"github.com/argoproj/argo-cd/v2/util/oci"
+)
+
+const (
+	// SignatureTypeProvenance is the type of the signatures of charts from Helm repositories, which are verified with
+	// the GnuPG signed provenance files of the charts
+	SignatureTypeProvenance = "provenance"
+	// SignatureTypeCosign is the type of the signatures of charts from OCI registries, which are verified with the
+	// signatures stored by cosign next to the charts
+	SignatureTypeCosign = "cosign"
+
+	// mediaTypeChartContent is the media type of the chart archive layer of charts in OCI registries
+	mediaTypeChartContent = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+)
+
+// SignatureVerification is the result of verifying the signature of a chart
+type SignatureVerification struct {
+	// Type is the type of the signature, either provenance or cosign
+	Type string
+	// Signed is true if a signature has been found for the chart
+	Signed bool
+	// VerifyResult is the output of the GnuPG verification of the provenance file
+	VerifyResult string
+	// KeyIDs are the names of the cosign keys which the signature has been verified with, if the signature is valid
+	KeyIDs []string
+	// Message describes why the signature is not valid
+	Message string
+}
+
+// VerifyChartSignature verifies the signature of the given chart version, which must have been extracted before.
+// Charts from OCI registries are verified with their cosign signatures, all other charts with their provenance files.
+func (c *nativeHelmChart) VerifyChartSignature(chart string, version string, passCredentials bool) (*SignatureVerification, error) {
+	cachedChartPath, err := c.getCachedChartPath(chart, version)
+	if err != nil {
+		return nil, err
+	}
+	c.repoLock.Lock(cachedChartPath)
+	defer c.repoLock.Unlock(cachedChartPath)
+
+	digest, err := fileDigest(cachedChartPath)
+	if err != nil {
+		return nil, fmt.Errorf("error computing digest of chart %s version %s: %w", chart, version, err)
+	}
+	if c.enableOci {
+		return c.verifyCosignSignature(chart, version, digest)
+	}
+	return c.verifyProvenance(chart, version, digest, passCredentials)
+}
+
+// verifyProvenance verifies the provenance file of the given chart version, and that it lists the chart archive with
+// the given digest
+func (c *nativeHelmChart) verifyProvenance(chart string, version string, digest string, passCredentials bool) (*SignatureVerification, error) {
+	res := &SignatureVerification{Type: SignatureTypeProvenance}
+	chartURL, err := c.getChartURL(chart, version)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.get(chartURL+".prov", passCredentials)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusNotFound {
+		return res, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get provenance file of chart %s version %s: %s", chart, version, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Signed = true
+
+	files, err := parseProvenanceFiles(data)
+	if err != nil {
+		res.Message = err.Error()
+		return res, nil
+	}
+	name := path.Base(chartURL)
+	if files[name] != digest {
+		res.Message = fmt.Sprintf("Digest %s of chart archive %s does not match the digest in its provenance file", digest, name)
+		return res, nil
+	}
+
+	provenanceFile, err := os.CreateTemp("", "provenance")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(provenanceFile.Name()) }()
+	_, err = provenanceFile.Write(data)
+	if closeErr := provenanceFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.VerifyResult, err = verifyClearSignedFile(provenanceFile.Name())
+	if err != nil {
+		return nil, fmt.Errorf("error verifying provenance file of chart %s version %s: %w", chart, version, err)
+	}
+	return res, nil
+}
+
+// verifyClearSignedFile verifies the signature of the given clear-signed file with the keys in Argo CD's GnuPG
+// keyring, and returns the output of gpg
+func verifyClearSignedFile(path string) (string, error) {
+	cmd := exec.Command("gpg", "--no-permission-warning", "--verify", path)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("GNUPGHOME=%s", common.GetGnuPGHomePath()), "LANG=C")
+	out, err := cmd.CombinedOutput()
+	// gpg fails if the signature is bad or has been made with an unknown key, which is reported in its output just
+	// like a good signature
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || len(out) == 0) {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// verifyCosignSignature verifies the cosign signature of the given chart version, and that the signed chart manifest
+// holds the chart archive with the given digest
+func (c *nativeHelmChart) verifyCosignSignature(chart string, version string, digest string) (*SignatureVerification, error) {
+	res := &SignatureVerification{Type: SignatureTypeCosign}
+	client, err := oci.NewClient(fmt.Sprintf("oci://%s/%s", c.repoURL, chart), oci.Creds{
+		Username:           c.creds.Username,
+		Password:           c.creds.Password,
+		CAPath:             c.creds.CAPath,
+		CertData:           c.creds.CertData,
+		KeyData:            c.creds.KeyData,
+		InsecureSkipVerify: c.creds.InsecureSkipVerify,
+	}, c.proxy)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	manifestDigest, err := client.ResolveRevision(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := client.GetManifest(ctx, manifestDigest)
+	if err != nil {
+		return nil, err
+	}
+	signatureDigest, err := client.ResolveRevision(ctx, cosign.SignatureTag(manifestDigest))
+	if errors.Is(err, oci.ErrNotFound) {
+		return res, nil
+	} else if err != nil {
+		return nil, err
+	}
+	signatureManifest, err := client.GetManifest(ctx, signatureDigest)
+	if err != nil {
+		return nil, err
+	}
+	res.Signed = true
+
+	if !hasLayer(manifest, mediaTypeChartContent, digest) {
+		res.Message = fmt.Sprintf("Digest %s of chart archive does not match the digest in the chart manifest %s", digest, manifestDigest)
+		return res, nil
+	}
+	keys, err := cosign.LoadPublicKeys(common.GetCosignKeysPath())
+	if err != nil {
+		return nil, err
+	}
+	res.Message = fmt.Sprintf("No cosign signatures found in %s", cosign.SignatureTag(manifestDigest))
+	// all keys which verify any of the signatures are returned, so that the keys allowed by the project can be matched
+	keyIDs := map[string]bool{}
+	for _, layer := range signatureManifest.Layers {
+		if layer.MediaType != cosign.SignatureMediaType {
+			continue
+		}
+		data, err := client.GetBlob(ctx, layer)
+		if err != nil {
+			return nil, err
+		}
+		names, err := keys.VerifySignature(manifestDigest, data, layer.Annotations[cosign.SignatureAnnotation])
+		if err != nil {
+			if len(keyIDs) == 0 {
+				res.Message = fmt.Sprintf("Signature of chart manifest %s is not valid: %v", manifestDigest, err)
+			}
+			continue
+		}
+		for _, name := range names {
+			keyIDs[name] = true
+		}
+		res.Message = ""
+	}
+	for name := range keyIDs {
+		res.KeyIDs = append(res.KeyIDs, name)
+	}
+	sort.Strings(res.KeyIDs)
+	return res, nil
+}
+
+// getChartURL returns the URL of the archive of the given chart version, as listed in the repository index
+func (c *nativeHelmChart) getChartURL(chart string, version string) (string, error) {
+	index, err := c.GetIndex(false)
+	if err != nil {
+		return "", err
+	}
+	entries, err := index.GetEntries(chart)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		if entry.Version != version || len(entry.URLs) == 0 {
+			continue
+		}
+		// the URLs in the index may be relative to the repository URL
+		base, err := url.Parse(strings.TrimSuffix(c.repoURL, "/") + "/")
+		if err != nil {
+			return "", err
+		}
+		chartURL, err := base.Parse(entry.URLs[0])
+		if err != nil {
+			return "", err
+		}
+		return chartURL.String(), nil
+	}
+	return "", fmt.Errorf("no URL of chart '%s' version '%s' found in index", chart, version)
+}
+
+// parseProvenanceFiles returns the digests of the files listed in the given provenance file by their names. The signed
+// message of a provenance file consists of the Chart.yaml of the chart and of the list of files, separated by a YAML
+// document end marker.
+func parseProvenanceFiles(data []byte) (map[string]string, error) {
+	block, _ := clearsign.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("provenance file holds no signed message")
+	}
+	parts := strings.SplitN(string(block.Plaintext), "\n...\n", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("provenance file lists no files")
+	}
+	var provenance struct {
+		Files map[string]string `yaml:"files"`
+	}
+	if err := yaml.Unmarshal([]byte(parts[1]), &provenance); err != nil {
+		return nil, fmt.Errorf("error unmarshaling files of provenance file: %w", err)
+	}
+	return provenance.Files, nil
+}
+
+func hasLayer(manifest *oci.Manifest, mediaType string, digest string) bool {
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == mediaType && layer.Digest == digest {
+			return true
+		}
+	}
+	return false
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
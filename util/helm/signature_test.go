@@ -0,0 +1,210 @@
+package helm
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/clearsign"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/cosign"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/oci"
+)
+
+const testChartArchive = "my-chart-archive"
+
+// newChartClient returns a client of the given repository, which has cached the test chart archive
+func newChartClient(t *testing.T, repoURL string, enableOCI bool) Client {
+	t.Helper()
+	client := NewClient(repoURL, Creds{InsecureSkipVerify: true}, enableOCI, "", WithChartPaths(argoio.NewTempPaths(t.TempDir())))
+	cachedChartPath, err := client.(*nativeHelmChart).getCachedChartPath("my-chart", "1.0.0")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cachedChartPath, []byte(testChartArchive), 0644))
+	return client
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("sha256:%x", sum)
+}
+
+func signProvenance(t *testing.T, files string) []byte {
+	t.Helper()
+	entity, err := openpgp.NewEntity("Argo CD", "", "noreply@argoproj.io", nil)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	w, err := clearsign.Encode(buf, entity.PrivateKey, nil)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("apiVersion: v2\nname: my-chart\nversion: 1.0.0\n\n...\n" + files))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestParseProvenanceFiles(t *testing.T) {
+	files, err := parseProvenanceFiles(signProvenance(t, "files:\n  my-chart-1.0.0.tgz: sha256:abc\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"my-chart-1.0.0.tgz": "sha256:abc"}, files)
+
+	_, err = parseProvenanceFiles([]byte("files:\n  my-chart-1.0.0.tgz: sha256:abc\n"))
+	assert.ErrorContains(t, err, "no signed message")
+}
+
+func TestVerifyChartSignature_Provenance(t *testing.T) {
+	t.Setenv(common.EnvGnuPGHome, t.TempDir())
+	provenances := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.yaml":
+			_, _ = w.Write([]byte("entries:\n  my-chart:\n  - version: 1.0.0\n    urls: [charts/my-chart-1.0.0.tgz]\n"))
+		case "/charts/my-chart-1.0.0.tgz.prov":
+			if data, ok := provenances["my-chart"]; ok {
+				_, _ = w.Write(data)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := newChartClient(t, server.URL, false)
+
+	t.Run("Unsigned", func(t *testing.T) {
+		res, err := client.VerifyChartSignature("my-chart", "1.0.0", false)
+		require.NoError(t, err)
+		assert.Equal(t, &SignatureVerification{Type: SignatureTypeProvenance}, res)
+	})
+	t.Run("DigestMismatch", func(t *testing.T) {
+		provenances["my-chart"] = signProvenance(t, "files:\n  my-chart-1.0.0.tgz: sha256:abc\n")
+		res, err := client.VerifyChartSignature("my-chart", "1.0.0", false)
+		require.NoError(t, err)
+		assert.True(t, res.Signed)
+		assert.Contains(t, res.Message, "does not match the digest in its provenance file")
+		assert.Empty(t, res.VerifyResult)
+	})
+	t.Run("Signed", func(t *testing.T) {
+		provenances["my-chart"] = signProvenance(t, fmt.Sprintf("files:\n  my-chart-1.0.0.tgz: %s\n", digestOf([]byte(testChartArchive))))
+		res, err := client.VerifyChartSignature("my-chart", "1.0.0", false)
+		require.NoError(t, err)
+		assert.True(t, res.Signed)
+		assert.Empty(t, res.Message)
+		// the signing key is not part of the keyring
+		assert.Contains(t, res.VerifyResult, "gpg: Signature made")
+	})
+}
+
+// fakeRegistry serves the manifests and blobs of a single OCI repository
+type fakeRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+}
+
+func (r *fakeRegistry) push(t *testing.T, tag string, layers ...oci.Descriptor) string {
+	t.Helper()
+	data, err := json.Marshal(oci.Manifest{MediaType: "application/vnd.oci.image.manifest.v1+json", Layers: layers})
+	require.NoError(t, err)
+	digest := digestOf(data)
+	r.manifests[tag] = data
+	r.manifests[digest] = data
+	return digest
+}
+
+func (r *fakeRegistry) layer(mediaType string, data []byte, annotations map[string]string) oci.Descriptor {
+	r.blobs[digestOf(data)] = data
+	return oci.Descriptor{MediaType: mediaType, Digest: digestOf(data), Size: int64(len(data)), Annotations: annotations}
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/v2/charts/my-chart/")
+	var data []byte
+	var ok bool
+	if strings.HasPrefix(path, "manifests/") {
+		data, ok = r.manifests[strings.TrimPrefix(path, "manifests/")]
+		w.Header().Set("Docker-Content-Digest", digestOf(data))
+	} else if strings.HasPrefix(path, "blobs/") {
+		data, ok = r.blobs[strings.TrimPrefix(path, "blobs/")]
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if req.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+func TestVerifyChartSignature_Cosign(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	keysPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(keysPath, "release"), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644))
+	t.Setenv(common.EnvCosignKeysPath, keysPath)
+
+	registry := &fakeRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+	server := httptest.NewTLSServer(registry)
+	defer server.Close()
+	client := newChartClient(t, strings.TrimPrefix(server.URL, "https://")+"/charts", true)
+	sign := func(digest string) {
+		payload := []byte(fmt.Sprintf(`{"critical":{"image":{"docker-manifest-digest":"%s"}}}`, digest))
+		hash := sha256.Sum256(payload)
+		sig, err := key.Sign(rand.Reader, hash[:], crypto.SHA256)
+		require.NoError(t, err)
+		registry.push(t, cosign.SignatureTag(digest), registry.layer(cosign.SignatureMediaType, payload, map[string]string{
+			cosign.SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+		}))
+	}
+
+	t.Run("Unsigned", func(t *testing.T) {
+		registry.push(t, "1.0.0", registry.layer(mediaTypeChartContent, []byte(testChartArchive), nil))
+		res, err := client.VerifyChartSignature("my-chart", "1.0.0", false)
+		require.NoError(t, err)
+		assert.Equal(t, &SignatureVerification{Type: SignatureTypeCosign}, res)
+	})
+	t.Run("Signed", func(t *testing.T) {
+		sign(registry.push(t, "1.0.0", registry.layer(mediaTypeChartContent, []byte(testChartArchive), nil)))
+		res, err := client.VerifyChartSignature("my-chart", "1.0.0", false)
+		require.NoError(t, err)
+		assert.Equal(t, &SignatureVerification{Type: SignatureTypeCosign, Signed: true, KeyIDs: []string{"release"}}, res)
+	})
+	t.Run("DigestMismatch", func(t *testing.T) {
+		sign(registry.push(t, "1.0.0", registry.layer(mediaTypeChartContent, []byte("other-chart-archive"), nil)))
+		res, err := client.VerifyChartSignature("my-chart", "1.0.0", false)
+		require.NoError(t, err)
+		assert.True(t, res.Signed)
+		assert.Empty(t, res.KeyIDs)
+		assert.Contains(t, res.Message, "does not match the digest in the chart manifest")
+	})
+	t.Run("UnknownKey", func(t *testing.T) {
+		digest := registry.push(t, "1.0.0", registry.layer(mediaTypeChartContent, []byte(testChartArchive), nil))
+		registry.push(t, cosign.SignatureTag(digest), registry.layer(cosign.SignatureMediaType, []byte(fmt.Sprintf(`{"critical":{"image":{"docker-manifest-digest":"%s"}}}`, digest)), map[string]string{
+			cosign.SignatureAnnotation: base64.StdEncoding.EncodeToString([]byte("invalid")),
+		}))
+		res, err := client.VerifyChartSignature("my-chart", "1.0.0", false)
+		require.NoError(t, err)
+		assert.True(t, res.Signed)
+		assert.Empty(t, res.KeyIDs)
+		assert.Contains(t, res.Message, "could not be verified with any of the configured cosign keys")
+	})
+}
@@ -47,17 +47,22 @@ func Tgz(srcPath string, exclusions []string, writers ...io.Writer) error {
 //   - points to an empty directory or
 //   - points to a non existing directory
 func Untgz(dstPath string, r io.Reader) error {
-	if !filepath.IsAbs(dstPath) {
-		return fmt.Errorf("dstPath points to a relative path: %s", dstPath)
-	}
-
 	gzr, err := gzip.NewReader(r)
 	if err != nil {
 		return fmt.Errorf("error reading file: %w", err)
 	}
 	defer gzr.Close()
 
-	tr := tar.NewReader(gzr)
+	return Untar(dstPath, gzr)
+}
+
+// Untar is like Untgz, but expects an uncompressed tar archive.
+func Untar(dstPath string, r io.Reader) error {
+	if !filepath.IsAbs(dstPath) {
+		return fmt.Errorf("dstPath points to a relative path: %s", dstPath)
+	}
+
+	tr := tar.NewReader(r)
 
 	for {
 		header, err := tr.Next()
@@ -0,0 +1,355 @@
+package kube
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kubectl/pkg/cmd/apply"
+	"k8s.io/kubectl/pkg/cmd/delete"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+)
+
+// replaceDeletionTimeout is the maximum time a forced replace waits for the deletion of the resource
+const replaceDeletionTimeout = time.Minute
+
+var (
+	kubectlErrOutRegexp           = regexp.MustCompile(`^(error: )?(error validating|error when creating) "\S+": `)
+	kubectlApplyPatchErrOutRegexp = regexp.MustCompile(`(?s)^error when applying patch:.*\nfor: "\S+": `)
+)
+
+// NewImpersonatingKubectl wraps the given kubectl so that resources are applied, created, replaced and updated as the
+// user impersonated by the rest config. Kubectl runs with a kubeconfig written from the rest config, which does not
+// retain the impersonation settings, so client-side apply runs kubectl with a kubeconfig which impersonates the user,
+// and all other requests are sent with a dynamic client created from the rest config. Resources applied with
+// server-side apply are owned by the given field manager. Operations which use the rest config directly impersonate
+// the user on their own.
+func NewImpersonatingKubectl(kubectl kube.Kubectl, fieldManager string) kube.Kubectl {
+	return &impersonatingKubectl{Kubectl: kubectl, fieldManager: fieldManager}
+}
+
+type impersonatingKubectl struct {
+	kube.Kubectl
+	fieldManager string
+}
+
+func (k *impersonatingKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	if config.Impersonate.UserName == "" {
+		return k.Kubectl.ManageResources(config, openAPISchema)
+	}
+	// the sync is refused if the requests cannot be sent as the impersonated user
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating dynamic client impersonating %s: %w", config.Impersonate.UserName, err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating discovery client impersonating %s: %w", config.Impersonate.UserName, err)
+	}
+	kubeConfigPath, err := writeImpersonatingKubeConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error writing kubeconfig impersonating %s: %w", config.Impersonate.UserName, err)
+	}
+	cleanup := func() {
+		_ = os.Remove(kubeConfigPath)
+	}
+	return &impersonatingResourceOperations{
+		fact:          kubeCmdFactory(kubeConfigPath, config),
+		openAPISchema: openAPISchema,
+		dynamicIf:     dynamicIf,
+		mapper:        restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+		fieldManager:  k.fieldManager,
+	}, cleanup, nil
+}
+
+// writeImpersonatingKubeConfig writes a kubeconfig of the rest config, including its impersonation settings, to a
+// temporary file and returns the path of the file
+func writeImpersonatingKubeConfig(config *rest.Config) (string, error) {
+	kubeConfig := kube.NewKubeConfig(config, "")
+	authInfo := kubeConfig.AuthInfos[config.Host]
+	authInfo.Impersonate = config.Impersonate.UserName
+	authInfo.ImpersonateGroups = config.Impersonate.Groups
+	authInfo.ImpersonateUserExtra = config.Impersonate.Extra
+	f, err := ioutil.TempFile("", "kubeconfig")
+	if err != nil {
+		return "", err
+	}
+	_ = f.Close()
+	if err := clientcmd.WriteToFile(*kubeConfig, f.Name()); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// kubeCmdFactory returns a kubectl factory of the given kubeconfig, like the one of the gitops engine
+func kubeCmdFactory(kubeConfigPath string, config *rest.Config) cmdutil.Factory {
+	kubeConfigFlags := genericclioptions.NewConfigFlags(true)
+	kubeConfigFlags.KubeConfig = &kubeConfigPath
+	kubeConfigFlags.WithDiscoveryBurst(config.Burst)
+	kubeConfigFlags.WithDiscoveryQPS(config.QPS)
+	return cmdutil.NewFactory(cmdutil.NewMatchVersionFlags(kubeConfigFlags))
+}
+
+// cleanKubectlOutput removes the name of the manifest file and the applied patch from kubectl errors
+func cleanKubectlOutput(s string) string {
+	s = strings.TrimSpace(s)
+	s = kubectlErrOutRegexp.ReplaceAllString(s, "")
+	s = kubectlApplyPatchErrOutRegexp.ReplaceAllString(s, "")
+	return strings.Replace(s, "; if you choose to ignore these errors, turn validation off with --validate=false", "", -1)
+}
+
+// impersonatingResourceOperations sends all requests as a user which the rest config impersonates, so that the
+// Kubernetes API server, including its authorization and admission control, processes them as requests of that user
+type impersonatingResourceOperations struct {
+	fact          cmdutil.Factory
+	openAPISchema openapi.Resources
+	dynamicIf     dynamic.Interface
+	mapper        meta.RESTMapper
+	fieldManager  string
+}
+
+func (o *impersonatingResourceOperations) resourceInterface(obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	return resourceInterface(o.dynamicIf, o.mapper, obj)
+}
+
+// operationMessage returns the result message of an operation in the format of kubectl, e.g.
+// "deployment.apps/guestbook created"
+func operationMessage(obj *unstructured.Unstructured, operation string, dryRunStrategy cmdutil.DryRunStrategy) string {
+	gvk := obj.GroupVersionKind()
+	kind := strings.ToLower(gvk.Kind)
+	if gvk.Group != "" {
+		kind += "." + gvk.Group
+	}
+	msg := fmt.Sprintf("%s/%s %s", kind, obj.GetName(), operation)
+	switch dryRunStrategy {
+	case cmdutil.DryRunClient:
+		msg += " (dry run)"
+	case cmdutil.DryRunServer:
+		msg += " (server dry run)"
+	}
+	return msg
+}
+
+func dryRunOption(dryRunStrategy cmdutil.DryRunStrategy) []string {
+	if dryRunStrategy == cmdutil.DryRunServer {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// clientDryRun reads the live resource like a kubectl client dry run, which verifies that the impersonated user has
+// access to it. Resources which do not exist yet, possibly because their namespace is only created by the sync, pass.
+func clientDryRun(ctx context.Context, resourceIf dynamic.ResourceInterface, obj *unstructured.Unstructured) error {
+	if _, err := resourceIf.Get(ctx, obj.GetName(), metav1.GetOptions{}); err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// ApplyResource applies the resource with kubectl, or with server-side apply if requested. Server-side apply only
+// takes over fields owned by other field managers if the apply is forced.
+func (o *impersonatingResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool) (string, error) {
+	if !serverSideApply {
+		return o.applyClientSide(obj, dryRunStrategy, force, validate)
+	}
+	resourceIf, err := o.resourceInterface(obj)
+	if err != nil {
+		return "", err
+	}
+	if dryRunStrategy == cmdutil.DryRunClient {
+		if err := clientDryRun(ctx, resourceIf, obj); err != nil {
+			return "", err
+		}
+	}
+	return applyServerSide(ctx, resourceIf, obj, dryRunStrategy, force, o.fieldManager)
+}
+
+// applyClientSide runs `kubectl apply` with the impersonating kubeconfig, the same way the gitops engine does
+func (o *impersonatingResourceOperations) applyClientSide(obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate bool) (string, error) {
+	manifestBytes, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	manifestFile, err := ioutil.TempFile("", "manifest")
+	if err != nil {
+		return "", fmt.Errorf("failed to generate temp file for manifest: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(manifestFile.Name())
+	}()
+	if _, err = manifestFile.Write(manifestBytes); err != nil {
+		_ = manifestFile.Close()
+		return "", fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err = manifestFile.Close(); err != nil {
+		return "", fmt.Errorf("failed to close manifest: %w", err)
+	}
+
+	ioStreams := genericclioptions.IOStreams{In: &bytes.Buffer{}, Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+	applyOpts, err := o.newApplyOptions(ioStreams, obj, manifestFile.Name(), dryRunStrategy, force, validate)
+	if err != nil {
+		return "", err
+	}
+	if err := applyOpts.Run(); err != nil {
+		return "", errors.New(cleanKubectlOutput(err.Error()))
+	}
+	var out []string
+	if buf := strings.TrimSpace(ioStreams.Out.(*bytes.Buffer).String()); len(buf) > 0 {
+		out = append(out, buf)
+	}
+	if buf := strings.TrimSpace(ioStreams.ErrOut.(*bytes.Buffer).String()); len(buf) > 0 {
+		out = append(out, buf)
+	}
+	return strings.Join(out, ". "), nil
+}
+
+func (o *impersonatingResourceOperations) newApplyOptions(ioStreams genericclioptions.IOStreams, obj *unstructured.Unstructured, fileName string, dryRunStrategy cmdutil.DryRunStrategy, force, validate bool) (*apply.ApplyOptions, error) {
+	flags := apply.NewApplyFlags(o.fact, ioStreams)
+	opts := &apply.ApplyOptions{
+		IOStreams:         ioStreams,
+		VisitedUids:       sets.NewString(),
+		VisitedNamespaces: sets.NewString(),
+		Recorder:          genericclioptions.NoopRecorder{},
+		PrintFlags:        flags.PrintFlags,
+		Overwrite:         true,
+		OpenAPIPatch:      true,
+		DynamicClient:     o.dynamicIf,
+		OpenAPISchema:     o.openAPISchema,
+		Namespace:         obj.GetNamespace(),
+		DryRunStrategy:    dryRunStrategy,
+	}
+	var err error
+	opts.DeleteOptions, err = delete.NewDeleteFlags("").ToOptions(o.dynamicIf, ioStreams)
+	if err != nil {
+		return nil, err
+	}
+	opts.DeleteOptions.FilenameOptions.Filenames = []string{fileName}
+	opts.DeleteOptions.ForceDeletion = force
+	opts.Validator, err = o.fact.Validator(validate)
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := o.fact.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	opts.DryRunVerifier = resource.NewDryRunVerifier(o.dynamicIf, discoveryClient)
+	opts.Builder = o.fact.NewBuilder()
+	opts.Mapper, err = o.fact.ToRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+	opts.ToPrinter = func(operation string) (printers.ResourcePrinter, error) {
+		opts.PrintFlags.NamePrintFlags.Operation = operation
+		switch opts.DryRunStrategy {
+		case cmdutil.DryRunClient:
+			if err := opts.PrintFlags.Complete("%s (dry run)"); err != nil {
+				return nil, err
+			}
+		case cmdutil.DryRunServer:
+			if err := opts.PrintFlags.Complete("%s (server dry run)"); err != nil {
+				return nil, err
+			}
+		}
+		return opts.PrintFlags.ToPrinter()
+	}
+	return opts, nil
+}
+
+// ReplaceResource updates the resource, or deletes and creates it again if the replace is forced
+func (o *impersonatingResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool) (string, error) {
+	resourceIf, err := o.resourceInterface(obj)
+	if err != nil {
+		return "", err
+	}
+	if dryRunStrategy == cmdutil.DryRunClient {
+		if err := clientDryRun(ctx, resourceIf, obj); err != nil {
+			return "", err
+		}
+		return operationMessage(obj, "replaced", dryRunStrategy), nil
+	}
+	if !force {
+		if _, err := resourceIf.Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(dryRunStrategy)}); err != nil {
+			return "", err
+		}
+		return operationMessage(obj, "replaced", dryRunStrategy), nil
+	}
+	err = resourceIf.Delete(ctx, obj.GetName(), metav1.DeleteOptions{DryRun: dryRunOption(dryRunStrategy)})
+	if err != nil && !apierr.IsNotFound(err) {
+		return "", err
+	}
+	if dryRunStrategy == cmdutil.DryRunServer {
+		// the resource is not deleted by a dry run, so it cannot be created again
+		return operationMessage(obj, "replaced", dryRunStrategy), nil
+	}
+	err = wait.PollImmediate(time.Second, replaceDeletionTimeout, func() (bool, error) {
+		_, err := resourceIf.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return "", fmt.Errorf("error waiting for the deletion of %s: %w", obj.GetName(), err)
+	}
+	if _, err := resourceIf.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+		return "", err
+	}
+	return operationMessage(obj, "replaced", dryRunStrategy), nil
+}
+
+// CreateResource creates the resource
+func (o *impersonatingResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, validate bool) (string, error) {
+	resourceIf, err := o.resourceInterface(obj)
+	if err != nil {
+		return "", err
+	}
+	if dryRunStrategy == cmdutil.DryRunClient {
+		if err := clientDryRun(ctx, resourceIf, obj); err != nil {
+			return "", err
+		}
+		return operationMessage(obj, "created", dryRunStrategy), nil
+	}
+	if _, err := resourceIf.Create(ctx, obj, metav1.CreateOptions{DryRun: dryRunOption(dryRunStrategy)}); err != nil {
+		return "", err
+	}
+	return operationMessage(obj, "created", dryRunStrategy), nil
+}
+
+// UpdateResource updates the resource
+func (o *impersonatingResourceOperations) UpdateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy) (*unstructured.Unstructured, error) {
+	resourceIf, err := o.resourceInterface(obj)
+	if err != nil {
+		return nil, err
+	}
+	updateOptions := metav1.UpdateOptions{}
+	if dryRunStrategy != cmdutil.DryRunNone {
+		updateOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	return resourceIf.Update(ctx, obj, updateOptions)
+}
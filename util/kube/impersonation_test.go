@@ -0,0 +1,230 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	kubetesting "k8s.io/client-go/testing"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+func newDeployment() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+	obj.SetName("guestbook-ui")
+	obj.SetNamespace("default")
+	return obj
+}
+
+func newImpersonatingResourceOperations() (*impersonatingResourceOperations, *dynamicfake.FakeDynamicClient) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	dynamicIf := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Group: "apps", Version: "v1", Resource: "deployments"}: "DeploymentList",
+	})
+	return &impersonatingResourceOperations{dynamicIf: dynamicIf, mapper: mapper, fieldManager: "argocd-controller"}, dynamicIf
+}
+
+func TestImpersonatingKubectl_ManageResources(t *testing.T) {
+	kubectl := NewImpersonatingKubectl(&kubetest.MockKubectlCmd{}, "argocd-controller")
+
+	t.Run("no impersonation", func(t *testing.T) {
+		resourceOps, _, err := kubectl.ManageResources(&rest.Config{Host: "https://localhost"}, nil)
+		require.NoError(t, err)
+		assert.IsType(t, &kubetest.MockKubectlCmd{}, resourceOps)
+	})
+
+	t.Run("impersonation", func(t *testing.T) {
+		config := &rest.Config{Host: "https://localhost", Impersonate: rest.ImpersonationConfig{UserName: "system:serviceaccount:default:deployer"}}
+		resourceOps, cleanup, err := kubectl.ManageResources(config, nil)
+		require.NoError(t, err)
+		defer cleanup()
+		assert.IsType(t, &impersonatingResourceOperations{}, resourceOps)
+	})
+}
+
+func TestWriteImpersonatingKubeConfig(t *testing.T) {
+	config := &rest.Config{Host: "https://localhost", BearerToken: "token", Impersonate: rest.ImpersonationConfig{
+		UserName: "system:serviceaccount:default:deployer",
+		Groups:   []string{"deployers"},
+	}}
+	kubeConfigPath, err := writeImpersonatingKubeConfig(config)
+	require.NoError(t, err)
+	defer os.Remove(kubeConfigPath)
+
+	kubeConfig, err := clientcmd.LoadFromFile(kubeConfigPath)
+	require.NoError(t, err)
+	authInfo := kubeConfig.AuthInfos["https://localhost"]
+	require.NotNil(t, authInfo)
+	assert.Equal(t, "token", authInfo.Token)
+	assert.Equal(t, "system:serviceaccount:default:deployer", authInfo.Impersonate)
+	assert.Equal(t, []string{"deployers"}, authInfo.ImpersonateGroups)
+}
+
+// patchRecordingClient records the options of patch requests, which the fake dynamic client does not retain
+type patchRecordingClient struct {
+	dynamic.Interface
+	patchOptions *metav1.PatchOptions
+}
+
+func (c *patchRecordingClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &patchRecordingResource{NamespaceableResourceInterface: c.Interface.Resource(resource), client: c}
+}
+
+type patchRecordingResource struct {
+	dynamic.NamespaceableResourceInterface
+	client *patchRecordingClient
+}
+
+func (r *patchRecordingResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &patchRecordingResource{NamespaceableResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace).(dynamic.NamespaceableResourceInterface), client: r.client}
+}
+
+func (r *patchRecordingResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	r.client.patchOptions = &options
+	return r.NamespaceableResourceInterface.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func TestImpersonatingResourceOperations_ApplyResource(t *testing.T) {
+	newApplyingResourceOperations := func(t *testing.T) (*impersonatingResourceOperations, *patchRecordingClient) {
+		ops, dynamicIf := newImpersonatingResourceOperations()
+		dynamicIf.PrependReactor("patch", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, types.ApplyPatchType, action.(kubetesting.PatchActionImpl).GetPatchType())
+			return true, newDeployment(), nil
+		})
+		client := &patchRecordingClient{Interface: dynamicIf}
+		ops.dynamicIf = client
+		return ops, client
+	}
+
+	t.Run("server-side apply", func(t *testing.T) {
+		ops, client := newApplyingResourceOperations(t)
+		message, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunServer, false, true, true)
+		require.NoError(t, err)
+		assert.Equal(t, "deployment.apps/guestbook-ui serverside-applied (server dry run)", message)
+		require.NotNil(t, client.patchOptions)
+		assert.Equal(t, "argocd-controller", client.patchOptions.FieldManager)
+		assert.False(t, *client.patchOptions.Force)
+		assert.Equal(t, []string{metav1.DryRunAll}, client.patchOptions.DryRun)
+	})
+
+	t.Run("server-side apply conflict", func(t *testing.T) {
+		ops, dynamicIf := newImpersonatingResourceOperations()
+		dynamicIf.PrependReactor("patch", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "guestbook-ui", nil)
+		})
+		client := &patchRecordingClient{Interface: dynamicIf}
+		ops.dynamicIf = client
+		_, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunNone, false, true, true)
+		assert.True(t, errors.IsConflict(err))
+		require.NotNil(t, client.patchOptions)
+		assert.False(t, *client.patchOptions.Force)
+	})
+
+	t.Run("client dry run not permitted", func(t *testing.T) {
+		ops, dynamicIf := newImpersonatingResourceOperations()
+		dynamicIf.PrependReactor("get", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "guestbook-ui", nil)
+		})
+		_, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunClient, false, true, true)
+		assert.True(t, errors.IsForbidden(err))
+		for _, action := range dynamicIf.Actions() {
+			assert.NotEqual(t, "patch", action.GetVerb())
+		}
+	})
+
+	t.Run("client dry run of a new resource", func(t *testing.T) {
+		ops, _ := newImpersonatingResourceOperations()
+		message, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunClient, false, true, true)
+		require.NoError(t, err)
+		assert.Equal(t, "deployment.apps/guestbook-ui serverside-applied (dry run)", message)
+	})
+}
+
+// TestImpersonatingResourceOperations_ApplyResource_ClientSide applies a new deployment with kubectl and verifies that
+// the requests impersonate the user and the deployment is created with the last-applied annotation
+func TestImpersonatingResourceOperations_ApplyResource_ClientSide(t *testing.T) {
+	var impersonatedUsers []string
+	var created *unstructured.Unstructured
+	server := newFakeAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		impersonatedUsers = append(impersonatedUsers, r.Header.Get("Impersonate-User"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/apps/v1/namespaces/default/deployments/guestbook-ui":
+			writeResponse(t, w, http.StatusNotFound, &errors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "guestbook-ui").ErrStatus)
+		case r.Method == http.MethodPost && r.URL.Path == "/apis/apps/v1/namespaces/default/deployments":
+			created = &unstructured.Unstructured{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created.Object))
+			writeResponse(t, w, http.StatusCreated, created.Object)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	config := &rest.Config{Host: server.URL, Impersonate: rest.ImpersonationConfig{UserName: "system:serviceaccount:default:deployer"}}
+	resourceOps, cleanup, err := NewImpersonatingKubectl(&kubetest.MockKubectlCmd{}, "argocd-controller").ManageResources(config, nil)
+	require.NoError(t, err)
+	defer cleanup()
+
+	message, err := resourceOps.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunNone, false, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "deployment.apps/guestbook-ui created", message)
+	require.NotNil(t, created)
+	assert.Contains(t, created.GetAnnotations(), "kubectl.kubernetes.io/last-applied-configuration")
+	require.NotEmpty(t, impersonatedUsers)
+	for _, user := range impersonatedUsers {
+		assert.Equal(t, "system:serviceaccount:default:deployer", user)
+	}
+}
+
+func TestImpersonatingResourceOperations_ReplaceResource(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		ops, dynamicIf := newImpersonatingResourceOperations()
+		require.NoError(t, dynamicIf.Tracker().Add(newDeployment()))
+		updated := newDeployment()
+		updated.SetLabels(map[string]string{"foo": "bar"})
+		message, err := ops.ReplaceResource(context.Background(), updated, cmdutil.DryRunNone, false)
+		require.NoError(t, err)
+		assert.Equal(t, "deployment.apps/guestbook-ui replaced", message)
+		live, err := dynamicIf.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("default").Get(context.Background(), "guestbook-ui", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"foo": "bar"}, live.GetLabels())
+	})
+
+	t.Run("forced replace", func(t *testing.T) {
+		ops, dynamicIf := newImpersonatingResourceOperations()
+		require.NoError(t, dynamicIf.Tracker().Add(newDeployment()))
+		_, err := ops.ReplaceResource(context.Background(), newDeployment(), cmdutil.DryRunNone, true)
+		require.NoError(t, err)
+		var verbs []string
+		for _, action := range dynamicIf.Actions() {
+			verbs = append(verbs, action.GetVerb())
+		}
+		assert.Equal(t, []string{"delete", "get", "create"}, verbs)
+	})
+}
+
+func TestImpersonatingResourceOperations_CreateResource(t *testing.T) {
+	ops, dynamicIf := newImpersonatingResourceOperations()
+	dynamicIf.PrependReactor("create", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "guestbook-ui", nil)
+	})
+	_, err := ops.CreateResource(context.Background(), newDeployment(), cmdutil.DryRunNone, false)
+	assert.True(t, errors.IsForbidden(err))
+}
@@ -0,0 +1,110 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+)
+
+// NewServerSideApplyKubectl wraps the given kubectl so that resources applied with server-side apply are owned by
+// the given field manager. kubectl only sets a field manager for server-side apply from its command line flags, so
+// these resources are applied with a dynamic client instead. Conflicts with fields owned by other managers are
+// reported as apply errors unless the apply is forced.
+func NewServerSideApplyKubectl(kubectl kube.Kubectl, fieldManager string) kube.Kubectl {
+	return &serverSideApplyKubectl{Kubectl: kubectl, fieldManager: fieldManager}
+}
+
+type serverSideApplyKubectl struct {
+	kube.Kubectl
+	fieldManager string
+}
+
+func (k *serverSideApplyKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	resourceOps, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("error creating discovery client: %w", err)
+	}
+	return &serverSideApplyResourceOperations{
+		ResourceOperations: resourceOps,
+		dynamicIf:          dynamicIf,
+		mapper:             restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+		fieldManager:       k.fieldManager,
+	}, cleanup, nil
+}
+
+type serverSideApplyResourceOperations struct {
+	kube.ResourceOperations
+	dynamicIf    dynamic.Interface
+	mapper       meta.RESTMapper
+	fieldManager string
+}
+
+// ApplyResource applies the resource using server-side apply if requested, and falls back to the wrapped resource
+// operations otherwise
+func (o *serverSideApplyResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool) (string, error) {
+	if !serverSideApply {
+		return o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply)
+	}
+	resourceIf, err := resourceInterface(o.dynamicIf, o.mapper, obj)
+	if err != nil {
+		return "", err
+	}
+	return applyServerSide(ctx, resourceIf, obj, dryRunStrategy, force, o.fieldManager)
+}
+
+// resourceInterface returns the client of the type of the given resource
+func resourceInterface(dynamicIf dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return dynamicIf.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+	}
+	return dynamicIf.Resource(mapping.Resource), nil
+}
+
+// applyServerSide applies the resource with server-side apply as the given field manager. Fields owned by other
+// managers are only taken over if the apply is forced. Server-side apply can only be verified by the API server, so
+// a client dry run only checks the resource type.
+func applyServerSide(ctx context.Context, resourceIf dynamic.ResourceInterface, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool, fieldManager string) (string, error) {
+	if dryRunStrategy == cmdutil.DryRunClient {
+		return operationMessage(obj, "serverside-applied", dryRunStrategy), nil
+	}
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	_, err = resourceIf.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        &force,
+		DryRun:       dryRunOption(dryRunStrategy),
+	})
+	if err != nil {
+		return "", err
+	}
+	return operationMessage(obj, "serverside-applied", dryRunStrategy), nil
+}
@@ -0,0 +1,184 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	kubetesting "k8s.io/client-go/testing"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+const conflictMessage = `Apply failed with 1 conflict: conflict with "kubectl-client-side-apply" using apps/v1: .spec.replicas`
+
+// newFakeAPIServer starts an API server which serves the discovery of deployments and passes all other requests to
+// the given handler
+func newFakeAPIServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	discovery := map[string]interface{}{
+		"/api": &metav1.APIVersions{Versions: []string{"v1"}},
+		"/apis": &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name:             "apps",
+			Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "apps/v1", Version: "v1"}},
+			PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "apps/v1", Version: "v1"},
+		}}},
+		"/api/v1": &metav1.APIResourceList{GroupVersion: "v1"},
+		"/apis/apps/v1": &metav1.APIResourceList{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{
+			Name:       "deployments",
+			Namespaced: true,
+			Kind:       "Deployment",
+			Verbs:      metav1.Verbs{"create", "delete", "get", "list", "patch", "update", "watch"},
+		}}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if res, ok := discovery[r.URL.Path]; ok {
+			writeResponse(t, w, http.StatusOK, res)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeResponse(t *testing.T, w http.ResponseWriter, status int, res interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	assert.NoError(t, json.NewEncoder(w).Encode(res))
+}
+
+func writeConflict(t *testing.T, w http.ResponseWriter) {
+	writeResponse(t, w, http.StatusConflict, &metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Reason:   metav1.StatusReasonConflict,
+		Code:     http.StatusConflict,
+		Message:  conflictMessage,
+	})
+}
+
+func TestServerSideApplyKubectl_ManageResources(t *testing.T) {
+	kubectl := NewServerSideApplyKubectl(&kubetest.MockKubectlCmd{}, "argocd-controller")
+	resourceOps, cleanup, err := kubectl.ManageResources(&rest.Config{Host: "https://localhost"}, nil)
+	require.NoError(t, err)
+	defer cleanup()
+	ops, ok := resourceOps.(*serverSideApplyResourceOperations)
+	require.True(t, ok)
+	assert.Equal(t, "argocd-controller", ops.fieldManager)
+}
+
+func TestServerSideApplyResourceOperations_ApplyResource(t *testing.T) {
+	newServerSideApplyResourceOperations := func(t *testing.T) (*serverSideApplyResourceOperations, *kubetest.MockKubectlCmd, *patchRecordingClient) {
+		impersonatingOps, dynamicIf := newImpersonatingResourceOperations()
+		dynamicIf.PrependReactor("patch", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, types.ApplyPatchType, action.(kubetesting.PatchActionImpl).GetPatchType())
+			return true, newDeployment(), nil
+		})
+		client := &patchRecordingClient{Interface: dynamicIf}
+		kubectl := &kubetest.MockKubectlCmd{}
+		return &serverSideApplyResourceOperations{
+			ResourceOperations: kubectl,
+			dynamicIf:          client,
+			mapper:             impersonatingOps.mapper,
+			fieldManager:       "argocd-controller",
+		}, kubectl, client
+	}
+
+	t.Run("server-side apply", func(t *testing.T) {
+		ops, _, client := newServerSideApplyResourceOperations(t)
+		message, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunNone, false, true, true)
+		require.NoError(t, err)
+		assert.Equal(t, "deployment.apps/guestbook-ui serverside-applied", message)
+		require.NotNil(t, client.patchOptions)
+		assert.Equal(t, "argocd-controller", client.patchOptions.FieldManager)
+		assert.False(t, *client.patchOptions.Force)
+		assert.Empty(t, client.patchOptions.DryRun)
+	})
+
+	t.Run("forced server-side apply", func(t *testing.T) {
+		ops, _, client := newServerSideApplyResourceOperations(t)
+		_, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunServer, true, true, true)
+		require.NoError(t, err)
+		require.NotNil(t, client.patchOptions)
+		assert.True(t, *client.patchOptions.Force)
+		assert.Equal(t, []string{metav1.DryRunAll}, client.patchOptions.DryRun)
+	})
+
+	t.Run("client dry run", func(t *testing.T) {
+		ops, _, client := newServerSideApplyResourceOperations(t)
+		message, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunClient, false, true, true)
+		require.NoError(t, err)
+		assert.Equal(t, "deployment.apps/guestbook-ui serverside-applied (dry run)", message)
+		assert.Nil(t, client.patchOptions)
+	})
+
+	t.Run("client-side apply", func(t *testing.T) {
+		ops, kubectl, client := newServerSideApplyResourceOperations(t)
+		_, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunNone, false, true, false)
+		require.NoError(t, err)
+		assert.Nil(t, client.patchOptions)
+		assert.Equal(t, "apply", kubectl.GetLastResourceCommand(kube.GetResourceKey(newDeployment())))
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		impersonatingOps, dynamicIf := newImpersonatingResourceOperations()
+		dynamicIf.PrependReactor("patch", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "guestbook-ui", nil)
+		})
+		ops := &serverSideApplyResourceOperations{dynamicIf: dynamicIf, mapper: impersonatingOps.mapper, fieldManager: "argocd-controller"}
+		_, err := ops.ApplyResource(context.Background(), newDeployment(), cmdutil.DryRunNone, false, true, true)
+		assert.True(t, errors.IsConflict(err))
+	})
+}
+
+// TestServerSideApplyKubectl_Sync syncs a deployment whose replicas are owned by another field manager and verifies
+// that the conflict is reported in the result of the resource
+func TestServerSideApplyKubectl_Sync(t *testing.T) {
+	var fieldManagers []string
+	server := newFakeAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch && r.URL.Path == "/apis/apps/v1/namespaces/default/deployments/guestbook-ui" {
+			fieldManagers = append(fieldManagers, r.URL.Query().Get("fieldManager"))
+			writeConflict(t, w)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+	config := &rest.Config{Host: server.URL}
+	target := newDeployment()
+	require.NoError(t, unstructured.SetNestedField(target.Object, int64(2), "spec", "replicas"))
+
+	syncCtx, cleanup, err := sync.NewSyncContext(
+		"abc123",
+		sync.ReconciliationResult{Target: []*unstructured.Unstructured{target}, Live: []*unstructured.Unstructured{nil}},
+		config,
+		config,
+		NewServerSideApplyKubectl(&kubetest.MockKubectlCmd{}, "argocd-controller"),
+		"default",
+		nil,
+		sync.WithServerSideApply(true),
+	)
+	require.NoError(t, err)
+	defer cleanup()
+	syncCtx.Sync()
+
+	phase, _, resources := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	require.Len(t, resources, 1)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, resources[0].Status)
+	assert.Contains(t, resources[0].Message, conflictMessage)
+	assert.Equal(t, []string{"argocd-controller"}, fieldManagers)
+}
@@ -0,0 +1,99 @@
+package oci
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// DefaultBlobCacheSize is the default maximum combined size of the blobs cached by a BlobCache
+const DefaultBlobCacheSize int64 = 2 << 30
+
+// BlobCache caches pulled blobs in a directory, and removes the least recently used blobs once their combined size
+// exceeds the maximum size
+type BlobCache struct {
+	root    string
+	maxSize int64
+
+	lock    sync.Mutex
+	size    int64
+	used    uint64
+	entries map[string]*cachedBlob
+}
+
+type cachedBlob struct {
+	path     string
+	size     int64
+	lastUsed uint64
+}
+
+// NewBlobCache returns a blob cache which stores the blobs in the given directory. A maximum size of zero or less
+// disables the eviction of blobs.
+func NewBlobCache(root string, maxSize int64) *BlobCache {
+	return &BlobCache{root: root, maxSize: maxSize, entries: map[string]*cachedBlob{}}
+}
+
+// open opens the cached blob with the given key. Blobs which are open when they are evicted remain readable until they
+// are closed.
+func (c *BlobCache) open(key string) (*os.File, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	f, err := os.Open(entry.path)
+	if err != nil {
+		c.remove(key)
+		return nil, false
+	}
+	c.used++
+	entry.lastUsed = c.used
+	return f, true
+}
+
+// add moves the file at the given path into the cache, evicts the least recently used other blobs if the cache
+// exceeds its maximum size, and opens the added blob
+func (c *BlobCache) add(key string, path string, size int64) (*os.File, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	cachedPath := filepath.Join(c.root, id.String())
+	if err := os.Rename(path, cachedPath); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(cachedPath)
+	if err != nil {
+		_ = os.Remove(cachedPath)
+		return nil, err
+	}
+	c.remove(key)
+	c.used++
+	c.entries[key] = &cachedBlob{path: cachedPath, size: size, lastUsed: c.used}
+	c.size += size
+	for c.maxSize > 0 && c.size > c.maxSize && len(c.entries) > 1 {
+		var oldest string
+		for k, entry := range c.entries {
+			if k != key && (oldest == "" || entry.lastUsed < c.entries[oldest].lastUsed) {
+				oldest = k
+			}
+		}
+		c.remove(oldest)
+	}
+	return f, nil
+}
+
+func (c *BlobCache) remove(key string) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	_ = os.Remove(entry.path)
+	c.size -= entry.size
+	delete(c.entries, key)
+}
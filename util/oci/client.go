@@ -0,0 +1,616 @@
+package oci
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	argosync "github.com/argoproj/pkg/sync"
+	log "github.com/sirupsen/logrus"
+
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+	"github.com/argoproj/argo-cd/v2/util/proxy"
+)
+
+const (
+	// ociScheme is the scheme of the URLs of OCI repositories
+	ociScheme = "oci://"
+	// defaultTag is the tag which is pulled if no revision is given
+	defaultTag = "latest"
+	// DefaultMaxExtractedSize is the default maximum size of the layers of an artifact, and of the files extracted
+	// from them
+	DefaultMaxExtractedSize int64 = 1 << 30
+
+	mediaTypeImageManifest  = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeImageIndex     = "application/vnd.oci.image.index.v1+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// annotationTitle holds the name of a file pushed as a layer, e.g. by `oras push`
+	annotationTitle = "org.opencontainers.image.title"
+	// annotationUnpack indicates that a layer holding a titled directory has to be unpacked
+	annotationUnpack = "io.deis.oras.content.unpack"
+	// annotationCreated, annotationAuthors and annotationDescription hold the metadata of an artifact
+	annotationCreated     = "org.opencontainers.image.created"
+	annotationAuthors     = "org.opencontainers.image.authors"
+	annotationDescription = "org.opencontainers.image.description"
+)
+
+// DefaultLayerMediaTypes are the media types of the artifact layers which are extracted by default
+var DefaultLayerMediaTypes = []string{
+	"application/vnd.oci.image.layer.v1.tar",
+	"application/vnd.oci.image.layer.v1.tar+gzip",
+	"application/vnd.cncf.argoproj.cd.content.v1.tar+gzip",
+}
+
+var (
+	globalLock      = argosync.NewKeyLock()
+	globalBlobCache = NewBlobCache(os.TempDir(), DefaultBlobCacheSize)
+
+	// ErrNotFound is returned if the requested manifest or blob does not exist in the repository
+	ErrNotFound = errors.New("not found")
+
+	digestRegex    = regexp.MustCompile(`^(sha256:[a-f0-9]{64}|sha512:[a-f0-9]{128})$`)
+	challengeRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+)
+
+// Creds holds the credentials used to access an OCI registry
+type Creds struct {
+	Username           string
+	Password           string
+	CAPath             string
+	CertData           []byte
+	KeyData            []byte
+	InsecureSkipVerify bool
+}
+
+// Descriptor describes a blob of an OCI artifact
+type Descriptor struct {
+	MediaType   string            `json:"mediaType,omitempty"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is the manifest of an OCI artifact
+type Manifest struct {
+	MediaType   string            `json:"mediaType,omitempty"`
+	Config      Descriptor        `json:"config"`
+	Layers      []Descriptor      `json:"layers"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Created returns the creation time annotation of the artifact
+func (m *Manifest) Created() string {
+	return m.Annotations[annotationCreated]
+}
+
+// Authors returns the authors annotation of the artifact
+func (m *Manifest) Authors() string {
+	return m.Annotations[annotationAuthors]
+}
+
+// Description returns the description annotation of the artifact
+func (m *Manifest) Description() string {
+	return m.Annotations[annotationDescription]
+}
+
+type Client interface {
+	// ResolveRevision resolves the given tag or digest to the digest of the artifact manifest
+	ResolveRevision(ctx context.Context, revision string) (string, error)
+	// GetManifest returns the manifest of the artifact with the given digest
+	GetManifest(ctx context.Context, digest string) (*Manifest, error)
+	// Extract extracts the layers of the artifact with the given digest into a temporary directory, which is removed
+	// by the returned closer. Fails if a layer or the extracted files exceed the maximum extracted size.
+	Extract(ctx context.Context, digest string) (string, argoio.Closer, error)
+	// GetBlob returns the content of the given blob, which is cached like the extracted layers
+	GetBlob(ctx context.Context, blob Descriptor) ([]byte, error)
+	// TestRepo tests whether the repository is accessible
+	TestRepo(ctx context.Context) (bool, error)
+}
+
+type ClientOpts func(c *nativeOCIClient)
+
+// WithBlobCache sets the cache of the pulled layers
+func WithBlobCache(blobCache *BlobCache) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.blobCache = blobCache
+	}
+}
+
+// WithTempDir sets the directory in which artifacts are extracted
+func WithTempDir(tempDir string) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.tempDir = tempDir
+	}
+}
+
+// WithMaxExtractedSize sets the maximum size of the layers of an artifact, and of the files extracted from them. A
+// size of zero or less disables the limit.
+func WithMaxExtractedSize(size int64) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.maxExtractedSize = size
+	}
+}
+
+// WithLayerMediaTypes sets the media types of the artifact layers which are extracted
+func WithLayerMediaTypes(mediaTypes []string) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.layerMediaTypes = mediaTypes
+	}
+}
+
+// IsOCIRepo returns whether the given repository URL refers to an OCI repository
+func IsOCIRepo(repoURL string) bool {
+	return strings.HasPrefix(strings.ToLower(repoURL), ociScheme)
+}
+
+func NewClient(repoURL string, creds Creds, proxy string, opts ...ClientOpts) (Client, error) {
+	return NewClientWithLock(repoURL, creds, globalLock, proxy, opts...)
+}
+
+func NewClientWithLock(repoURL string, creds Creds, repoLock argosync.KeyLock, proxy string, opts ...ClientOpts) (Client, error) {
+	if !IsOCIRepo(repoURL) {
+		return nil, fmt.Errorf("OCI repository URL should start with %s: %s", ociScheme, repoURL)
+	}
+	registry, repository, ok := strings.Cut(repoURL[len(ociScheme):], "/")
+	repository = strings.Trim(repository, "/")
+	if !ok || registry == "" || repository == "" {
+		return nil, fmt.Errorf("OCI repository URL should include the registry and the repository: %s", repoURL)
+	}
+	c := &nativeOCIClient{
+		repoURL:         repoURL,
+		registry:        registry,
+		repository:      repository,
+		creds:           creds,
+		repoLock:         repoLock,
+		proxy:            proxy,
+		blobCache:        globalBlobCache,
+		tempDir:          os.TempDir(),
+		maxExtractedSize: DefaultMaxExtractedSize,
+		layerMediaTypes:  DefaultLayerMediaTypes,
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c, nil
+}
+
+var _ Client = &nativeOCIClient{}
+
+type nativeOCIClient struct {
+	blobCache        *BlobCache
+	tempDir          string
+	maxExtractedSize int64
+	repoURL          string
+	registry         string
+	repository       string
+	creds            Creds
+	repoLock         argosync.KeyLock
+	proxy            string
+	layerMediaTypes  []string
+
+	tokenLock sync.Mutex
+	token     string
+}
+
+func (c *nativeOCIClient) ResolveRevision(ctx context.Context, revision string) (string, error) {
+	if revision == "" {
+		revision = defaultTag
+	}
+	if digestRegex.MatchString(revision) {
+		return revision, nil
+	}
+	resp, err := c.do(ctx, http.MethodHead, "manifests/"+url.PathEscape(revision), manifestMediaTypes())
+	if err != nil {
+		return "", fmt.Errorf("error resolving tag %s: %w", revision, err)
+	}
+	_ = resp.Body.Close()
+	if digest := resp.Header.Get("Docker-Content-Digest"); digestRegex.MatchString(digest) {
+		return digest, nil
+	}
+	// not all registries return the digest of the manifest when its headers are requested
+	resp, err = c.do(ctx, http.MethodGet, "manifests/"+url.PathEscape(revision), manifestMediaTypes())
+	if err != nil {
+		return "", fmt.Errorf("error resolving tag %s: %w", revision, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	h := sha256.New()
+	if _, err := io.Copy(h, resp.Body); err != nil {
+		return "", fmt.Errorf("error resolving tag %s: %w", revision, err)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (c *nativeOCIClient) GetManifest(ctx context.Context, digest string) (*Manifest, error) {
+	if !digestRegex.MatchString(digest) {
+		return nil, fmt.Errorf("invalid digest: %s", digest)
+	}
+	resp, err := c.do(ctx, http.MethodGet, "manifests/"+digest, manifestMediaTypes())
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifest %s: %w", digest, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest %s: %w", digest, err)
+	}
+	if err := verifyDigest(digest, data); err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("error unmarshaling manifest %s: %w", digest, err)
+	}
+	if manifest.MediaType == mediaTypeImageIndex {
+		return nil, fmt.Errorf("manifest %s is an image index, which is not supported", digest)
+	}
+	return manifest, nil
+}
+
+func (c *nativeOCIClient) Extract(ctx context.Context, digest string) (string, argoio.Closer, error) {
+	manifest, err := c.GetManifest(ctx, digest)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// throw away temp directory that stores the extracted artifact and should be deleted as soon as no longer needed
+	// by returned closer
+	tempDir, err := files.CreateTempDir(c.tempDir)
+	if err != nil {
+		return "", nil, err
+	}
+	closer := argoio.NewCloser(func() error {
+		return os.RemoveAll(tempDir)
+	})
+
+	limit := &sizeLimit{max: c.maxExtractedSize, remaining: c.maxExtractedSize}
+	extracted := 0
+	for _, layer := range manifest.Layers {
+		if !c.isAcceptedMediaType(layer.MediaType) {
+			log.Debugf("Skipping layer %s of artifact %s with media type %s", layer.Digest, digest, layer.MediaType)
+			continue
+		}
+		blob, err := c.pullBlob(ctx, layer)
+		if err == nil {
+			err = extractLayer(tempDir, blob, layer, limit)
+			_ = blob.Close()
+		}
+		if err != nil {
+			argoio.Close(closer)
+			return "", nil, fmt.Errorf("error extracting layer %s of artifact %s: %w", layer.Digest, digest, err)
+		}
+		extracted++
+	}
+	if extracted == 0 {
+		argoio.Close(closer)
+		return "", nil, fmt.Errorf("artifact %s has no layers of the accepted media types: %s", digest, strings.Join(c.layerMediaTypes, ", "))
+	}
+	return tempDir, closer, nil
+}
+
+func (c *nativeOCIClient) GetBlob(ctx context.Context, blob Descriptor) ([]byte, error) {
+	f, err := c.pullBlob(ctx, blob)
+	if err != nil {
+		return nil, fmt.Errorf("error pulling blob %s: %w", blob.Digest, err)
+	}
+	defer func() { _ = f.Close() }()
+	return io.ReadAll(f)
+}
+
+func (c *nativeOCIClient) TestRepo(ctx context.Context) (bool, error) {
+	resp, err := c.do(ctx, http.MethodGet, "tags/list", nil)
+	if err != nil {
+		return false, err
+	}
+	_ = resp.Body.Close()
+	return true, nil
+}
+
+func (c *nativeOCIClient) isAcceptedMediaType(mediaType string) bool {
+	for _, accepted := range c.layerMediaTypes {
+		if mediaType == accepted {
+			return true
+		}
+	}
+	return false
+}
+
+// pullBlob downloads the given layer, unless it is cached, and returns the opened cached layer. Layers which exceed
+// the maximum extracted size are not downloaded.
+func (c *nativeOCIClient) pullBlob(ctx context.Context, layer Descriptor) (*os.File, error) {
+	if !digestRegex.MatchString(layer.Digest) {
+		return nil, fmt.Errorf("invalid digest: %s", layer.Digest)
+	}
+	limit := &sizeLimit{max: c.maxExtractedSize, remaining: c.maxExtractedSize}
+	if limit.exceeded(layer.Size) {
+		return nil, fmt.Errorf("size of %d bytes exceeds the maximum of %d bytes", layer.Size, limit.max)
+	}
+	keyData, err := json.Marshal(map[string]string{"url": c.repoURL, "digest": layer.Digest})
+	if err != nil {
+		return nil, err
+	}
+	key := string(keyData)
+
+	c.repoLock.Lock(key)
+	defer c.repoLock.Unlock(key)
+
+	if f, ok := c.blobCache.open(key); ok {
+		return f, nil
+	}
+
+	resp, err := c.do(ctx, http.MethodGet, "blobs/"+layer.Digest, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	tempFile, err := os.CreateTemp(c.blobCache.root, "blob")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(tempFile.Name()) }()
+	h := newDigester(layer.Digest)
+	size, err := io.Copy(io.MultiWriter(tempFile, h), limit.reader(resp.Body))
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if actual := digestString(layer.Digest, h); actual != layer.Digest {
+		return nil, fmt.Errorf("digest mismatch: expected %s but got %s", layer.Digest, actual)
+	}
+	return c.blobCache.add(key, tempFile.Name(), size)
+}
+
+// sizeLimit limits the combined size of the data read using its readers. A maximum of zero or less disables the limit.
+type sizeLimit struct {
+	max       int64
+	remaining int64
+}
+
+func (l *sizeLimit) exceeded(size int64) bool {
+	return l.max > 0 && size > l.remaining
+}
+
+func (l *sizeLimit) reader(r io.Reader) io.Reader {
+	if l.max <= 0 {
+		return r
+	}
+	return &sizeLimitReader{r: r, limit: l}
+}
+
+type sizeLimitReader struct {
+	r     io.Reader
+	limit *sizeLimit
+}
+
+func (r *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.limit.remaining -= int64(n)
+	if r.limit.remaining < 0 {
+		return n, fmt.Errorf("size exceeds the maximum of %d bytes", r.limit.max)
+	}
+	return n, err
+}
+
+// extractLayer extracts the given layer into the given directory. Layers which hold a single file are copied as is,
+// while all other layers are expected to be (compressed) tar archives. The size of the extracted files is limited by
+// the given limit.
+func extractLayer(dstPath string, f io.Reader, layer Descriptor, limit *sizeLimit) error {
+	if title := layer.Annotations[annotationTitle]; title != "" && layer.Annotations[annotationUnpack] != "true" {
+		target := filepath.Join(dstPath, title)
+		if !files.Inbound(target, dstPath) {
+			return fmt.Errorf("illegal filepath in layer title: %s", title)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		out, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(out, limit.reader(f))
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+
+	r := bufio.NewReader(f)
+	if magic, err := r.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzr, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("error reading file: %w", err)
+		}
+		defer func() { _ = gzr.Close() }()
+		return files.Untar(dstPath, limit.reader(gzr))
+	}
+	return files.Untar(dstPath, limit.reader(r))
+}
+
+// do sends a request to the given path of the repository API, and authenticates with a bearer token if the registry
+// requests it
+func (c *nativeOCIClient) do(ctx context.Context, method string, path string, accept []string) (*http.Response, error) {
+	client, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	requestURL := fmt.Sprintf("https://%s/v2/%s/%s", c.registry, c.repository, path)
+	send := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, method, requestURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(accept) > 0 {
+			req.Header.Set("Accept", strings.Join(accept, ", "))
+		}
+		c.tokenLock.Lock()
+		token := c.token
+		c.tokenLock.Unlock()
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		} else if c.creds.Username != "" || c.creds.Password != "" {
+			req.SetBasicAuth(c.creds.Username, c.creds.Password)
+		}
+		return client.Do(req)
+	}
+
+	resp, err := send()
+	if err != nil {
+		return nil, err
+	}
+	if challenge := resp.Header.Get("WWW-Authenticate"); resp.StatusCode == http.StatusUnauthorized && strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		_ = resp.Body.Close()
+		token, err := c.fetchToken(ctx, client, challenge)
+		if err != nil {
+			return nil, fmt.Errorf("error getting registry token: %w", err)
+		}
+		c.tokenLock.Lock()
+		c.token = token
+		c.tokenLock.Unlock()
+		if resp, err = send(); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s %s: %s", ErrNotFound, method, requestURL, resp.Status)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%s %s: %s", method, requestURL, resp.Status)
+	}
+	return resp, nil
+}
+
+// fetchToken requests a bearer token from the authorization server given in the challenge of the registry
+func (c *nativeOCIClient) fetchToken(ctx context.Context, client *http.Client, challenge string) (string, error) {
+	params := map[string]string{}
+	for _, match := range challengeRegex.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid realm in challenge: %s", challenge)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	if params["scope"] == "" {
+		query.Set("scope", fmt.Sprintf("repository:%s:pull", c.repository))
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.creds.Username != "" || c.creds.Password != "" {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New("failed to get token: " + resp.Status)
+	}
+	var tokenResponse struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return "", err
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	if tokenResponse.AccessToken != "" {
+		return tokenResponse.AccessToken, nil
+	}
+	return "", errors.New("token response contains no token")
+}
+
+func (c *nativeOCIClient) httpClient() (*http.Client, error) {
+	tlsConf, err := newTLSConfig(c.creds)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: &http.Transport{
+		Proxy:           proxy.GetCallback(c.proxy),
+		TLSClientConfig: tlsConf,
+	}}, nil
+}
+
+func newTLSConfig(creds Creds) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+
+	if creds.CAPath != "" {
+		caData, err := os.ReadFile(creds.CAPath)
+		if err != nil {
+			return nil, err
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caData)
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// If a client cert & key is provided then configure TLS config accordingly.
+	if len(creds.CertData) > 0 && len(creds.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(creds.CertData, creds.KeyData)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func manifestMediaTypes() []string {
+	return []string{mediaTypeImageManifest, mediaTypeDockerManifest}
+}
+
+func newDigester(digest string) hash.Hash {
+	if strings.HasPrefix(digest, "sha512:") {
+		return sha512.New()
+	}
+	return sha256.New()
+}
+
+func digestString(digest string, h hash.Hash) string {
+	algorithm, _, _ := strings.Cut(digest, ":")
+	return algorithm + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+func verifyDigest(digest string, data []byte) error {
+	h := newDigester(digest)
+	_, _ = h.Write(data)
+	if actual := digestString(digest, h); actual != digest {
+		return fmt.Errorf("digest mismatch: expected %s but got %s", digest, actual)
+	}
+	return nil
+}
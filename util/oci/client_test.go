@@ -0,0 +1,353 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func tarball(t *testing.T, compress bool, files map[string]string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	var tw *tar.Writer
+	var gzw *gzip.Writer
+	if compress {
+		gzw = gzip.NewWriter(buf)
+		tw = tar.NewWriter(gzw)
+	} else {
+		tw = tar.NewWriter(buf)
+	}
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	if gzw != nil {
+		require.NoError(t, gzw.Close())
+	}
+	return buf.Bytes()
+}
+
+// fakeRegistry serves a single repository holding artifacts with the given layers, tagged by their name
+type fakeRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	// token is requested by the registry if set
+	token    string
+	username string
+	password string
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+}
+
+func (r *fakeRegistry) push(t *testing.T, tag string, layers ...Descriptor) string {
+	t.Helper()
+	manifest := Manifest{
+		MediaType:   mediaTypeImageManifest,
+		Config:      Descriptor{MediaType: "application/vnd.oci.empty.v1+json", Digest: digestOf([]byte("{}")), Size: 2},
+		Layers:      layers,
+		Annotations: map[string]string{annotationCreated: "2023-05-01T10:00:00Z", annotationAuthors: "argo"},
+	}
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	digest := digestOf(data)
+	r.manifests[tag] = data
+	r.manifests[digest] = data
+	return digest
+}
+
+func (r *fakeRegistry) layer(mediaType string, data []byte, annotations map[string]string) Descriptor {
+	digest := digestOf(data)
+	r.blobs[digest] = data
+	return Descriptor{MediaType: mediaType, Digest: digest, Size: int64(len(data)), Annotations: annotations}
+}
+
+func (r *fakeRegistry) start(t *testing.T) string {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/token" {
+			if username, password, _ := req.BasicAuth(); username != r.username || password != r.password {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": r.token})
+			return
+		}
+		if r.token != "" && req.Header.Get("Authorization") != "Bearer "+r.token {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		path := strings.TrimPrefix(req.URL.Path, "/v2/org/app/")
+		switch {
+		case path == "tags/list":
+			_, _ = w.Write([]byte(`{"name":"org/app","tags":[]}`))
+		case strings.HasPrefix(path, "manifests/"):
+			data, ok := r.manifests[strings.TrimPrefix(path, "manifests/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", mediaTypeImageManifest)
+			w.Header().Set("Docker-Content-Digest", digestOf(data))
+			if req.Method == http.MethodGet {
+				_, _ = w.Write(data)
+			}
+		case strings.HasPrefix(path, "blobs/"):
+			data, ok := r.blobs[strings.TrimPrefix(path, "blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return "oci://" + strings.TrimPrefix(server.URL, "https://") + "/org/app"
+}
+
+func TestIsOCIRepo(t *testing.T) {
+	assert.True(t, IsOCIRepo("oci://ghcr.io/argoproj/manifests"))
+	assert.True(t, IsOCIRepo("OCI://ghcr.io/argoproj/manifests"))
+	assert.False(t, IsOCIRepo("https://github.com/argoproj/argocd-example-apps"))
+	assert.False(t, IsOCIRepo("ghcr.io/argoproj/manifests"))
+}
+
+func TestNewClient_InvalidURL(t *testing.T) {
+	_, err := NewClient("https://ghcr.io/argoproj/manifests", Creds{}, "")
+	assert.Error(t, err)
+	_, err = NewClient("oci://ghcr.io", Creds{}, "")
+	assert.Error(t, err)
+}
+
+func TestResolveRevision(t *testing.T) {
+	registry := newFakeRegistry()
+	digest := registry.push(t, "latest")
+	repoURL := registry.start(t)
+	client, err := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "")
+	require.NoError(t, err)
+
+	t.Run("Tag", func(t *testing.T) {
+		resolved, err := client.ResolveRevision(context.Background(), "latest")
+		require.NoError(t, err)
+		assert.Equal(t, digest, resolved)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		resolved, err := client.ResolveRevision(context.Background(), "")
+		require.NoError(t, err)
+		assert.Equal(t, digest, resolved)
+	})
+	t.Run("Digest", func(t *testing.T) {
+		other := digestOf([]byte("other"))
+		resolved, err := client.ResolveRevision(context.Background(), other)
+		require.NoError(t, err)
+		assert.Equal(t, other, resolved)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := client.ResolveRevision(context.Background(), "v9.9.9")
+		assert.ErrorContains(t, err, "404")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestExtract(t *testing.T) {
+	registry := newFakeRegistry()
+	manifests := tarball(t, true, map[string]string{"deploy/deployment.yaml": "kind: Deployment"})
+	config := tarball(t, false, map[string]string{"deploy/configmap.yaml": "kind: ConfigMap"})
+	digest := registry.push(t, "v1",
+		registry.layer("application/vnd.oci.image.layer.v1.tar+gzip", manifests, nil),
+		registry.layer("application/vnd.oci.image.layer.v1.tar", config, nil),
+		registry.layer("application/vnd.example.ignored", []byte("ignored"), nil),
+	)
+	titled := registry.push(t, "v2",
+		registry.layer("application/vnd.oci.image.layer.v1.tar", []byte("kind: Service"), map[string]string{annotationTitle: "service.yaml"}),
+	)
+	unknown := registry.push(t, "v3",
+		registry.layer("application/vnd.example.ignored", []byte("ignored"), nil),
+	)
+	repoURL := registry.start(t)
+	blobCache := NewBlobCache(t.TempDir(), 0)
+	tempDir := t.TempDir()
+	client, err := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "", WithBlobCache(blobCache), WithTempDir(tempDir))
+	require.NoError(t, err)
+
+	t.Run("Layers", func(t *testing.T) {
+		path, closer, err := client.Extract(context.Background(), digest)
+		require.NoError(t, err)
+		defer argoio.Close(closer)
+		data, err := os.ReadFile(filepath.Join(path, "deploy", "deployment.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Deployment", string(data))
+		assert.Equal(t, tempDir, filepath.Dir(path))
+		data, err = os.ReadFile(filepath.Join(path, "deploy", "configmap.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: ConfigMap", string(data))
+
+		// the layers are cached, so the artifact can be extracted again without the blobs
+		blobs := registry.blobs
+		registry.blobs = map[string][]byte{}
+		defer func() { registry.blobs = blobs }()
+		otherPath, otherCloser, err := client.Extract(context.Background(), digest)
+		require.NoError(t, err)
+		defer argoio.Close(otherCloser)
+		assert.NotEqual(t, path, otherPath)
+		assert.FileExists(t, filepath.Join(otherPath, "deploy", "deployment.yaml"))
+	})
+	t.Run("TitledLayer", func(t *testing.T) {
+		path, closer, err := client.Extract(context.Background(), titled)
+		require.NoError(t, err)
+		defer argoio.Close(closer)
+		data, err := os.ReadFile(filepath.Join(path, "service.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Service", string(data))
+	})
+	t.Run("NoAcceptedLayers", func(t *testing.T) {
+		_, _, err := client.Extract(context.Background(), unknown)
+		assert.ErrorContains(t, err, "has no layers of the accepted media types")
+	})
+	t.Run("CustomMediaTypes", func(t *testing.T) {
+		client, err := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "", WithBlobCache(blobCache), WithLayerMediaTypes([]string{"application/vnd.oci.image.layer.v1.tar"}))
+		require.NoError(t, err)
+		path, closer, err := client.Extract(context.Background(), digest)
+		require.NoError(t, err)
+		defer argoio.Close(closer)
+		assert.NoFileExists(t, filepath.Join(path, "deploy", "deployment.yaml"))
+		assert.FileExists(t, filepath.Join(path, "deploy", "configmap.yaml"))
+	})
+	t.Run("CorruptedBlob", func(t *testing.T) {
+		corrupted := registry.push(t, "v4", Descriptor{MediaType: "application/vnd.oci.image.layer.v1.tar", Digest: digestOf([]byte("expected")), Size: 8})
+		registry.blobs[digestOf([]byte("expected"))] = []byte("actual")
+		_, _, err := client.Extract(context.Background(), corrupted)
+		assert.ErrorContains(t, err, "digest mismatch")
+	})
+	t.Run("LayerExceedsMaxSize", func(t *testing.T) {
+		client, err := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "", WithBlobCache(NewBlobCache(t.TempDir(), 0)), WithMaxExtractedSize(8))
+		require.NoError(t, err)
+		_, _, err = client.Extract(context.Background(), digest)
+		assert.ErrorContains(t, err, "exceeds the maximum of 8 bytes")
+	})
+	t.Run("ExtractedFilesExceedMaxSize", func(t *testing.T) {
+		bomb := tarball(t, true, map[string]string{"large.yaml": strings.Repeat("a", 64*1024)})
+		large := registry.push(t, "v5", registry.layer("application/vnd.oci.image.layer.v1.tar+gzip", bomb, nil))
+		client, err := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "", WithBlobCache(NewBlobCache(t.TempDir(), 0)), WithMaxExtractedSize(int64(len(bomb))*2))
+		require.NoError(t, err)
+		_, _, err = client.Extract(context.Background(), large)
+		assert.ErrorContains(t, err, "exceeds the maximum")
+	})
+}
+
+func TestBlobCache(t *testing.T) {
+	root := t.TempDir()
+	cache := NewBlobCache(root, 10)
+	add := func(key string, content string) {
+		path := filepath.Join(t.TempDir(), key)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		f, err := cache.add(key, path, int64(len(content)))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+	isCached := func(key string) bool {
+		f, ok := cache.open(key)
+		if ok {
+			_ = f.Close()
+		}
+		return ok
+	}
+
+	add("a", "aaaa")
+	add("b", "bbbb")
+	assert.True(t, isCached("a"))
+
+	// "b" is the least recently used blob, so it is evicted once the cache exceeds its maximum size
+	add("c", "cccc")
+	assert.True(t, isCached("a"))
+	assert.False(t, isCached("b"))
+	assert.True(t, isCached("c"))
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestGetManifest(t *testing.T) {
+	registry := newFakeRegistry()
+	digest := registry.push(t, "v1")
+	repoURL := registry.start(t)
+	client, err := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "")
+	require.NoError(t, err)
+
+	manifest, err := client.GetManifest(context.Background(), digest)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-05-01T10:00:00Z", manifest.Created())
+	assert.Equal(t, "argo", manifest.Authors())
+
+	_, err = client.GetManifest(context.Background(), "v1")
+	assert.ErrorContains(t, err, "invalid digest")
+}
+
+func TestGetBlob(t *testing.T) {
+	registry := newFakeRegistry()
+	blob := registry.layer("application/vnd.dev.cosign.simplesigning.v1+json", []byte(`{"critical":{}}`), nil)
+	repoURL := registry.start(t)
+	client, err := NewClient(repoURL, Creds{InsecureSkipVerify: true}, "", WithBlobCache(NewBlobCache(t.TempDir(), 0)))
+	require.NoError(t, err)
+
+	data, err := client.GetBlob(context.Background(), blob)
+	require.NoError(t, err)
+	assert.Equal(t, `{"critical":{}}`, string(data))
+
+	_, err = client.GetBlob(context.Background(), Descriptor{Digest: digestOf([]byte("missing"))})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTestRepo(t *testing.T) {
+	registry := newFakeRegistry()
+	registry.token, registry.username, registry.password = "my-token", "my-username", "my-password"
+	repoURL := registry.start(t)
+
+	t.Run("TokenAuth", func(t *testing.T) {
+		client, err := NewClient(repoURL, Creds{Username: "my-username", Password: "my-password", InsecureSkipVerify: true}, "")
+		require.NoError(t, err)
+		ok, err := client.TestRepo(context.Background())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("InvalidCreds", func(t *testing.T) {
+		client, err := NewClient(repoURL, Creds{Username: "my-username", Password: "wrong", InsecureSkipVerify: true}, "")
+		require.NoError(t, err)
+		_, err = client.TestRepo(context.Background())
+		assert.ErrorContains(t, err, "error getting registry token")
+	})
+	t.Run("UntrustedCertificate", func(t *testing.T) {
+		client, err := NewClient(repoURL, Creds{Username: "my-username", Password: "my-password"}, "")
+		require.NoError(t, err)
+		_, err = client.TestRepo(context.Background())
+		assert.Error(t, err)
+	})
+}
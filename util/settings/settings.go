@@ -630,6 +630,11 @@ func (mgr *SettingsManager) GetResourcesFilter() (*ResourcesFilter, error) {
 	return rf, nil
 }
 
+// GetNamespace returns the namespace the settings are stored in, i.e. the control plane namespace
+func (mgr *SettingsManager) GetNamespace() string {
+	return mgr.namespace
+}
+
 func (mgr *SettingsManager) GetAppInstanceLabelKey() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {